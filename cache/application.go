@@ -1,11 +1,16 @@
 package cache
 
 import (
+	"context"
+
 	"github.com/rusmanplatd/goravelframework/contracts/cache"
 	"github.com/rusmanplatd/goravelframework/contracts/config"
 	"github.com/rusmanplatd/goravelframework/contracts/log"
 )
 
+// Application is the cache facade, the keys are prefixed with the tenant only when the store is called by
+// WithContext(ctx) with a context that carries a tenant, calls without a context, like facades.Cache().Put,
+// aren't prefixed and are shared by all the tenants.
 type Application struct {
 	cache.Driver
 	config config.Config
@@ -27,7 +32,7 @@ func NewApplication(config config.Config, log log.Log, store string) (*Applicati
 		log:    log,
 		driver: driver,
 		stores: map[string]cache.Driver{
-			store: instance,
+			store: &tenantStore{Driver: instance},
 		},
 	}, nil
 }
@@ -44,7 +49,13 @@ func (app *Application) Store(name string) cache.Driver {
 		return nil
 	}
 
-	app.stores[name] = instance
+	app.stores[name] = &tenantStore{Driver: instance}
+
+	return app.stores[name]
+}
 
-	return instance
+// WithContext returns the default store with the given context, the keys are prefixed with the tenant if the
+// context carries a tenant, see tenancy.WithTenant.
+func (app *Application) WithContext(ctx context.Context) cache.Driver {
+	return WithTenant(app.Driver, ctx)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/rusmanplatd/goravelframework/contracts/testing/docker"
 	configmock "github.com/rusmanplatd/goravelframework/mocks/config"
 	logmock "github.com/rusmanplatd/goravelframework/mocks/log"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type DriverTestSuite struct {
@@ -69,6 +70,11 @@ func (s *DriverTestSuite) TestStore() {
 
 	s.Equal("goravel", memory.GetString("hello"))
 
+	acme := memory.Store("memory").WithContext(tenancy.WithTenant(context.Background(), "acme"))
+	s.Nil(acme.Put("hello", "acme", 5*time.Second))
+	s.Equal("acme", memory.GetString("acme:hello"))
+	s.Equal("goravel", memory.GetString("hello"))
+
 	s.mockConfig.AssertExpectations(s.T())
 }
 
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	contractscache "github.com/rusmanplatd/goravelframework/contracts/cache"
+	"github.com/rusmanplatd/goravelframework/tenancy"
+)
+
+// Tenant prefixes the keys with the tenant, so the tenants don't share the cached items of the same key.
+type Tenant struct {
+	contractscache.Driver
+	tenant string
+}
+
+// WithTenant returns the driver that prefixes the keys with the tenant of the context, the driver is returned
+// directly if the context doesn't carry a tenant.
+func WithTenant(driver contractscache.Driver, ctx context.Context) contractscache.Driver {
+	driver = driver.WithContext(ctx)
+
+	tenant := tenancy.FromContext(ctx)
+	if tenant == "" {
+		return driver
+	}
+
+	return &Tenant{
+		Driver: driver,
+		tenant: tenant,
+	}
+}
+
+func (r *Tenant) Add(key string, value any, t time.Duration) bool {
+	return r.Driver.Add(r.key(key), value, t)
+}
+
+func (r *Tenant) Decrement(key string, value ...int64) (int64, error) {
+	return r.Driver.Decrement(r.key(key), value...)
+}
+
+// Flush isn't supported for the tenant, the items of all the tenants share the same store, flushing it would
+// remove the items of the other tenants too, forget the keys of the tenant one by one instead.
+func (r *Tenant) Flush() bool {
+	return false
+}
+
+func (r *Tenant) Forever(key string, value any) bool {
+	return r.Driver.Forever(r.key(key), value)
+}
+
+func (r *Tenant) Forget(key string) bool {
+	return r.Driver.Forget(r.key(key))
+}
+
+func (r *Tenant) Get(key string, def ...any) any {
+	return r.Driver.Get(r.key(key), def...)
+}
+
+func (r *Tenant) GetBool(key string, def ...bool) bool {
+	return r.Driver.GetBool(r.key(key), def...)
+}
+
+func (r *Tenant) GetInt(key string, def ...int) int {
+	return r.Driver.GetInt(r.key(key), def...)
+}
+
+func (r *Tenant) GetInt64(key string, def ...int64) int64 {
+	return r.Driver.GetInt64(r.key(key), def...)
+}
+
+func (r *Tenant) GetString(key string, def ...string) string {
+	return r.Driver.GetString(r.key(key), def...)
+}
+
+func (r *Tenant) Has(key string) bool {
+	return r.Driver.Has(r.key(key))
+}
+
+func (r *Tenant) Increment(key string, value ...int64) (int64, error) {
+	return r.Driver.Increment(r.key(key), value...)
+}
+
+func (r *Tenant) Lock(key string, t ...time.Duration) contractscache.Lock {
+	return r.Driver.Lock(r.key(key), t...)
+}
+
+func (r *Tenant) Put(key string, value any, t time.Duration) error {
+	return r.Driver.Put(r.key(key), value, t)
+}
+
+func (r *Tenant) Pull(key string, def ...any) any {
+	return r.Driver.Pull(r.key(key), def...)
+}
+
+func (r *Tenant) Remember(key string, ttl time.Duration, callback func() (any, error)) (any, error) {
+	return r.Driver.Remember(r.key(key), ttl, callback)
+}
+
+func (r *Tenant) RememberForever(key string, callback func() (any, error)) (any, error) {
+	return r.Driver.RememberForever(r.key(key), callback)
+}
+
+func (r *Tenant) WithContext(ctx context.Context) contractscache.Driver {
+	return WithTenant(r.Driver, ctx)
+}
+
+// tenantStore is a store of the application, its WithContext prefixes the keys with the tenant of the context,
+// like the default store does.
+type tenantStore struct {
+	contractscache.Driver
+}
+
+func (r *tenantStore) WithContext(ctx context.Context) contractscache.Driver {
+	return WithTenant(r.Driver, ctx)
+}
+
+func (r *Tenant) key(key string) string {
+	return r.tenant + ":" + key
+}
@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/tenancy"
+)
+
+func TestWithTenant(t *testing.T) {
+	memory, err := getMemoryStore()
+	assert.Nil(t, err)
+
+	assert.Equal(t, memory, WithTenant(memory, context.Background()))
+
+	acme := WithTenant(memory, tenancy.WithTenant(context.Background(), "acme"))
+	globex := WithTenant(memory, tenancy.WithTenant(context.Background(), "globex"))
+
+	assert.Nil(t, acme.Put("name", "Acme", 1*time.Minute))
+	assert.Nil(t, globex.Put("name", "Globex", 1*time.Minute))
+	assert.Equal(t, "Acme", acme.GetString("name"))
+	assert.Equal(t, "Globex", globex.GetString("name"))
+	assert.Equal(t, "Acme", memory.GetString("acme:name"))
+	assert.False(t, memory.Has("name"))
+
+	assert.True(t, acme.Lock("lock").Get())
+	assert.True(t, globex.Lock("lock").Get())
+
+	switched := acme.WithContext(tenancy.WithTenant(context.Background(), "globex"))
+	assert.Equal(t, "Globex", switched.GetString("name"))
+
+	assert.False(t, acme.Flush())
+	assert.Equal(t, "Globex", globex.GetString("name"))
+	assert.True(t, memory.Flush())
+}
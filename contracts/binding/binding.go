@@ -18,6 +18,7 @@ const (
 	Mail         = "goravel.mail"
 	Notification = "goravel.notification"
 	Orm          = "goravel.orm"
+	Outbox       = "goravel.outbox"
 	Pipeline     = "goravel.pipeline"
 	Process      = "goravel.process"
 	Queue        = "goravel.queue"
@@ -277,6 +278,17 @@ var (
 				Schema,
 			},
 		},
+		Outbox: {
+			Description: "Stores events and jobs in the database transaction and relays them reliably.",
+			PkgPath:     "github.com/rusmanplatd/goravelframework/outbox",
+			Dependencies: []string{
+				Config,
+				Event,
+				Log,
+				Orm,
+				Queue,
+			},
+		},
 		Pipeline: {
 			Description: "Provides a pipeline pattern for passing data through a series of processing stages.",
 			PkgPath:     "github.com/rusmanplatd/goravelframework/pipeline",
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -13,8 +14,19 @@ type DB interface {
 	BeginTransaction() (Tx, error)
 	// Connection gets an Orm instance from the connection pool.
 	Connection(name string) DB
+	// FlushQueryCache invalidates the results of the given tables that are cached by Remember.
+	FlushQueryCache(tables ...string) error
+	// ListenQueries registers a listener that is called after each query of all connections, including the
+	// queries executed by the Orm.
+	ListenQueries(callback func(event QueryExecuted))
+	// TrackQueryTime enables the cumulative query time tracking for the context, eg: a request.
+	TrackQueryTime(ctx context.Context) context.Context
 	// Transaction runs a callback wrapped in a database transaction.
 	Transaction(txFunc func(tx Tx) error) error
+	// WhenQueryingForLongerThan registers a callback that is called once when the cumulative query time of a
+	// context exceeds the threshold, the context should be prepared by TrackQueryTime, eg: by the HTTP middleware
+	// middleware.TrackQueryTime.
+	WhenQueryingForLongerThan(threshold time.Duration, callback func(ctx context.Context, total time.Duration))
 	// WithContext sets the context to be used by the Orm.
 	WithContext(ctx context.Context) DB
 }
@@ -47,6 +59,11 @@ type Query interface {
 	CrossJoin(query string, args ...any) Query
 	// Cursor returns a cursor, use scan to iterate over the returned rows.
 	Cursor() chan Row
+	// CursorPaginate paginates the query by the keyset of its OrderBy columns, it returns the opaque cursors
+	// of the next and previous pages, the cursors are empty when there are no more pages. The "id" column is
+	// appended to the orders as the tiebreaker, the NULL values are sorted first and the cursors are signed by app.key.
+	// The random and full text orders aren't supported.
+	CursorPaginate(limit int, cursor string, dest any) (next, prev string, err error)
 	// Decrement decrements the given column's values by the given amounts.
 	Decrement(column string, value ...uint64) error
 	// Delete deletes records from the database.
@@ -72,6 +89,8 @@ type Query interface {
 	FirstOrFail(dest any) error
 	// Get retrieves all rows from the database.
 	Get(dest any) error
+	// FromSub specifies a sub query as the table of the query.
+	FromSub(query Query, as string) Query
 	// GroupBy specifies the group method on the query.
 	GroupBy(column ...string) Query
 	// Having specifies HAVING conditions for the query.
@@ -84,12 +103,19 @@ type Query interface {
 	Insert(data any) (*Result, error)
 	// InsertGetID returns the ID of the inserted row, only supported by MySQL and Sqlite
 	InsertGetID(data any) (int64, error)
+	// InsertOrIgnore inserts the records into the database, the records that violate the unique constraints are ignored,
+	// the uniqueBy columns are the conflict target, they are required by SQL Server.
+	InsertOrIgnore(data any, uniqueBy ...string) (*Result, error)
 	// Join specifies JOIN conditions for the query.
 	Join(query string, args ...any) Query
+	// JoinSub specifies JOIN conditions with a sub query for the query, eg: JoinSub(query, "latest", "latest.user_id = users.id").
+	JoinSub(query Query, as string, on string, args ...any) Query
 	// Latest retrieves the latest record from the database, default column is "created_at"
 	Latest(column ...string) Query
 	// LeftJoin specifies LEFT JOIN conditions for the query.
 	LeftJoin(query string, args ...any) Query
+	// LeftJoinSub specifies LEFT JOIN conditions with a sub query for the query.
+	LeftJoinSub(query Query, as string, on string, args ...any) Query
 	// Limit the number of records returned.
 	Limit(limit uint64) Query
 	// LockForUpdate locks the selected rows in the table for updating.
@@ -100,6 +126,8 @@ type Query interface {
 	OrderBy(column string, directions ...string) Query
 	// OrderByDesc specifies the order should be descending.
 	OrderByDesc(column string) Query
+	// OrderByFullText orders the results by the relevance of the full-text search, the most relevant first.
+	OrderByFullText(columns []string, value string, options ...FullTextOptions) Query
 	// OrderByRaw specifies the order should be raw.
 	OrderByRaw(raw string) Query
 	// OrWhere adds an "or where" clause to the query.
@@ -108,8 +136,10 @@ type Query interface {
 	OrWhereBetween(column string, x, y any) Query
 	// OrWhereColumn adds an "or where column" clause to the query.
 	OrWhereColumn(column1 string, column2 ...string) Query
+	// OrWhereFullText adds an "or where full-text" clause to the query.
+	OrWhereFullText(columns []string, value string, options ...FullTextOptions) Query
 	// OrWhereIn adds an "or where column in" clause to the query.
-	OrWhereIn(column string, values []any) Query
+	OrWhereIn(column string, values any) Query
 	// OrWhereJsonContains adds an "or where JSON contains" clause to the query.
 	OrWhereJsonContains(column string, value any) Query
 	// OrWhereJsonContainsKey add a clause that determines if a JSON path exists to the query.
@@ -127,7 +157,7 @@ type Query interface {
 	// OrWhereNotBetween adds an "or where column not between x and y" clause to the query.
 	OrWhereNotBetween(column string, x, y any) Query
 	// OrWhereNotIn adds an "or where column not in" clause to the query.
-	OrWhereNotIn(column string, values []any) Query
+	OrWhereNotIn(column string, values any) Query
 	// OrWhereNotLike adds an "or where column not like" clause to the query.
 	OrWhereNotLike(column string, value string) Query
 	// OrWhereNotNull adds an "or where column is not null" clause to the query.
@@ -140,10 +170,16 @@ type Query interface {
 	Paginate(page, limit int, dest any, total *int64) error
 	// Pluck retrieves a single column from the database.
 	Pluck(column string, dest any) error
+	// Remember caches the result of Get, First, Find, Count and Pluck in the cache store for the given ttl,
+	// the key is generated from the SQL and bindings if it's not given. The cached results are invalidated
+	// when the table is written or flushed by FlushQueryCache.
+	Remember(ttl time.Duration, key ...string) Query
 	// RightJoin specifies RIGHT JOIN conditions for the query.
 	RightJoin(query string, args ...any) Query
 	// Select specifies fields that should be retrieved from the database.
 	Select(columns ...string) Query
+	// SelectSub adds a sub query as a column of the query.
+	SelectSub(as string, query Query) Query
 	// SharedLock locks the selected rows in the table.
 	SharedLock() Query
 	// Sum calculates the sum of a column's values and populates the destination object.
@@ -158,15 +194,26 @@ type Query interface {
 	ToSql() ToSql
 	// ToRawSql returns the query as a raw SQL string.
 	ToRawSql() ToSql
+	// Union combines the query with the given query, the duplicate rows are removed.
+	Union(query Query) Query
+	// UnionAll combines the query with the given query, the duplicate rows are kept.
+	UnionAll(query Query) Query
 	// Update records with the given column and values
 	Update(column any, value ...any) (*Result, error)
 	// UpdateOrInsert finds the first record that matches the given attributes
 	// or create a new one with those attributes if none was found.
 	UpdateOrInsert(attributes any, values any) (*Result, error)
+	// Upsert inserts the records or updates the given columns of the existing records in a single statement,
+	// the uniqueBy columns should have a primary or unique index, the update columns default to all inserted columns.
+	Upsert(data any, uniqueBy []string, update []string) (*Result, error)
 	// Value gets a single column's value from the first result of a query.
 	Value(column string, dest any) error
 	// When executes the callback if the condition is true.
 	When(condition bool, callback func(query Query) Query, falseCallback ...func(query Query) Query) Query
+	// With adds a common table expression to the query.
+	With(name string, query Query) Query
+	// WithRecursive adds a recursive common table expression to the query.
+	WithRecursive(name string, query Query) Query
 	// Where adds a "where" clause to the query.
 	Where(query any, args ...any) Query
 	// WhereAll adds a "where all columns match" clause to the query.
@@ -179,8 +226,11 @@ type Query interface {
 	WhereColumn(column1 string, column2 ...string) Query
 	// WhereExists adds an exists clause to the query.
 	WhereExists(func() Query) Query
-	// WhereIn adds a "where column in" clause to the query.
-	WhereIn(column string, values []any) Query
+	// WhereFullText adds a "where full-text" clause to the query, the columns should have a full-text index, the columns
+	// of SQLite should belong to the FTS5 virtual table of the query.
+	WhereFullText(columns []string, value string, options ...FullTextOptions) Query
+	// WhereIn adds a "where column in" clause to the query, the values can be a slice or a sub query.
+	WhereIn(column string, values any) Query
 	// WhereJsonContains add a "where JSON contains" clause to the query.
 	WhereJsonContains(column string, value any) Query
 	// WhereJsonContainsKey add a clause that determines if a JSON path exists to the query.
@@ -199,8 +249,8 @@ type Query interface {
 	WhereNot(query any, args ...any) Query
 	// WhereNotBetween adds a "where column not between x and y" clause to the query.
 	WhereNotBetween(column string, x, y any) Query
-	// WhereNotIn adds a "where column not in" clause to the query.
-	WhereNotIn(column string, values []any) Query
+	// WhereNotIn adds a "where column not in" clause to the query, the values can be a slice or a sub query.
+	WhereNotIn(column string, values any) Query
 	// WhereNotLike adds a "where not like" clause to the query.
 	WhereNotLike(column string, value string) Query
 	// WhereNotNull adds a "where column is not null" clause to the query.
@@ -211,10 +261,40 @@ type Query interface {
 	WhereRaw(raw string, args []any) Query
 }
 
+// FullTextOptions is the options of the full-text search.
+type FullTextOptions struct {
+	// Language is the text search configuration of Postgres, the default is "english".
+	Language string
+	// Mode is the search mode, the default is "natural":
+	//   - natural: the natural language search.
+	//   - boolean: the boolean search, eg: "+goravel -laravel", it's the tsquery syntax for Postgres.
+	//   - expanded: the natural language search with the query expansion, only for MySQL.
+	//   - phrase: the phrase search, only for Postgres.
+	//   - websearch: the web search syntax, eg: "goravel or framework", only for Postgres.
+	Mode string
+}
+
 type Result struct {
 	RowsAffected int64
 }
 
+// QueryExecuted is the event of an executed query, it's dispatched by the event facade when the query takes
+// longer than the slow threshold of the connection.
+type QueryExecuted struct {
+	// The SQL with the bindings interpolated.
+	SQL string
+	// The bindings of the SQL, they are empty for the queries executed by the Orm.
+	Bindings []any
+	// The connection name.
+	Connection string
+	// The time it took to execute the query.
+	Duration time.Duration
+	// The number of affected rows, -1 when it is unknown.
+	RowsAffected int64
+	// The error returned by the query.
+	Error error
+}
+
 type Builder interface {
 	CommonBuilder
 	Beginx() (*sqlx.Tx, error)
@@ -227,6 +307,7 @@ type TxBuilder interface {
 }
 
 type CommonBuilder interface {
+	DriverName() string
 	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
 	Explain(sql string, args ...any) string
 	GetContext(ctx context.Context, dest any, query string, args ...any) error
@@ -9,6 +9,10 @@ type ColumnDefinition interface {
 	AutoIncrement() ColumnDefinition
 	// Change the column (MySQL / PostgreSQL / SQL Server)
 	Change() ColumnDefinition
+	// Charset sets the character set of the column (MySQL only), the grammar must declare it, see ColumnModifierGrammar
+	Charset(charset string) ColumnDefinition
+	// Collation sets the collation of the column, the grammar must declare it, see ColumnModifierGrammar
+	Collation(collation string) ColumnDefinition
 	// Comment sets the comment value (MySQL / PostgreSQL)
 	Comment(comment string) ColumnDefinition
 	// Default set the default value
@@ -23,6 +27,10 @@ type ColumnDefinition interface {
 	GetAllowed() []any
 	// GetAutoIncrement returns the autoIncrement value
 	GetAutoIncrement() bool
+	// GetCharset returns the charset value
+	GetCharset() string
+	// GetCollation returns the collation value
+	GetCollation() string
 	// GetComment returns the comment value
 	GetComment() (comment string)
 	// GetDefault returns the default value
@@ -41,12 +49,16 @@ type ColumnDefinition interface {
 	GetPlaces() int
 	// GetPrecision returns the precision value
 	GetPrecision() int
+	// GetStoredAs returns the expression of the stored generated column
+	GetStoredAs() string
 	// GetTotal returns the total value
 	GetTotal() int
 	// GetType returns the type value
 	GetType() string
 	// GetUnsigned returns the unsigned value
 	GetUnsigned() bool
+	// GetVirtualAs returns the expression of the virtual generated column
+	GetVirtualAs() string
 	// GetUseCurrent returns the useCurrent value
 	GetUseCurrent() bool
 	// GetUseCurrentOnUpdate returns the useCurrentOnUpdate value
@@ -65,6 +77,8 @@ type ColumnDefinition interface {
 	OnUpdate(value any) ColumnDefinition
 	// Places set the decimal places
 	Places(places int) ColumnDefinition
+	// StoredAs creates a stored generated column with the given expression, the grammar must declare it, see ColumnModifierGrammar
+	StoredAs(expression string) ColumnDefinition
 	// Total set the decimal total
 	Total(total int) ColumnDefinition
 	// Nullable allow NULL values to be inserted into the column
@@ -75,16 +89,22 @@ type ColumnDefinition interface {
 	UseCurrent() ColumnDefinition
 	// UseCurrentOnUpdate set the column to use the current timestamp on update (Mysql only)
 	UseCurrentOnUpdate() ColumnDefinition
+	// VirtualAs creates a virtual generated column with the given expression, the grammar must declare it, see ColumnModifierGrammar
+	VirtualAs(expression string) ColumnDefinition
 }
 
 type Column struct {
-	Collation     string
-	Comment       string
-	Default       string
-	Extra         string
-	Name          string
-	Type          string
-	TypeName      string
-	Autoincrement bool
-	Nullable      bool
+	Charset   string
+	Collation string
+	Comment   string
+	Default   string
+	Extra     string
+	// Generation is the type of the generated column: "stored" or "virtual", it's empty if the column isn't generated.
+	Generation           string
+	GenerationExpression string
+	Name                 string
+	Type                 string
+	TypeName             string
+	Autoincrement        bool
+	Nullable             bool
 }
@@ -1,5 +1,7 @@
 package driver
 
+import sq "github.com/Masterminds/squirrel"
+
 // WhereType type of where condition
 type WhereType int
 
@@ -13,6 +15,7 @@ const (
 type Conditions struct {
 	CrossJoin     []Join
 	Distinct      *bool
+	FromSub       *FromSub
 	GroupBy       []string
 	Having        *Having
 	Join          []Join
@@ -22,11 +25,20 @@ type Conditions struct {
 	Limit         *uint64
 	Offset        *uint64
 	OrderBy       []string
+	OrderByArgs   []any
 	RightJoin     []Join
 	Selects       []string
 	SharedLock    *bool
+	SubSelects    []Join
 	Table         string
+	Unions        []Union
 	Where         []Where
+	With          []With
+}
+
+type FromSub struct {
+	Query sq.SelectBuilder
+	As    string
 }
 
 type Having struct {
@@ -46,3 +58,16 @@ type Where struct {
 	Or    bool
 	IsNot bool
 }
+
+type Union struct {
+	Query string
+	Args  []any
+	All   bool
+}
+
+type With struct {
+	Name      string
+	Query     string
+	Args      []any
+	Recursive bool
+}
@@ -15,3 +15,14 @@ type Driver interface {
 	// Processor returns the database processor.
 	Processor() Processor
 }
+
+// SchemaDumper is an optional interface of the driver, it dumps and loads the database structure with the
+// native tools, eg: pg_dump and psql. The schema builds the dump from the database structure otherwise, note that
+// none of the official drivers implements it yet, so the views, triggers, check constraints and sequences are not
+// dumped.
+type SchemaDumper interface {
+	// DumpSchema dumps the database structure to the given path.
+	DumpSchema(path string) error
+	// LoadSchema loads the given dump into the database.
+	LoadSchema(path string) error
+}
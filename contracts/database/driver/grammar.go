@@ -3,6 +3,8 @@ package driver
 import (
 	sq "github.com/Masterminds/squirrel"
 	"gorm.io/gorm/clause"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/db"
 )
 
 type Grammar interface {
@@ -155,6 +157,27 @@ type DBGrammar interface {
 	CompileVersion() string
 }
 
+// CompileCheckGrammar compiles the check constraints, the blueprint returns errors.SchemaCheckNotSupported if the
+// grammar doesn't implement it. An empty statement means the command is compiled elsewhere, eg: SQLite can only
+// declare the check constraints in the create table statement.
+type CompileCheckGrammar interface {
+	// CompileCheck Compile a check constraint command.
+	CompileCheck(blueprint Blueprint, command *Command) string
+	// CompileChecks Compile the query to determine the check constraints of the table, the query selects the name and
+	// the expression columns, see Check.
+	CompileChecks(schema, table string) (string, error)
+	// CompileDropCheck Compile a drop check constraint command.
+	CompileDropCheck(blueprint Blueprint, command *Command) string
+}
+
+// ColumnModifierGrammar declares the optional column modifiers that the grammar compiles, the blueprint returns
+// errors.SchemaColumnModifierNotSupported if a column uses a modifier that the grammar doesn't declare, eg: charset,
+// collation, storedAs and virtualAs.
+type ColumnModifierGrammar interface {
+	// GetColumnModifiers Get the optional column modifiers that the grammar compiles.
+	GetColumnModifiers() []string
+}
+
 type CompileOffsetGrammar interface {
 	CompileOffset(builder sq.SelectBuilder, conditions *Conditions) sq.SelectBuilder
 }
@@ -167,6 +190,31 @@ type CompileLimitGrammar interface {
 	CompileLimit(builder sq.SelectBuilder, conditions *Conditions) sq.SelectBuilder
 }
 
+// CompileWithGrammar compiles the common table expressions, the default is "WITH [RECURSIVE] name AS (query)", the
+// RECURSIVE keyword is omitted for SQL Server.
+type CompileWithGrammar interface {
+	CompileWith(builder sq.SelectBuilder, conditions *Conditions) sq.SelectBuilder
+}
+
+// CompileFullTextGrammar compiles the full-text search, it's compiled by the driver name by default, see
+// db.CompileWhereFullText.
+type CompileFullTextGrammar interface {
+	// CompileWhereFullText Compile a "where full-text" clause.
+	CompileWhereFullText(columns []string, value string, options db.FullTextOptions) (string, []any, error)
+	// CompileOrderByFullText Compile the order by clause of the full-text relevance, the most relevant first.
+	CompileOrderByFullText(columns []string, value string, options db.FullTextOptions) (string, []any, error)
+}
+
+// CompileUpsertGrammar compiles the insert statements that handle the conflicts of the unique constraints, they are
+// compiled by the driver name by default, see db.CompileInsertOrIgnore and db.CompileUpsert. The statements should use
+// the question placeholder, it's replaced by the placeholder format of the grammar.
+type CompileUpsertGrammar interface {
+	// CompileInsertOrIgnore Compile an insert statement that ignores the records violating the unique constraints.
+	CompileInsertOrIgnore(table string, columns []string, values [][]any, uniqueBy []string) (string, []any, error)
+	// CompileUpsert Compile an insert statement that updates the given columns of the existing records.
+	CompileUpsert(table string, columns []string, values [][]any, uniqueBy []string, update []string) (string, []any, error)
+}
+
 type JsonGrammar interface {
 	// CompileJsonColumnsUpdate Compile the JSON  columns for an update statement.
 	CompileJsonColumnsUpdate(values map[string]any) (map[string]any, error)
@@ -202,6 +250,7 @@ type Command struct {
 	Deferrable         *bool
 	InitiallyImmediate *bool
 	Algorithm          string
+	Expression         string
 	From               string
 	Index              string
 	Language           string
@@ -216,6 +265,11 @@ type Command struct {
 	ShouldBeSkipped    bool
 }
 
+type Check struct {
+	Name       string
+	Expression string
+}
+
 type Table struct {
 	Collation string
 	Comment   string
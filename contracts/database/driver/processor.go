@@ -7,20 +7,25 @@ type Processor interface {
 	ProcessTypes(types []Type) []Type
 }
 
+// DBColumn is a row of the Grammar.CompileColumns query, the charset, generation and generation_expression columns
+// are optional, they are copied to the Column if the processor doesn't map them.
 type DBColumn struct {
-	Collation     string
-	Comment       string
-	Default       string
-	Extra         string
-	Name          string
-	Nullable      string
-	Type          string
-	TypeName      string
-	Length        int
-	Places        int
-	Precision     int
-	Autoincrement bool
-	Primary       bool
+	Charset              string
+	Collation            string
+	Comment              string
+	Default              string
+	Extra                string
+	Generation           string
+	GenerationExpression string
+	Name                 string
+	Nullable             string
+	Type                 string
+	TypeName             string
+	Length               int
+	Places               int
+	Precision            int
+	Autoincrement        bool
+	Primary              bool
 }
 
 type DBForeignKey struct {
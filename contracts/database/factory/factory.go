@@ -5,6 +5,11 @@ type Factory interface {
 	Definition() map[string]any
 }
 
+type FactoryWithStates interface {
+	// States defines the model's named states, they can be applied by orm.Factory.NamedState.
+	States() map[string]func(attributes map[string]any) map[string]any
+}
+
 type Model interface {
 	// Factory creates a new factory instance for the model.
 	Factory() Factory
@@ -5,6 +5,23 @@ const (
 	MigratorSql     = "sql"
 )
 
+// Pretended is the SQL that a migration would execute in the pretend mode, the schema changes are recorded instead of
+// being executed, the other queries of the connection, eg: facades.Orm().Query().Exec and facades.DB(), are run in a
+// transaction that is always rolled back. Note that MySQL commits the transaction implicitly when a DDL statement is
+// executed directly, so make the schema changes through facades.Schema().
+type Pretended struct {
+	Migration string
+	Sqls      []string
+}
+
+// FreshOptions are the options of Migrator.Fresh.
+type FreshOptions struct {
+	// DropTypes drops all the types before running the migrations (Postgres only).
+	DropTypes bool
+	// DropViews drops all the views before running the migrations.
+	DropViews bool
+}
+
 type Status struct {
 	Name  string
 	Batch int
@@ -15,13 +32,30 @@ type Migrator interface {
 	// Create a new migration file.
 	Create(name string) (string, error)
 	// Fresh the migrations.
-	Fresh() error
+	Fresh(options ...FreshOptions) error
+	// PretendFresh gets the SQL that Fresh would execute without touching the database.
+	PretendFresh(options ...FreshOptions) ([]Pretended, error)
+	// PretendRollback gets the SQL that Rollback would execute without touching the database.
+	PretendRollback(step, batch int) ([]Pretended, error)
+	// PretendRun gets the SQL that Run would execute without touching the database.
+	PretendRun() ([]Pretended, error)
 	// Reset the migrations.
 	Reset() error
 	// Rollback the last migration operation.
 	Rollback(step, batch int) error
 	// Run the migrations according to paths.
 	Run() error
+	// RunIsolated runs the migrations if no other process is running them, returns errors.MigrationLocked otherwise.
+	RunIsolated() error
 	// Status get the migration's status.
 	Status() ([]Status, error)
 }
+
+// Lock prevents the migrations from being run by multiple processes at the same time.
+type Lock interface {
+	// Acquire acquires the lock, it waits until the timeout if wait is true, returns errors.MigrationLocked if the
+	// lock is held by another process and wait is false. The returned function releases the lock.
+	Acquire(wait bool) (release func() error, err error)
+	// Refresh restores the held lock if its state has been dropped, eg: the lock table is dropped by db:wipe.
+	Refresh() error
+}
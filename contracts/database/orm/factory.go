@@ -1,12 +1,29 @@
 package orm
 
 type Factory interface {
+	// AfterCreating registers a callback that is called after each model is created.
+	AfterCreating(callback func(model any) error) Factory
+	// AfterMaking registers a callback that is called after each model is made.
+	AfterMaking(callback func(model any) error) Factory
 	// Count sets the number of models that should be generated.
 	Count(count int) Factory
 	// Create creates a model and persists it to the database.
 	Create(value any, attributes ...map[string]any) error
 	// CreateQuietly creates a model and persists it to the database without firing any model events.
 	CreateQuietly(value any, attributes ...map[string]any) error
+	// For builds the parent model of the belongs to relationship, the parent can be a model or a factory, the parent
+	// built by the factory is shared by all the models that are made or created at once.
+	For(parent any, relation string) Factory
+	// Has builds the related models of the has one, has many or many to many relationship by the factory.
+	Has(factory Factory, relation string) Factory
 	// Make creates a model and returns it, but does not persist it to the database.
 	Make(value any, attributes ...map[string]any) error
+	// NamedState applies the state that is defined in the States method of the model's factory.
+	NamedState(name string) Factory
+	// Raw gets the attributes of the models without making them, a map is returned for each model.
+	Raw(value any, attributes ...map[string]any) ([]map[string]any, error)
+	// Sequence cycles the attributes of the generated models through the given sequence.
+	Sequence(sequence ...map[string]any) Factory
+	// State modifies the attributes of the model's default state.
+	State(state func(attributes map[string]any) map[string]any) Factory
 }
@@ -3,6 +3,7 @@ package orm
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/rusmanplatd/goravelframework/contracts/database"
 	"github.com/rusmanplatd/goravelframework/contracts/database/db"
@@ -43,6 +44,12 @@ type Query interface {
 	Begin() (Query, error)
 	// BeginTransaction begins a new transaction
 	BeginTransaction() (Query, error)
+	// Chunk retrieves the models set by Model in chunks, the callback receives a pointer to a slice of the models.
+	// The query is ordered by the primary key if it isn't ordered, so the chunks don't overlap.
+	Chunk(size int, callback func(models any) error) error
+	// ChunkByID retrieves the models set by Model in chunks by comparing the column, the primary key "id" is used
+	// when the column is empty. Unlike Chunk, it's safe to update the retrieved rows in the callback.
+	ChunkByID(size int, column string, callback func(models any) error) error
 	// Commit commits the changes in a transaction.
 	Commit() error
 	// Count retrieve the "count" result of the query.
@@ -51,6 +58,10 @@ type Query interface {
 	Create(value any) error
 	// Cursor returns a cursor, use scan to iterate over the returned rows.
 	Cursor() chan db.Row
+	// CursorPaginate paginates the query by the keyset of its OrderBy columns, it returns the opaque cursors
+	// of the next and previous pages, the cursors are empty when there are no more pages. The primary key of the model is
+	// appended to the orders as the tiebreaker, the NULL values are sorted first and the cursors are signed by app.key.
+	CursorPaginate(limit int, cursor string, dest any) (next, prev string, err error)
 	// DB gets the underlying database connection.
 	DB() (*sql.DB, error)
 	// Delete deletes records matching given conditions, if the conditions are empty will delete all records.
@@ -59,6 +70,8 @@ type Query interface {
 	Distinct(columns ...string) Query
 	// Driver gets the driver for the query.
 	Driver() string
+	// Each retrieves the models set by Model in chunks and passes each model to the callback.
+	Each(callback func(model any) error) error
 	// Exec executes raw sql
 	Exec(sql string, values ...any) (*db.Result, error)
 	// Exists returns true if matching records exist; otherwise, it returns false.
@@ -95,6 +108,8 @@ type Query interface {
 	InRandomOrder() Query
 	// InTransaction checks if the query is in a transaction.
 	InTransaction() bool
+	// InsertOrIgnore inserts the models into the database, the models that violate the unique constraints are ignored.
+	InsertOrIgnore(value any) (*db.Result, error)
 	// Join specifying JOIN conditions for the query.
 	Join(query string, args ...any) Query
 	// Limit the number of records returned.
@@ -111,6 +126,8 @@ type Query interface {
 	Offset(offset int) Query
 	// Omit specifies columns that should be omitted from the query.
 	Omit(columns ...string) Query
+	// OnlyTrashed limits the results to the soft deleted models.
+	OnlyTrashed() Query
 	// Order specifies the order in which the results should be returned.
 	// DEPRECATED Use OrderByRaw instead.
 	Order(value any) Query
@@ -118,12 +135,16 @@ type Query interface {
 	OrderBy(column string, direction ...string) Query
 	// OrderByDesc specifies the order should be descending.
 	OrderByDesc(column string) Query
+	// OrderByFullText orders the results by the relevance of the full-text search, the most relevant first.
+	OrderByFullText(columns []string, value string, options ...db.FullTextOptions) Query
 	// OrderByRaw specifies the order should be raw.
 	OrderByRaw(raw string) Query
 	// OrWhere add an "or where" clause to the query.
 	OrWhere(query any, args ...any) Query
 	// OrWhereBetween adds an "or where column between x and y" clause to the query.
 	OrWhereBetween(column string, x, y any) Query
+	// OrWhereFullText adds an "or where full-text" clause to the query.
+	OrWhereFullText(columns []string, value string, options ...db.FullTextOptions) Query
 	// OrWhereIn adds an "or where column in" clause to the query.
 	OrWhereIn(column string, values []any) Query
 	// OrWhereJsonContains adds an "or where JSON contains" clause to the query.
@@ -148,6 +169,10 @@ type Query interface {
 	Pluck(column string, dest any) error
 	// Raw creates a raw query.
 	Raw(sql string, values ...any) Query
+	// Remember caches the result of Get, First, Find, Count and Pluck in the cache store for the given ttl,
+	// the key is generated from the SQL and bindings if it's not given. The cached results are invalidated
+	// when the table is written by the Orm or DB, or flushed by facades.DB().FlushQueryCache.
+	Remember(ttl time.Duration, key ...string) Query
 	// Restore restores a soft deleted model.
 	Restore(model ...any) (*db.Result, error)
 	// Rollback rolls back the changes in a transaction.
@@ -185,6 +210,9 @@ type Query interface {
 	// UpdateOrCreate finds the first record that matches the given attributes
 	// or create a new one with those attributes if none was found.
 	UpdateOrCreate(dest any, attributes any, values any) error
+	// Upsert inserts the models or updates the given columns of the existing models in a single statement,
+	// all columns are updated if the update columns are empty. Model events are not fired.
+	Upsert(value any, uniqueBy []string, update []string) (*db.Result, error)
 	// Where add a "where" clause to the query.
 	Where(query any, args ...any) Query
 	// WhereAll adds a "where all columns match" clause to the query.
@@ -193,6 +221,9 @@ type Query interface {
 	WhereAny(columns []string, args ...any) Query
 	// WhereBetween adds a "where column between x and y" clause to the query.
 	WhereBetween(column string, x, y any) Query
+	// WhereFullText adds a "where full-text" clause to the query, the columns should have a full-text index, the columns
+	// of SQLite should belong to the FTS5 virtual table set by Table or Model before it.
+	WhereFullText(columns []string, value string, options ...db.FullTextOptions) Query
 	// WhereIn adds a "where column in" clause to the query.
 	WhereIn(column string, values []any) Query
 	// WhereJsonContains add a "where JSON contains" clause to the query.
@@ -246,6 +277,29 @@ type Association interface {
 	Count() int64
 }
 
+type Cast interface {
+	// Get converts the value of the column to the value of the model field when the model is retrieved.
+	Get(value any) (any, error)
+	// Set converts the value of the model field to the value of the column when the model is saved.
+	Set(value any) (any, error)
+}
+
+type ModelWithAppends interface {
+	// Appends gets the attributes that are appended to the serialized model, the key is the attribute name.
+	Appends() map[string]any
+}
+
+type ModelWithCascadeDeletes interface {
+	// CascadeDeletes gets the has one and has many relationships whose models are soft deleted with the model, they
+	// are restored when the model is restored.
+	CascadeDeletes() []string
+}
+
+type ModelWithCasts interface {
+	// Casts gets the casts of the model, the key is the field name or the column name.
+	Casts() map[string]Cast
+}
+
 type ModelWithConnection interface {
 	// Connection gets the connection name for the model.
 	Connection() string
@@ -255,6 +309,42 @@ type ModelWithGlobalScopes interface {
 	GlobalScopes() []func(Query) Query
 }
 
+type ModelWithHidden interface {
+	// Hidden gets the attributes that are hidden from the serialized model, the attribute is the JSON key or the
+	// field name.
+	Hidden() []string
+}
+
+type ModelWithTenant interface {
+	// TenantColumn gets the column of the tenant, the queries are scoped to the tenant of the context passed by
+	// Orm().WithContext(ctx) and the column is filled when the model is created, see tenancy.WithTenant. The queries
+	// without a tenant fail with errors.OrmTenantNotFound, use tenancy.WithoutTenant(ctx) to query all the tenants.
+	TenantColumn() string
+}
+
+type ModelWithVersion interface {
+	// VersionColumn gets the column of the version that is checked and incremented when the model is updated,
+	// errors.OrmStaleModel is returned if the model has been modified since it was retrieved.
+	VersionColumn() string
+}
+
+type ModelWithVisible interface {
+	// Visible gets the attributes that are visible in the serialized model, the other attributes are hidden.
+	Visible() []string
+}
+
+type MassPrunable interface {
+	// MassPrunable gets the query of the models that should be pruned by the model:prune command, the models are
+	// deleted in bulk, so the events and observers are not fired.
+	MassPrunable() Query
+}
+
+type Prunable interface {
+	// Prunable gets the query of the models that should be pruned by the model:prune command, the models are
+	// deleted one by one, so the events and observers are fired.
+	Prunable() Query
+}
+
 type ToSql interface {
 	Count() string
 	Create(value any) string
@@ -16,6 +16,8 @@ type Blueprint interface {
 	Build(query orm.Query, grammar driver.Grammar) error
 	// Char Create a new char column on the table.
 	Char(column string, length ...int) driver.ColumnDefinition
+	// Check Specify a check constraint for the table, the grammar must implement driver.CompileCheckGrammar.
+	Check(name string, expression string)
 	// Column Create a new custom type column on the table.
 	Column(column string, ttype string) driver.ColumnDefinition
 	// Comment Add a comment to the table. (MySQL / PostgreSQL)
@@ -36,6 +38,8 @@ type Blueprint interface {
 	Double(column string) driver.ColumnDefinition
 	// Drop Indicate that the table should be dropped.
 	Drop()
+	// DropCheck Indicate that the given check constraint should be dropped, see Check.
+	DropCheck(name string)
 	// DropColumn Indicate that the given columns should be dropped.
 	DropColumn(column ...string)
 	// DropForeign Indicate that the given foreign key should be dropped.
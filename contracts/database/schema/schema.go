@@ -10,6 +10,10 @@ type Schema interface {
 	Connection(name string) Schema
 	// Create a new table on the schema.
 	Create(table string, callback func(table Blueprint)) error
+	// CreateMaterializedView Create a new materialized view, the query can be a db.Query or a raw SQL. (PostgreSQL only)
+	CreateMaterializedView(name string, query any) error
+	// CreateView Create a new view, the query can be a db.Query or a raw SQL, replace the view if orReplace is true.
+	CreateView(name string, query any, orReplace ...bool) error
 	// Drop a table from the schema.
 	Drop(table string) error
 	// DropAllTables Drop all tables from the schema.
@@ -22,8 +26,17 @@ type Schema interface {
 	DropColumns(table string, columns []string) error
 	// DropIfExists Drop a table from the schema if exists.
 	DropIfExists(table string) error
+	// DropMaterializedView Drop a materialized view from the schema. (PostgreSQL only)
+	DropMaterializedView(name string) error
+	// DropView Drop a view from the schema.
+	DropView(name string) error
+	// Dump the database structure and the contents of the migration table to the given path, only the tables,
+	// columns, indexes and foreign keys are dumped if the driver doesn't implement driver.SchemaDumper.
+	Dump(path, migrationTable string) error
 	// Extend the schema with given extend parameter.
 	Extend(extend Extension) Schema
+	// GetChecks Get the check constraints for a given table, the grammar must implement driver.CompileCheckGrammar.
+	GetChecks(table string) ([]driver.Check, error)
 	// GetColumnListing Get the column listing for a given table.
 	GetColumnListing(table string) []string
 	// GetColumns Get the columns for a given table.
@@ -58,12 +71,20 @@ type Schema interface {
 	HasType(name string) bool
 	// HasView Determine if the given view exists.
 	HasView(name string) bool
+	// Load the database structure from a dump.
+	Load(path string) error
 	// Migrations Get the migrations.
 	Migrations() []Migration
 	// Orm Get the orm instance.
 	Orm() orm.Orm
+	// Pretend runs the callback without executing the schema changes, returns the SQL that would be executed. The
+	// tables, views and types dropped in the pretend mode are treated as dropped until the outermost Pretend returns.
+	Pretend(callback func() error) ([]string, error)
 	// Prune reclaims space or optimizes underlying storage.
 	Prune() error
+	// RefreshMaterializedView Refresh the data of a materialized view, the view can still be read during the refresh
+	// if concurrently is true, it requires a unique index on the view. (PostgreSQL only)
+	RefreshMaterializedView(name string, concurrently ...bool) error
 	// Register migrations.
 	Register([]Migration)
 	// Rename a table on the schema.
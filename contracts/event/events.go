@@ -7,6 +7,10 @@ type Instance interface {
 	Job(event Event, args []Arg) Task
 	// GetEvents gets all registered events.
 	GetEvents() map[Event][]Listener
+	// GetListeners gets all listeners registered by Listen and Subscribe, keyed by event name or wildcard pattern.
+	GetListeners() map[string][]any
+	// GetSubscribers gets all registered event subscribers with the names of the events they subscribed to.
+	GetSubscribers() []RegisteredSubscriber
 	// Listen registers an event listener with the dispatcher.
 	// The event parameter can be a string event name, Event interface, or wildcard pattern (e.g., "user.*").
 	// The listeners parameter can be closures, listener structs, or listener names.
@@ -28,6 +32,27 @@ type Instance interface {
 	Flush(event string) error
 }
 
+// Fake is an event dispatcher that records dispatched events instead of calling their listeners, the model events of
+// the ORM are not dispatched by the event dispatcher, they are passed to the observers directly, so they can't be faked,
+// use orm.Query.WithoutEvents to skip them.
+type Fake interface {
+	Instance
+	// AssertDispatched asserts that the event was dispatched, the optional callback can be used to match the payload.
+	AssertDispatched(event any, callback ...func(payload []any) bool) bool
+	// AssertDispatchedTimes asserts that the event was dispatched the given number of times.
+	AssertDispatchedTimes(event any, times int) bool
+	// AssertNotDispatched asserts that the event was not dispatched, the optional callback can be used to match the payload.
+	AssertNotDispatched(event any, callback ...func(payload []any) bool) bool
+	// AssertNothingDispatched asserts that no events were dispatched.
+	AssertNothingDispatched() bool
+	// AssertListening asserts that the listener is registered for the event.
+	AssertListening(event any, listener any) bool
+	// Dispatched gets the payloads of the dispatched event, the optional callback can be used to filter them.
+	Dispatched(event any, callback ...func(payload []any) bool) [][]any
+	// HasDispatched determines if the event was dispatched.
+	HasDispatched(event any) bool
+}
+
 type Event interface {
 	// Handle the event.
 	Handle(args []Arg) ([]Arg, error)
@@ -66,6 +91,12 @@ type Subscriber interface {
 	Subscribe(dispatcher Instance) map[any][]any
 }
 
+// RegisteredSubscriber is an event subscriber registered by Subscribe, the events are recorded when it's registered.
+type RegisteredSubscriber struct {
+	Subscriber Subscriber
+	Events     []string
+}
+
 // ShouldQueue indicates that a listener should be queued.
 // When a listener implements this interface, it will be executed asynchronously via the queue system.
 type ShouldQueue interface {
@@ -21,6 +21,7 @@ const (
 	Notification = "Notification"
 	Process      = "Process"
 	Orm          = "Orm"
+	Outbox       = "Outbox"
 	Queue        = "Queue"
 	RateLimiter  = "RateLimiter"
 	Route        = "Route"
@@ -52,6 +53,7 @@ var FacadeToBinding = map[string]string{
 	Mail:         binding.Mail,
 	Notification: binding.Notification,
 	Orm:          binding.Orm,
+	Outbox:       binding.Outbox,
 	Process:      binding.Process,
 	Queue:        binding.Queue,
 	RateLimiter:  binding.RateLimiter,
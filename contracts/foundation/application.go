@@ -23,6 +23,7 @@ import (
 	"github.com/rusmanplatd/goravelframework/contracts/log"
 	"github.com/rusmanplatd/goravelframework/contracts/mail"
 	"github.com/rusmanplatd/goravelframework/contracts/notification"
+	"github.com/rusmanplatd/goravelframework/contracts/outbox"
 	"github.com/rusmanplatd/goravelframework/contracts/pipeline"
 	"github.com/rusmanplatd/goravelframework/contracts/process"
 	"github.com/rusmanplatd/goravelframework/contracts/queue"
@@ -155,6 +156,8 @@ type Application interface {
 	MakeNotification() notification.Factory
 	// MakeOrm resolves the orm instance.
 	MakeOrm() orm.Orm
+	// MakeOutbox resolves the outbox instance.
+	MakeOutbox() outbox.Outbox
 	// MakePipeline resolves the pipeline instance.
 	MakePipeline() pipeline.Pipeline
 	// MakeProcess resolves the process instance.
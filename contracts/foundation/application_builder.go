@@ -26,6 +26,8 @@ type ApplicationBuilder interface {
 	WithGrpcClientInterceptors(groupToInterceptors map[string][]grpc.UnaryClientInterceptor) ApplicationBuilder
 	// WithGrpcServerInterceptors sets gRPC server interceptors for the application.
 	WithGrpcServerInterceptors(interceptors []grpc.UnaryServerInterceptor) ApplicationBuilder
+	// WithListeners registers event listeners by event name, wildcard pattern or event value, eg: the discovered listeners.
+	WithListeners(eventToListeners map[any][]any) ApplicationBuilder
 	// WithMiddleware registers the http's middleware.
 	WithMiddleware(fn func(handler configuration.Middleware)) ApplicationBuilder
 	// WithMigrations registers the database migrations.
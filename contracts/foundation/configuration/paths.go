@@ -2,6 +2,7 @@ package configuration
 
 type Paths interface {
 	Bootstrap(path string) Paths
+	Cast(path string) Paths
 	Command(path string) Paths
 	Controller(path string) Paths
 	Event(path string) Paths
@@ -18,6 +19,7 @@ type Paths interface {
 	Policy(path string) Paths
 	Provider(path string) Paths
 	Request(path string) Paths
+	Resource(path string) Paths
 	Rule(path string) Paths
 	Seeder(path string) Paths
 	Test(path string) Paths
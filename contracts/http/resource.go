@@ -0,0 +1,6 @@
+package http
+
+type Resource interface {
+	// ToArray transforms the resource into a map that is sent as the JSON response.
+	ToArray(ctx Context) map[string]any
+}
@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/contracts/queue"
+)
+
+type Outbox interface {
+	// Tx returns a publisher that stores the messages in the outbox table within the given transaction,
+	// the messages are relayed only after the transaction is committed.
+	Tx(tx orm.Query) Publisher
+	// Register registers the event types that can be restored from the outbox table.
+	// The events stored by Tx in the same process are registered automatically.
+	Register(events ...any)
+	// Relay relays a batch of pending messages to the event dispatcher and the queue,
+	// returns the number of relayed messages.
+	Relay() (int, error)
+	// Relayer creates a relayer that keeps relaying the pending messages until it is shut down.
+	Relayer() Relayer
+}
+
+type Publisher interface {
+	// Key sets the aggregate key, the messages with the same key are relayed in the order they were stored.
+	Key(key string) Publisher
+	// Dispatch stores an event that will be dispatched by the relay.
+	Dispatch(event any, payload ...any) error
+	// Job creates a pending job that will be pushed to the queue by the relay.
+	Job(job queue.Job, args ...[]queue.Arg) PendingJob
+}
+
+type PendingJob interface {
+	// Delay pushes the job to the queue with the given delay.
+	Delay(time time.Time) PendingJob
+	// Dispatch stores the job in the outbox table.
+	Dispatch() error
+	// OnConnection sets the queue connection of the job.
+	OnConnection(connection string) PendingJob
+	// OnQueue sets the queue of the job.
+	OnQueue(queue string) PendingJob
+}
+
+type Relayer interface {
+	// Run relays the pending messages until Shutdown is called.
+	Run() error
+	// Shutdown stops the relayer.
+	Shutdown() error
+}
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"context"
 	"time"
 )
 
@@ -22,6 +23,11 @@ type PendingJob interface {
 	OnConnection(connection string) PendingJob
 	// OnQueue sets the queue of the task.
 	OnQueue(queue string) PendingJob
+	// OnTenant sets the tenant of the task, it's passed to the job that implements JobWithContext.
+	OnTenant(tenant string) PendingJob
+	// WithContext sets the tenant of the task to the tenant of the given context, see tenancy.WithTenant,
+	// the tenant isn't changed if the context doesn't carry one.
+	WithContext(ctx context.Context) PendingJob
 }
 
 type ReservedJob interface {
@@ -45,6 +51,12 @@ type ChainJob struct {
 	Args  []Arg     `json:"args"`
 }
 
+type JobWithContext interface {
+	// HandleWithContext executes the job with the context that carries the tenant of the task, it's called instead
+	// of Handle, the tenant can be got by tenancy.FromContext(ctx).
+	HandleWithContext(ctx context.Context, args ...any) error
+}
+
 type JobWithShouldRetry interface {
 	// ShouldRetry determines if the job should be retried based on the error.
 	ShouldRetry(err error, attempt int) (retryable bool, delay time.Duration)
@@ -2,6 +2,7 @@ package queue
 
 type Task struct {
 	ChainJob
-	UUID  string     `json:"uuid"`
-	Chain []ChainJob `json:"chain"`
+	UUID   string     `json:"uuid"`
+	Tenant string     `json:"tenant,omitempty"`
+	Chain  []ChainJob `json:"chain"`
 }
@@ -61,6 +61,9 @@ type Event interface {
 	GetCallback() func()
 	// GetName get name.
 	GetName() string
+	// GetTenants get the tenants of the command, all the tenants are resolved by tenancy.All if OnTenants is
+	// called without tenants.
+	GetTenants() ([]string, error)
 	// GetSkipIfStillRunning get skipIfStillRunning bool.
 	GetSkipIfStillRunning() bool
 	// GetDelayIfStillRunning get delayIfStillRunning bool.
@@ -99,10 +102,15 @@ type Event interface {
 	Yearly() Event
 	// IsOnOneServer get isOnOneServer bool.
 	IsOnOneServer() bool
+	// IsOnTenants get isOnTenants bool.
+	IsOnTenants() bool
 	// Name set the event name.
 	Name(name string) Event
 	// OnOneServer only allow the event to run on one server for each cron expression.
 	OnOneServer() Event
+	// OnTenants run the command once for each tenant, the tenant is passed by the --tenant option, the command
+	// runs for all the tenants of tenancy.SetTenantsResolver if no tenant is given.
+	OnTenants(tenants ...string) Event
 	// SkipIfStillRunning if the event is still running, the event will be skipped.
 	SkipIfStillRunning() Event
 }
@@ -0,0 +1,8 @@
+package tenancy
+
+import "github.com/rusmanplatd/goravelframework/contracts/http"
+
+type Resolver interface {
+	// Resolve gets the tenant from the request, an empty string is returned if the tenant is not found.
+	Resolve(ctx http.Context) string
+}
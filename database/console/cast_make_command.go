@@ -0,0 +1,71 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/support"
+	supportconsole "github.com/rusmanplatd/goravelframework/support/console"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+type CastMakeCommand struct {
+}
+
+func NewCastMakeCommand() *CastMakeCommand {
+	return &CastMakeCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *CastMakeCommand) Signature() string {
+	return "make:cast"
+}
+
+// Description The console command description.
+func (r *CastMakeCommand) Description() string {
+	return "Create a new cast class"
+}
+
+// Extend The console command extend.
+func (r *CastMakeCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "make",
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Create the cast even if it already exists",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *CastMakeCommand) Handle(ctx console.Context) error {
+	m, err := supportconsole.NewMake(ctx, "cast", ctx.Argument(0), support.Config.Paths.Cast)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	if err := file.PutContent(m.GetFilePath(), r.populateStub(r.getStub(), m.GetPackageName(), m.GetStructName())); err != nil {
+		return err
+	}
+
+	ctx.Success("Cast created successfully")
+
+	return nil
+}
+
+func (r *CastMakeCommand) getStub() string {
+	return Stubs{}.Cast()
+}
+
+// populateStub Populate the place-holders in the command stub.
+func (r *CastMakeCommand) populateStub(stub string, packageName, structName string) string {
+	stub = strings.ReplaceAll(stub, "DummyCast", structName)
+	stub = strings.ReplaceAll(stub, "DummyPackage", packageName)
+
+	return stub
+}
@@ -0,0 +1,43 @@
+package console
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+func TestCastMakeCommand(t *testing.T) {
+	castMakeCommand := &CastMakeCommand{}
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Argument(0).Return("").Once()
+	mockContext.EXPECT().Ask("Enter the cast name", mock.Anything).Return("", errors.New("the cast name cannot be empty")).Once()
+	mockContext.EXPECT().Error("the cast name cannot be empty").Once()
+	assert.Nil(t, castMakeCommand.Handle(mockContext))
+	assert.False(t, file.Exists("app/casts/user_cast.go"))
+
+	mockContext.EXPECT().Argument(0).Return("UserCast").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Success("Cast created successfully").Once()
+	assert.Nil(t, castMakeCommand.Handle(mockContext))
+	assert.True(t, file.Exists("app/casts/user_cast.go"))
+
+	mockContext.EXPECT().Argument(0).Return("UserCast").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Error("the cast already exists. Use the --force or -f flag to overwrite").Once()
+	assert.Nil(t, castMakeCommand.Handle(mockContext))
+
+	mockContext.EXPECT().Argument(0).Return("User/PhoneCast").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Success("Cast created successfully").Once()
+	assert.Nil(t, castMakeCommand.Handle(mockContext))
+	assert.True(t, file.Exists("app/casts/User/phone_cast.go"))
+	assert.True(t, file.Contain("app/casts/User/phone_cast.go", "package User"))
+	assert.True(t, file.Contain("app/casts/User/phone_cast.go", "type PhoneCast struct"))
+
+	assert.Nil(t, file.Remove("app"))
+}
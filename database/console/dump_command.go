@@ -0,0 +1,140 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/config"
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/contracts/foundation"
+	"github.com/rusmanplatd/goravelframework/database/migration"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/packages"
+	"github.com/rusmanplatd/goravelframework/packages/match"
+	"github.com/rusmanplatd/goravelframework/packages/modify"
+	"github.com/rusmanplatd/goravelframework/support"
+	"github.com/rusmanplatd/goravelframework/support/env"
+	supportfile "github.com/rusmanplatd/goravelframework/support/file"
+	"github.com/rusmanplatd/goravelframework/support/str"
+)
+
+type DumpCommand struct {
+	app    foundation.Application
+	config config.Config
+	schema schema.Schema
+}
+
+func NewDumpCommand(app foundation.Application, config config.Config, schema schema.Schema) *DumpCommand {
+	return &DumpCommand{
+		app:    app,
+		config: config,
+		schema: schema,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *DumpCommand) Signature() string {
+	return "schema:dump"
+}
+
+// Description The console command description.
+func (r *DumpCommand) Description() string {
+	return "Dump the given database schema"
+}
+
+// Extend The console command extend.
+func (r *DumpCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "schema",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "database",
+				Aliases: []string{"d"},
+				Usage:   "The database connection to use",
+			},
+			&command.BoolFlag{
+				Name:  "prune",
+				Usage: "Delete all existing migration files and their registrations",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *DumpCommand) Handle(ctx console.Context) error {
+	connection := ctx.Option("database")
+	if connection == "" {
+		connection = r.config.GetString("database.default")
+	}
+
+	schema := r.schema.Connection(connection)
+	table := r.config.GetString("database.migrations.table")
+	path := migration.SchemaDumpPath(connection)
+
+	if err := schema.Dump(path, table); err != nil {
+		ctx.Error(errors.ConsoleSchemaDumpFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("Database schema dumped to %s", path))
+
+	if !ctx.OptionBool("prune") {
+		return nil
+	}
+
+	ran, err := migration.NewRepository(schema, table).GetRan()
+	if err != nil {
+		ctx.Error(errors.ConsoleSchemaDumpFailed.Args(err).Error())
+		return nil
+	}
+
+	pwd, _ := os.Getwd()
+	var pruned int
+	for _, signature := range ran {
+		file := filepath.Join(pwd, support.Config.Paths.Migration, signature+".go")
+		if !supportfile.Exists(file) {
+			continue
+		}
+
+		// The registration is removed first, the application doesn't compile if the file is removed without it.
+		if err := r.unregisterMigration(signature); err != nil {
+			ctx.Error(errors.MigrationUnregisterFailed.Args(err).Error())
+			return nil
+		}
+
+		if err := supportfile.Remove(file); err != nil {
+			ctx.Error(errors.ConsoleSchemaDumpFailed.Args(err).Error())
+			return nil
+		}
+
+		pruned++
+	}
+
+	if pruned == 0 {
+		ctx.Info("No migration files to prune")
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("Pruned %d migration files", pruned))
+
+	return nil
+}
+
+// unregisterMigration removes the registration of the migration, it's the reverse of the registration of make:migration.
+func (r *DumpCommand) unregisterMigration(signature string) error {
+	structName := str.Of(signature).Prepend("m_").Studly().String()
+	pkg := strings.Join(append([]string{packages.GetModuleName()}, strings.Split(support.Config.Paths.Migration, string(filepath.Separator))...), "/")
+	if env.IsBootstrapSetup() {
+		return modify.RemoveMigration(pkg, fmt.Sprintf("&%s.%s{}", filepath.Base(support.Config.Paths.Migration), structName))
+	}
+
+	// DEPRECATED: The kernel file will be removed in future versions.
+	return modify.GoFile(r.app.DatabasePath("kernel.go")).
+		Find(match.Migrations()).Modify(modify.Unregister(fmt.Sprintf("&migrations.%s{}", structName))).
+		Find(match.Imports()).Modify(modify.RemoveImport(pkg)).
+		Apply()
+}
@@ -0,0 +1,178 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/rusmanplatd/goravelframework/database/migration"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksconfig "github.com/rusmanplatd/goravelframework/mocks/config"
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+	mocksschema "github.com/rusmanplatd/goravelframework/mocks/database/schema"
+	mocksfoundation "github.com/rusmanplatd/goravelframework/mocks/foundation"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+func TestDumpCommand(t *testing.T) {
+	var (
+		mockApp     *mocksfoundation.Application
+		mockContext *mocksconsole.Context
+		mockConfig  *mocksconfig.Config
+		mockSchema  *mocksschema.Schema
+	)
+
+	pwd, _ := os.Getwd()
+	path := migration.SchemaDumpPath("postgres")
+	migrationFile := filepath.Join(pwd, "database", "migrations", "20240817214501_create_users_table.go")
+
+	beforeEach := func() {
+		mockApp = mocksfoundation.NewApplication(t)
+		mockContext = mocksconsole.NewContext(t)
+		mockConfig = mocksconfig.NewConfig(t)
+		mockSchema = mocksschema.NewSchema(t)
+	}
+
+	bootstrapContent := `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/foundation"
+
+	"github.com/rusmanplatd/goravelframework/database/migrations"
+)
+
+func Boot() {
+	foundation.Setup().WithMigrations([]schema.Migration{
+		&migrations.M20240817214501CreateUsersTable{},
+		&migrations.M20240817214502CreateAgentsTable{},
+	}).Run()
+}
+`
+
+	mockRan := func() {
+		mockOrm := mocksorm.NewOrm(t)
+		mockQuery := mocksorm.NewQuery(t)
+		mockSchema.EXPECT().Orm().Return(mockOrm).Once()
+		mockOrm.EXPECT().Query().Return(mockQuery).Once()
+		mockQuery.EXPECT().Table("migrations").Return(mockQuery).Once()
+		mockQuery.EXPECT().OrderBy("batch").Return(mockQuery).Once()
+		mockQuery.EXPECT().OrderBy("migration").Return(mockQuery).Once()
+		mockQuery.EXPECT().Pluck("migration", mock.Anything).RunAndReturn(func(column string, dest any) error {
+			*dest.(*[]string) = []string{"20240817214501_create_users_table", "20240817214502_create_agents_table"}
+
+			return nil
+		}).Once()
+	}
+
+	tests := []struct {
+		name   string
+		setup  func()
+		assert func()
+	}{
+		{
+			name: "Happy path - default connection",
+			setup: func() {
+				mockContext.EXPECT().Option("database").Return("").Once()
+				mockConfig.EXPECT().GetString("database.default").Return("postgres").Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockConfig.EXPECT().GetString("database.migrations.table").Return("migrations").Once()
+				mockSchema.EXPECT().Dump(path, "migrations").Return(nil).Once()
+				mockContext.EXPECT().Success("Database schema dumped to " + path).Once()
+				mockContext.EXPECT().OptionBool("prune").Return(false).Once()
+			},
+		},
+		{
+			name: "Sad path - dump failed",
+			setup: func() {
+				mockContext.EXPECT().Option("database").Return("postgres").Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockConfig.EXPECT().GetString("database.migrations.table").Return("migrations").Once()
+				mockSchema.EXPECT().Dump(path, "migrations").Return(assert.AnError).Once()
+				mockContext.EXPECT().Error(errors.ConsoleSchemaDumpFailed.Args(assert.AnError).Error()).Once()
+			},
+		},
+		{
+			name: "Happy path - prune",
+			setup: func() {
+				assert.NoError(t, file.PutContent(migrationFile, "package migrations"))
+				assert.NoError(t, file.PutContent("bootstrap/app.go", bootstrapContent))
+
+				mockContext.EXPECT().Option("database").Return("postgres").Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockConfig.EXPECT().GetString("database.migrations.table").Return("migrations").Once()
+				mockSchema.EXPECT().Dump(path, "migrations").Return(nil).Once()
+				mockContext.EXPECT().Success("Database schema dumped to " + path).Once()
+				mockContext.EXPECT().OptionBool("prune").Return(true).Once()
+				mockRan()
+
+				mockContext.EXPECT().Success("Pruned 1 migration files").Once()
+			},
+			assert: func() {
+				assert.False(t, file.Exists(migrationFile))
+
+				// The registration of the pruned migration is removed, the other registrations are kept.
+				content, err := file.GetContent("bootstrap/app.go")
+				assert.NoError(t, err)
+				assert.NotContains(t, content, "M20240817214501CreateUsersTable")
+				assert.Contains(t, content, "&migrations.M20240817214502CreateAgentsTable{}")
+			},
+		},
+		{
+			name: "Sad path - failed to remove the registration",
+			setup: func() {
+				assert.NoError(t, file.PutContent(migrationFile, "package migrations"))
+				assert.NoError(t, file.PutContent("bootstrap/app.go", `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+)
+
+func Boot() {
+	foundation.Setup().WithMigrations(
+}
+`))
+
+				mockContext.EXPECT().Option("database").Return("postgres").Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockConfig.EXPECT().GetString("database.migrations.table").Return("migrations").Once()
+				mockSchema.EXPECT().Dump(path, "migrations").Return(nil).Once()
+				mockContext.EXPECT().Success("Database schema dumped to " + path).Once()
+				mockContext.EXPECT().OptionBool("prune").Return(true).Once()
+				mockRan()
+
+				mockContext.EXPECT().Error(mock.MatchedBy(func(msg string) bool {
+					return strings.Contains(msg, errors.MigrationUnregisterFailed.Args("").Error())
+				})).Once()
+			},
+			assert: func() {
+				// The file is kept, so the application still compiles.
+				assert.True(t, file.Exists(migrationFile))
+			},
+		},
+	}
+
+	defer func() {
+		assert.NoError(t, file.Remove("bootstrap"))
+		assert.NoError(t, file.Remove("database"))
+	}()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+
+			command := NewDumpCommand(mockApp, mockConfig, mockSchema)
+			assert.NoError(t, command.Handle(mockContext))
+
+			if test.assert != nil {
+				test.assert()
+			}
+		})
+	}
+}
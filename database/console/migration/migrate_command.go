@@ -29,12 +29,45 @@ func (r *MigrateCommand) Description() string {
 
 // Extend The console command extend.
 func (r *MigrateCommand) Extend() command.Extend {
-	return command.Extend{}
+	return command.Extend{
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:  "pretend",
+				Usage: "dump the SQL queries that would be run",
+			},
+			&command.BoolFlag{
+				Name:  "isolated",
+				Usage: "do not run the migrations if another process is running them",
+			},
+		},
+	}
 }
 
 // Handle Execute the console command.
 func (r *MigrateCommand) Handle(ctx console.Context) error {
-	if err := r.migrator.Run(); err != nil {
+	if ctx.OptionBool("pretend") {
+		pretended, err := r.migrator.PretendRun()
+		if err != nil {
+			ctx.Error(errors.MigrationMigrateFailed.Args(err).Error())
+			return nil
+		}
+
+		printPretended(ctx, pretended)
+
+		return nil
+	}
+
+	run := r.migrator.Run
+	if ctx.OptionBool("isolated") {
+		run = r.migrator.RunIsolated
+	}
+
+	if err := run(); err != nil {
+		if errors.Is(err, errors.MigrationLocked) {
+			ctx.Info("Migrations are being run by another process")
+			return nil
+		}
+
 		ctx.Error(errors.MigrationMigrateFailed.Args(err).Error())
 		return nil
 	}
@@ -43,3 +76,22 @@ func (r *MigrateCommand) Handle(ctx console.Context) error {
 
 	return nil
 }
+
+// printPretended prints the SQL of the pretended migrations, grouped by migration.
+func printPretended(ctx console.Context, pretended []migration.Pretended) {
+	if len(pretended) == 0 {
+		ctx.Info("Nothing to migrate")
+		return
+	}
+
+	for _, item := range pretended {
+		ctx.Info(item.Migration)
+		if len(item.Sqls) == 0 {
+			ctx.Line("  -- no SQL statements")
+		}
+		for _, sql := range item.Sqls {
+			ctx.Line("  " + sql + ";")
+		}
+		ctx.NewLine()
+	}
+}
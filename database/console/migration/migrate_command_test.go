@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rusmanplatd/goravelframework/contracts/database/migration"
 	"github.com/rusmanplatd/goravelframework/errors"
 	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
 	mocksmigration "github.com/rusmanplatd/goravelframework/mocks/database/migration"
@@ -28,6 +29,8 @@ func TestMigrateCommand(t *testing.T) {
 		{
 			name: "Happy path",
 			setup: func() {
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockContext.EXPECT().OptionBool("isolated").Return(false).Once()
 				mockMigrator.EXPECT().Run().Return(nil).Once()
 				mockContext.EXPECT().Success("Migration success").Once()
 			},
@@ -35,10 +38,50 @@ func TestMigrateCommand(t *testing.T) {
 		{
 			name: "Sad path - run failed",
 			setup: func() {
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockContext.EXPECT().OptionBool("isolated").Return(false).Once()
 				mockMigrator.EXPECT().Run().Return(assert.AnError).Once()
 				mockContext.EXPECT().Error(errors.MigrationMigrateFailed.Args(assert.AnError).Error()).Once()
 			},
 		},
+		{
+			name: "Isolated",
+			setup: func() {
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockContext.EXPECT().OptionBool("isolated").Return(true).Once()
+				mockMigrator.EXPECT().RunIsolated().Return(nil).Once()
+				mockContext.EXPECT().Success("Migration success").Once()
+			},
+		},
+		{
+			name: "Isolated - locked by another process",
+			setup: func() {
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockContext.EXPECT().OptionBool("isolated").Return(true).Once()
+				mockMigrator.EXPECT().RunIsolated().Return(errors.MigrationLocked).Once()
+				mockContext.EXPECT().Info("Migrations are being run by another process").Once()
+			},
+		},
+		{
+			name: "Pretend",
+			setup: func() {
+				mockContext.EXPECT().OptionBool("pretend").Return(true).Once()
+				mockMigrator.EXPECT().PretendRun().Return([]migration.Pretended{
+					{Migration: "20240817214501_create_users_table", Sqls: []string{"create table \"users\" (\"name\" varchar(255) not null)"}},
+				}, nil).Once()
+				mockContext.EXPECT().Info("20240817214501_create_users_table").Once()
+				mockContext.EXPECT().Line("  create table \"users\" (\"name\" varchar(255) not null);").Once()
+				mockContext.EXPECT().NewLine().Once()
+			},
+		},
+		{
+			name: "Pretend - nothing to migrate",
+			setup: func() {
+				mockContext.EXPECT().OptionBool("pretend").Return(true).Once()
+				mockMigrator.EXPECT().PretendRun().Return(nil, nil).Once()
+				mockContext.EXPECT().Info("Nothing to migrate").Once()
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,158 @@
+package migration
+
+import (
+	"fmt"
+	"slices"
+
+	gormschema "gorm.io/gorm/schema"
+
+	"github.com/rusmanplatd/goravelframework/contracts/config"
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/contracts/foundation"
+	"github.com/rusmanplatd/goravelframework/database/migration"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support"
+	supportconsole "github.com/rusmanplatd/goravelframework/support/console"
+	supportfile "github.com/rusmanplatd/goravelframework/support/file"
+)
+
+type MigrateDiffCommand struct {
+	app    foundation.Application
+	config config.Config
+	schema schema.Schema
+}
+
+func NewMigrateDiffCommand(app foundation.Application, config config.Config, schema schema.Schema) *MigrateDiffCommand {
+	return &MigrateDiffCommand{
+		app:    app,
+		config: config,
+		schema: schema,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *MigrateDiffCommand) Signature() string {
+	return "migrate:diff"
+}
+
+// Description The console command description.
+func (r *MigrateDiffCommand) Description() string {
+	return "Create a new migration file by comparing the models with the database"
+}
+
+// Extend The console command extend.
+func (r *MigrateDiffCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "migrate",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:    "database",
+				Aliases: []string{"d"},
+				Usage:   "The database connection to use",
+			},
+			&command.StringSliceFlag{
+				Name:    "model",
+				Aliases: []string{"m"},
+				Usage:   "Only compare the given models, the struct or table name can be used",
+			},
+			&command.BoolFlag{
+				Name:  "drop",
+				Usage: "Drop the columns that aren't defined by the models",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *MigrateDiffCommand) Handle(ctx console.Context) error {
+	defaultConnection := r.config.GetString("database.default")
+	connection := ctx.Option("database")
+	if connection == "" {
+		connection = defaultConnection
+	}
+
+	name := ctx.Argument(0)
+	if name == "" {
+		name = "diff_models"
+	}
+
+	make, err := supportconsole.NewMake(ctx, "migration", name, support.Config.Paths.Migration)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	prefix := r.config.GetString(fmt.Sprintf("database.connections.%s.prefix", connection))
+	naming := gormschema.NamingStrategy{
+		TablePrefix:   prefix,
+		SingularTable: r.config.GetBool(fmt.Sprintf("database.connections.%s.singular", connection)),
+		NoLowerCase:   r.config.GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", connection)),
+	}
+	if replacer, ok := r.config.Get(fmt.Sprintf("database.connections.%s.name_replacer", connection)).(gormschema.Replacer); ok {
+		naming.NameReplacer = replacer
+	}
+
+	tables, err := migration.ParseModels(support.Config.Paths.Model, naming)
+	if err != nil {
+		ctx.Error(errors.MigrationDiffFailed.Args(err).Error())
+		return nil
+	}
+
+	if models := ctx.OptionSlice("model"); len(models) > 0 {
+		tables = slices.DeleteFunc(tables, func(table *migration.ModelTable) bool {
+			return !slices.Contains(models, table.Model) && !slices.Contains(models, table.Name)
+		})
+	}
+
+	if len(tables) == 0 {
+		ctx.Warning("No models found")
+		return nil
+	}
+
+	driver := r.config.GetString(fmt.Sprintf("database.connections.%s.driver", connection))
+	differ := migration.NewDiffer(r.schema.Connection(connection), driver, prefix, ctx.OptionBool("drop"))
+	up, down, err := differ.Diff(tables)
+	if err != nil {
+		ctx.Error(errors.MigrationDiffFailed.Args(err).Error())
+		return nil
+	}
+
+	for _, warning := range differ.Warnings() {
+		ctx.Warning(warning)
+	}
+
+	if up == "" {
+		ctx.Info("Nothing to migrate, the database is up to date with the models")
+		return nil
+	}
+
+	if connection == defaultConnection {
+		connection = ""
+	}
+
+	creator := migration.NewCreator()
+	fileName := creator.GetFileName(make.GetName())
+	content, err := migration.RenderDiff(fileName, connection, up, down)
+	if err != nil {
+		ctx.Error(errors.MigrationDiffFailed.Args(err).Error())
+		return nil
+	}
+
+	if err := supportfile.PutContent(creator.GetPath(fileName), content); err != nil {
+		ctx.Error(errors.MigrationCreateFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("Created Migration: %s", make.GetName()))
+
+	if err := registerMigration(r.app, make, fileName); err != nil {
+		ctx.Error(errors.MigrationRegisterFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success("Migration registered successfully")
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksconfig "github.com/rusmanplatd/goravelframework/mocks/config"
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksschema "github.com/rusmanplatd/goravelframework/mocks/database/schema"
+	mocksfoundation "github.com/rusmanplatd/goravelframework/mocks/foundation"
+	"github.com/rusmanplatd/goravelframework/support"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+func TestMigrateDiffCommand(t *testing.T) {
+	var (
+		mockApp     *mocksfoundation.Application
+		mockConfig  *mocksconfig.Config
+		mockContext *mocksconsole.Context
+		mockSchema  *mocksschema.Schema
+	)
+
+	modelPath := support.Config.Paths.Model
+	support.Config.Paths.Model = filepath.Join(t.TempDir(), "models")
+	t.Cleanup(func() {
+		support.Config.Paths.Model = modelPath
+	})
+
+	assert.NoError(t, file.PutContent(filepath.Join(support.Config.Paths.Model, "user.go"), `package models
+
+import (
+	"github.com/rusmanplatd/goravelframework/database/orm"
+)
+
+type User struct {
+	orm.Model
+	Name string
+}
+`))
+
+	beforeEach := func() {
+		mockApp = mocksfoundation.NewApplication(t)
+		mockConfig = mocksconfig.NewConfig(t)
+		mockContext = mocksconsole.NewContext(t)
+		mockSchema = mocksschema.NewSchema(t)
+	}
+
+	mockParse := func() {
+		mockConfig.EXPECT().GetString("database.default").Return("postgres").Once()
+		mockContext.EXPECT().Option("database").Return("").Once()
+		mockContext.EXPECT().Argument(0).Return("").Once()
+		mockContext.EXPECT().OptionBool("force").Return(false).Once()
+		mockConfig.EXPECT().GetString("database.connections.postgres.prefix").Return("").Once()
+		mockConfig.EXPECT().GetBool("database.connections.postgres.singular").Return(false).Once()
+		mockConfig.EXPECT().GetBool("database.connections.postgres.no_lower_case").Return(false).Once()
+		mockConfig.EXPECT().Get("database.connections.postgres.name_replacer").Return(nil).Once()
+	}
+
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "No models found",
+			setup: func() {
+				mockParse()
+				mockContext.EXPECT().OptionSlice("model").Return([]string{"Post"}).Once()
+				mockContext.EXPECT().Warning("No models found").Once()
+			},
+		},
+		{
+			name: "Nothing to migrate",
+			setup: func() {
+				mockParse()
+				mockContext.EXPECT().OptionSlice("model").Return(nil).Once()
+				mockConfig.EXPECT().GetString("database.connections.postgres.driver").Return("postgres").Once()
+				mockContext.EXPECT().OptionBool("drop").Return(false).Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockSchema.EXPECT().HasTable("users").Return(true).Once()
+				mockSchema.EXPECT().GetColumns("users").Return([]driver.Column{
+					{Name: "id", Type: "bigint", TypeName: "int8", Autoincrement: true},
+					{Name: "created_at", Type: "timestamp", TypeName: "timestamp", Nullable: true},
+					{Name: "updated_at", Type: "timestamp", TypeName: "timestamp", Nullable: true},
+					{Name: "name", Type: "varchar(255)", TypeName: "varchar"},
+					{Name: "nickname", Type: "varchar(255)", TypeName: "varchar"},
+				}, nil).Once()
+				mockSchema.EXPECT().GetIndexes("users").Return(nil, nil).Once()
+				mockSchema.EXPECT().GetForeignKeys("users").Return(nil, nil).Once()
+				mockContext.EXPECT().Warning("The column users.nickname isn't defined by the models, it's dropped only with the --drop option").Once()
+				mockContext.EXPECT().Info("Nothing to migrate, the database is up to date with the models").Once()
+			},
+		},
+		{
+			name: "Sad path - failed to diff",
+			setup: func() {
+				mockParse()
+				mockContext.EXPECT().OptionSlice("model").Return([]string{"users"}).Once()
+				mockConfig.EXPECT().GetString("database.connections.postgres.driver").Return("postgres").Once()
+				mockContext.EXPECT().OptionBool("drop").Return(false).Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockSchema.EXPECT().HasTable("users").Return(true).Once()
+				mockSchema.EXPECT().GetColumns("users").Return(nil, assert.AnError).Once()
+				mockContext.EXPECT().Error(errors.MigrationDiffFailed.Args(assert.AnError).Error()).Once()
+			},
+		},
+		{
+			name: "Happy path",
+			setup: func() {
+				mockParse()
+				mockContext.EXPECT().OptionSlice("model").Return([]string{"User"}).Once()
+				mockConfig.EXPECT().GetString("database.connections.postgres.driver").Return("postgres").Once()
+				mockContext.EXPECT().OptionBool("drop").Return(false).Once()
+				mockSchema.EXPECT().Connection("postgres").Return(mockSchema).Once()
+				mockSchema.EXPECT().HasTable("users").Return(false).Once()
+				mockContext.EXPECT().Success("Created Migration: diff_models").Once()
+				mockApp.EXPECT().DatabasePath("kernel.go").Return("database/kernel.go").Once()
+				mockContext.EXPECT().Error(mock.MatchedBy(func(msg string) bool {
+					return strings.Contains(msg, errors.MigrationRegisterFailed.Error())
+				})).Once()
+				t.Cleanup(func() {
+					assert.NoError(t, file.Remove("database"))
+				})
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+
+			migrateDiffCommand := NewMigrateDiffCommand(mockApp, mockConfig, mockSchema)
+			err := migrateDiffCommand.Handle(mockContext)
+
+			assert.NoError(t, err)
+		})
+	}
+}
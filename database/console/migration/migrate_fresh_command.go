@@ -44,13 +44,42 @@ func (r *MigrateFreshCommand) Extend() command.Extend {
 				Name:  "seeder",
 				Usage: "specify the seeder(s) to use for seeding the database",
 			},
+			&command.BoolFlag{
+				Name:  "pretend",
+				Usage: "dump the SQL queries that would be run",
+			},
+			&command.BoolFlag{
+				Name:  "drop-views",
+				Usage: "drop all views",
+			},
+			&command.BoolFlag{
+				Name:  "drop-types",
+				Usage: "drop all types (Postgres only)",
+			},
 		},
 	}
 }
 
 // Handle Execute the console command.
 func (r *MigrateFreshCommand) Handle(ctx console.Context) error {
-	if err := r.migrator.Fresh(); err != nil {
+	options := migration.FreshOptions{
+		DropTypes: ctx.OptionBool("drop-types"),
+		DropViews: ctx.OptionBool("drop-views"),
+	}
+
+	if ctx.OptionBool("pretend") {
+		pretended, err := r.migrator.PretendFresh(options)
+		if err != nil {
+			ctx.Error(errors.MigrationFreshFailed.Args(err).Error())
+			return nil
+		}
+
+		printPretended(ctx, pretended)
+
+		return nil
+	}
+
+	if err := r.migrator.Fresh(options); err != nil {
 		ctx.Error(errors.MigrationFreshFailed.Args(err).Error())
 		return nil
 	}
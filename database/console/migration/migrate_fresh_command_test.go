@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rusmanplatd/goravelframework/contracts/database/migration"
 	"github.com/rusmanplatd/goravelframework/errors"
 	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
 	mocksmigration "github.com/rusmanplatd/goravelframework/mocks/database/migration"
@@ -30,7 +31,10 @@ func TestMigrateFreshCommand(t *testing.T) {
 		{
 			name: "Happy path",
 			setup: func() {
-				mockMigrator.EXPECT().Fresh().Return(nil).Once()
+				mockContext.EXPECT().OptionBool("drop-types").Return(false).Once()
+				mockContext.EXPECT().OptionBool("drop-views").Return(false).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockMigrator.EXPECT().Fresh(migration.FreshOptions{}).Return(nil).Once()
 				mockContext.EXPECT().OptionBool("seed").Return(true).Once()
 				mockContext.EXPECT().OptionSlice("seeder").Return([]string{"UserSeeder", "AgentSeeder"}).Once()
 				mockArtisan.EXPECT().Call("db:seed --seeder UserSeeder,AgentSeeder").Return(nil).Once()
@@ -40,20 +44,53 @@ func TestMigrateFreshCommand(t *testing.T) {
 		{
 			name: "Sad path - fresh failed",
 			setup: func() {
-				mockMigrator.EXPECT().Fresh().Return(assert.AnError).Once()
+				mockContext.EXPECT().OptionBool("drop-types").Return(false).Once()
+				mockContext.EXPECT().OptionBool("drop-views").Return(false).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockMigrator.EXPECT().Fresh(migration.FreshOptions{}).Return(assert.AnError).Once()
 				mockContext.EXPECT().Error(errors.MigrationFreshFailed.Args(assert.AnError).Error()).Once()
 			},
 		},
 		{
 			name: "Sad path - call db:seed failed",
 			setup: func() {
-				mockMigrator.EXPECT().Fresh().Return(nil).Once()
+				mockContext.EXPECT().OptionBool("drop-types").Return(false).Once()
+				mockContext.EXPECT().OptionBool("drop-views").Return(false).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				mockMigrator.EXPECT().Fresh(migration.FreshOptions{}).Return(nil).Once()
 				mockContext.EXPECT().OptionBool("seed").Return(true).Once()
 				mockContext.EXPECT().OptionSlice("seeder").Return([]string{"UserSeeder", "AgentSeeder"}).Once()
 				mockArtisan.EXPECT().Call("db:seed --seeder UserSeeder,AgentSeeder").Return(assert.AnError).Once()
 				mockContext.EXPECT().Error(errors.MigrationFreshFailed.Args(assert.AnError).Error()).Once()
 			},
 		},
+		{
+			name: "Pretend",
+			setup: func() {
+				mockContext.EXPECT().OptionBool("drop-types").Return(true).Once()
+				mockContext.EXPECT().OptionBool("drop-views").Return(true).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(true).Once()
+				mockMigrator.EXPECT().PretendFresh(migration.FreshOptions{DropTypes: true, DropViews: true}).Return([]migration.Pretended{
+					{Migration: "db:wipe", Sqls: []string{"drop table \"users\""}},
+					{Migration: "20240817214501_create_users_table"},
+				}, nil).Once()
+				mockContext.EXPECT().Info("db:wipe").Once()
+				mockContext.EXPECT().Line("  drop table \"users\";").Once()
+				mockContext.EXPECT().Info("20240817214501_create_users_table").Once()
+				mockContext.EXPECT().Line("  -- no SQL statements").Once()
+				mockContext.EXPECT().NewLine().Twice()
+			},
+		},
+		{
+			name: "Sad path - pretend failed",
+			setup: func() {
+				mockContext.EXPECT().OptionBool("drop-types").Return(false).Once()
+				mockContext.EXPECT().OptionBool("drop-views").Return(false).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(true).Once()
+				mockMigrator.EXPECT().PretendFresh(migration.FreshOptions{}).Return(nil, assert.AnError).Once()
+				mockContext.EXPECT().Error(errors.MigrationFreshFailed.Args(assert.AnError).Error()).Once()
+			},
+		},
 	}
 
 	for _, test := range tests {
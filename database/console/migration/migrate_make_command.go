@@ -58,14 +58,7 @@ func (r *MigrateMakeCommand) Handle(ctx console.Context) error {
 
 	ctx.Success(fmt.Sprintf("Created Migration: %s", make.GetName()))
 
-	structName := str.Of(fileName).Prepend("m_").Studly().String()
-	if env.IsBootstrapSetup() {
-		err = modify.AddMigration(make.GetPackageImportPath(), fmt.Sprintf("&%s.%s{}", make.GetPackageName(), structName))
-	} else {
-		err = r.registerInKernel(make.GetPackageImportPath(), structName)
-	}
-
-	if err != nil {
+	if err = registerMigration(r.app, make, fileName); err != nil {
 		ctx.Error(errors.MigrationRegisterFailed.Args(err).Error())
 		return nil
 	}
@@ -75,9 +68,18 @@ func (r *MigrateMakeCommand) Handle(ctx console.Context) error {
 	return nil
 }
 
+func registerMigration(app foundation.Application, make *supportconsole.Make, fileName string) error {
+	structName := str.Of(fileName).Prepend("m_").Studly().String()
+	if env.IsBootstrapSetup() {
+		return modify.AddMigration(make.GetPackageImportPath(), fmt.Sprintf("&%s.%s{}", make.GetPackageName(), structName))
+	}
+
+	return registerInKernel(app, make.GetPackageImportPath(), structName)
+}
+
 // DEPRECATED: The kernel file will be removed in future versions.
-func (r *MigrateMakeCommand) registerInKernel(pkg, structName string) error {
-	return modify.GoFile(r.app.DatabasePath("kernel.go")).
+func registerInKernel(app foundation.Application, pkg, structName string) error {
+	return modify.GoFile(app.DatabasePath("kernel.go")).
 		Find(match.Imports()).Modify(modify.AddImport(pkg)).
 		Find(match.Migrations()).Modify(modify.Register(fmt.Sprintf("&migrations.%s{}", structName))).
 		Apply()
@@ -0,0 +1,76 @@
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/contracts/foundation"
+	"github.com/rusmanplatd/goravelframework/database/migration"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support"
+	supportconsole "github.com/rusmanplatd/goravelframework/support/console"
+	supportfile "github.com/rusmanplatd/goravelframework/support/file"
+)
+
+type MigrateMakeViewCommand struct {
+	app foundation.Application
+}
+
+func NewMigrateMakeViewCommand(app foundation.Application) *MigrateMakeViewCommand {
+	return &MigrateMakeViewCommand{app: app}
+}
+
+// Signature The name and signature of the console command.
+func (r *MigrateMakeViewCommand) Signature() string {
+	return "make:view-migration"
+}
+
+// Description The console command description.
+func (r *MigrateMakeViewCommand) Description() string {
+	return "Create a new migration file for a database view"
+}
+
+// Extend The console command extend.
+func (r *MigrateMakeViewCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "make",
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:    "materialized",
+				Aliases: []string{"m"},
+				Usage:   "Create a materialized view (PostgreSQL only)",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *MigrateMakeViewCommand) Handle(ctx console.Context) error {
+	make, err := supportconsole.NewMake(ctx, "view", ctx.Argument(0), support.Config.Paths.Migration)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	view := make.GetName()
+	name := fmt.Sprintf("create_%s_view", strings.ReplaceAll(view, ".", "_"))
+	creator := migration.NewCreator()
+	fileName := creator.GetFileName(name)
+	if err := supportfile.PutContent(creator.GetPath(fileName), creator.PopulateViewStub(fileName, view, ctx.OptionBool("materialized"))); err != nil {
+		ctx.Error(errors.MigrationCreateFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("Created Migration: %s", name))
+
+	if err := registerMigration(r.app, make, fileName); err != nil {
+		ctx.Error(errors.MigrationRegisterFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success("Migration registered successfully")
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package migration
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksfoundation "github.com/rusmanplatd/goravelframework/mocks/foundation"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+func TestMigrateMakeViewCommand(t *testing.T) {
+	carbon.SetTestNow(carbon.FromDateTime(2024, 9, 15, 6, 1, 48))
+	defer carbon.ClearTestNow()
+
+	tests := []struct {
+		name         string
+		materialized bool
+		expect       []string
+	}{
+		{
+			name: "view",
+			expect: []string{
+				`return facades.Schema().CreateView("reporting.active_users", "select 1", true)`,
+				`return facades.Schema().DropView("reporting.active_users")`,
+			},
+		},
+		{
+			name:         "materialized view",
+			materialized: true,
+			expect: []string{
+				`return facades.Schema().CreateMaterializedView("reporting.active_users", "select 1")`,
+				`return facades.Schema().DropMaterializedView("reporting.active_users")`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockApp := mocksfoundation.NewApplication(t)
+			mockContext := mocksconsole.NewContext(t)
+			mockContext.EXPECT().Argument(0).Return("reporting.active_users").Once()
+			mockContext.EXPECT().OptionBool("force").Return(false).Once()
+			mockContext.EXPECT().OptionBool("materialized").Return(test.materialized).Once()
+			mockContext.EXPECT().Success("Created Migration: create_reporting_active_users_view").Once()
+			mockApp.EXPECT().DatabasePath("kernel.go").Return("database/kernel.go").Once()
+			mockContext.EXPECT().Success("Migration registered successfully").Once()
+			assert.NoError(t, file.PutContent("database/kernel.go", `package database
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+
+	"goravel/database/migrations"
+)
+
+type Kernel struct {
+}
+
+func (kernel Kernel) Migrations() []schema.Migration {
+	return []schema.Migration{}
+}`))
+			defer func() {
+				assert.NoError(t, file.Remove("database"))
+			}()
+
+			assert.NoError(t, NewMigrateMakeViewCommand(mockApp).Handle(mockContext))
+
+			content, err := file.GetContent(filepath.Join("database", "migrations", "20240915060148_create_reporting_active_users_view.go"))
+			assert.NoError(t, err)
+			assert.Contains(t, content, "type M20240915060148CreateReportingActiveUsersView struct{}")
+			for _, expect := range test.expect {
+				assert.Contains(t, content, expect)
+			}
+			assert.True(t, file.Contain("database/kernel.go", "&migrations.M20240915060148CreateReportingActiveUsersView{}"))
+		})
+	}
+}
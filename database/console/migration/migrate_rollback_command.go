@@ -42,6 +42,10 @@ func (r *MigrateRollbackCommand) Extend() command.Extend {
 				Value: 0,
 				Usage: "rollback batch number (only can be used in default driver)",
 			},
+			&command.BoolFlag{
+				Name:  "pretend",
+				Usage: "dump the SQL queries that would be run",
+			},
 		},
 	}
 }
@@ -70,6 +74,18 @@ func (r *MigrateRollbackCommand) Handle(ctx console.Context) error {
 		step = 1
 	}
 
+	if ctx.OptionBool("pretend") {
+		pretended, err := r.migrator.PretendRollback(step, batch)
+		if err != nil {
+			ctx.Error(errors.MigrationMigrateFailed.Args(err).Error())
+			return nil
+		}
+
+		printPretended(ctx, pretended)
+
+		return nil
+	}
+
 	if err := r.migrator.Rollback(step, batch); err != nil {
 		ctx.Error(errors.MigrationMigrateFailed.Args(err).Error())
 		return nil
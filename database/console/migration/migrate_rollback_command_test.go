@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rusmanplatd/goravelframework/contracts/database/migration"
 	"github.com/rusmanplatd/goravelframework/errors"
 	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
 	mocksmigration "github.com/rusmanplatd/goravelframework/mocks/database/migration"
@@ -30,6 +31,7 @@ func TestMigrateRollbackCommand(t *testing.T) {
 			setup: func() {
 				mockContext.EXPECT().OptionInt("step").Return(0).Once()
 				mockContext.EXPECT().OptionInt("batch").Return(0).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
 				mockMigrator.EXPECT().Rollback(1, 0).Return(nil).Once()
 				mockContext.EXPECT().Success("Migration rollback success").Once()
 			},
@@ -63,6 +65,7 @@ func TestMigrateRollbackCommand(t *testing.T) {
 			setup: func() {
 				mockContext.EXPECT().OptionInt("step").Return(2).Once()
 				mockContext.EXPECT().OptionInt("batch").Return(0).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
 				mockMigrator.EXPECT().Rollback(2, 0).Return(nil).Once()
 				mockContext.EXPECT().Success("Migration rollback success").Once()
 			},
@@ -72,15 +75,31 @@ func TestMigrateRollbackCommand(t *testing.T) {
 			setup: func() {
 				mockContext.EXPECT().OptionInt("step").Return(0).Once()
 				mockContext.EXPECT().OptionInt("batch").Return(2).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
 				mockMigrator.EXPECT().Rollback(0, 2).Return(nil).Once()
 				mockContext.EXPECT().Success("Migration rollback success").Once()
 			},
 		},
+		{
+			name: "Pretend",
+			setup: func() {
+				mockContext.EXPECT().OptionInt("step").Return(0).Once()
+				mockContext.EXPECT().OptionInt("batch").Return(0).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(true).Once()
+				mockMigrator.EXPECT().PretendRollback(1, 0).Return([]migration.Pretended{
+					{Migration: "20240817214501_create_users_table", Sqls: []string{"drop table if exists \"users\""}},
+				}, nil).Once()
+				mockContext.EXPECT().Info("20240817214501_create_users_table").Once()
+				mockContext.EXPECT().Line("  drop table if exists \"users\";").Once()
+				mockContext.EXPECT().NewLine().Once()
+			},
+		},
 		{
 			name: "Rollback failed",
 			setup: func() {
 				mockContext.EXPECT().OptionInt("step").Return(0).Once()
 				mockContext.EXPECT().OptionInt("batch").Return(0).Once()
+				mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
 				mockMigrator.EXPECT().Rollback(1, 0).Return(assert.AnError).Once()
 				mockContext.EXPECT().Error(errors.MigrationMigrateFailed.Args(assert.AnError).Error()).Once()
 			},
@@ -0,0 +1,243 @@
+package console
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/rusmanplatd/goravelframework/contracts/config"
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+type PruneCommand struct {
+	config config.Config
+}
+
+func NewPruneCommand(config config.Config) *PruneCommand {
+	return &PruneCommand{
+		config: config,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *PruneCommand) Signature() string {
+	return "model:prune"
+}
+
+// Description The console command description.
+func (r *PruneCommand) Description() string {
+	return "Prune models that are no longer needed"
+}
+
+// Extend The console command extend.
+func (r *PruneCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "model",
+		Flags: []command.Flag{
+			&command.StringSliceFlag{
+				Name:    "model",
+				Aliases: []string{"m"},
+				Usage:   "Only prune the given models, the struct name can be used",
+			},
+			&command.IntFlag{
+				Name:  "chunk",
+				Value: 1000,
+				Usage: "The number of models to retrieve per chunk of models to be deleted",
+			},
+			&command.BoolFlag{
+				Name:  "pretend",
+				Usage: "Display the number of prunable records found instead of deleting them",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *PruneCommand) Handle(ctx console.Context) error {
+	chunk := ctx.OptionInt("chunk")
+	if chunk <= 0 {
+		ctx.Error("The chunk option should be a positive integer")
+		return nil
+	}
+
+	models := r.getModels(ctx.OptionSlice("model"))
+	if len(models) == 0 {
+		ctx.Info("No prunable models found")
+		return nil
+	}
+
+	pretend := ctx.OptionBool("pretend")
+	for _, model := range models {
+		name := modelName(model)
+
+		var (
+			query contractsorm.Query
+			mass  bool
+		)
+		switch m := model.(type) {
+		case contractsorm.MassPrunable:
+			query, mass = m.MassPrunable(), true
+		case contractsorm.Prunable:
+			query = m.Prunable()
+		default:
+			ctx.Warning(fmt.Sprintf("%s is not prunable, it should implement orm.Prunable or orm.MassPrunable", name))
+			continue
+		}
+
+		// The soft deleted models are pruned as well, they are removed by ForceDelete.
+		softDeletes := hasSoftDeletes(model)
+		query = query.Model(model)
+		if softDeletes {
+			query = query.WithTrashed()
+		}
+
+		if pretend {
+			count, err := query.Count()
+			if err != nil {
+				ctx.Error(fmt.Sprintf("Failed to count the prunable %s: %v", name, err))
+				return nil
+			}
+
+			ctx.Info(fmt.Sprintf("%d [%s] records will be pruned", count, name))
+			continue
+		}
+
+		var (
+			total int64
+			err   error
+		)
+		if mass {
+			total, err = massPrune(query, model, chunk, softDeletes)
+		} else {
+			total, err = prune(query, chunk, softDeletes)
+		}
+		if err != nil {
+			ctx.Error(fmt.Sprintf("Failed to prune %s: %v", name, err))
+			return nil
+		}
+
+		ctx.Success(fmt.Sprintf("%d [%s] records have been pruned", total, name))
+	}
+
+	return nil
+}
+
+func (r *PruneCommand) getModels(names []string) []any {
+	models, _ := r.config.Get("database.prunable").([]any)
+	if len(names) == 0 {
+		return models
+	}
+
+	return slices.DeleteFunc(slices.Clone(models), func(model any) bool {
+		return !slices.Contains(names, modelName(model)) && !slices.Contains(names, reflect.Indirect(reflect.ValueOf(model)).Type().String())
+	})
+}
+
+// prune deletes the models one by one, so the events and observers are fired.
+func prune(query contractsorm.Query, chunk int, softDeletes bool) (int64, error) {
+	var total int64
+	err := query.ChunkByID(chunk, "", func(models any) error {
+		items := reflect.ValueOf(models).Elem()
+		for i := 0; i < items.Len(); i++ {
+			item := items.Index(i).Addr().Interface()
+
+			var err error
+			if softDeletes {
+				_, err = query.ForceDelete(item)
+			} else {
+				_, err = query.Delete(item)
+			}
+			if err != nil {
+				return err
+			}
+
+			total++
+		}
+
+		return nil
+	})
+
+	return total, err
+}
+
+// massPrune deletes the models in bulk by chunks, the events and observers are not fired. It stops once a chunk
+// deletes nothing, eg: the delete query is limited by the other conditions, to avoid selecting the same chunk forever.
+func massPrune(query contractsorm.Query, model any, chunk int, softDeletes bool) (int64, error) {
+	primaryKey, err := primaryKeyColumn(model)
+	if err != nil {
+		return 0, err
+	}
+
+	query = query.WithoutEvents()
+
+	var total int64
+	for {
+		var ids []any
+		if err := query.Limit(chunk).Pluck(primaryKey, &ids); err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+
+		deleteQuery := query.WhereIn(primaryKey, ids)
+		deleteFunc := deleteQuery.Delete
+		if softDeletes {
+			deleteFunc = deleteQuery.ForceDelete
+		}
+
+		result, err := deleteFunc()
+		if err != nil {
+			return total, err
+		}
+
+		total += result.RowsAffected
+		if result.RowsAffected == 0 || len(ids) < chunk {
+			return total, nil
+		}
+	}
+}
+
+// primaryKeyColumn gets the primary key column of the model.
+func primaryKeyColumn(model any) (string, error) {
+	modelSchema, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		return "", err
+	}
+	if modelSchema.PrioritizedPrimaryField == nil {
+		return "", errors.OrmModelPrimaryKeyNotFound.Args(modelSchema.Name)
+	}
+
+	return modelSchema.PrioritizedPrimaryField.DBName, nil
+}
+
+func modelName(model any) string {
+	return reflect.Indirect(reflect.ValueOf(model)).Type().Name()
+}
+
+// hasSoftDeletes checks whether the model or its embedded structs have the gorm.DeletedAt field.
+func hasSoftDeletes(model any) bool {
+	var check func(t reflect.Type) bool
+	check = func(t reflect.Type) bool {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Type == reflect.TypeFor[gorm.DeletedAt]() {
+				return true
+			}
+			if field.Anonymous && field.Type.Kind() == reflect.Struct && check(field.Type) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return check(reflect.Indirect(reflect.ValueOf(model)).Type())
+}
@@ -0,0 +1,188 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	mocksconfig "github.com/rusmanplatd/goravelframework/mocks/config"
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+)
+
+var pruneQuery contractsorm.Query
+
+type PrunableLog struct {
+	ID        uint
+	DeletedAt gorm.DeletedAt
+}
+
+func (r *PrunableLog) Prunable() contractsorm.Query {
+	return pruneQuery
+}
+
+type MassPrunableLog struct {
+	ID uint
+}
+
+func (r *MassPrunableLog) MassPrunable() contractsorm.Query {
+	return pruneQuery
+}
+
+type MassPrunableUuidLog struct {
+	Uuid string `gorm:"primaryKey"`
+}
+
+func (r *MassPrunableUuidLog) MassPrunable() contractsorm.Query {
+	return pruneQuery
+}
+
+type NotPrunableLog struct {
+	ID uint
+}
+
+type PruneCommandTestSuite struct {
+	suite.Suite
+	mockConfig   *mocksconfig.Config
+	mockContext  *mocksconsole.Context
+	mockQuery    *mocksorm.Query
+	pruneCommand *PruneCommand
+}
+
+func TestPruneCommandTestSuite(t *testing.T) {
+	suite.Run(t, new(PruneCommandTestSuite))
+}
+
+func (s *PruneCommandTestSuite) SetupTest() {
+	s.mockConfig = mocksconfig.NewConfig(s.T())
+	s.mockContext = mocksconsole.NewContext(s.T())
+	s.mockQuery = mocksorm.NewQuery(s.T())
+	s.pruneCommand = NewPruneCommand(s.mockConfig)
+	pruneQuery = s.mockQuery
+}
+
+func (s *PruneCommandTestSuite) TestHandle() {
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "Invalid chunk",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(0).Once()
+				s.mockContext.EXPECT().Error("The chunk option should be a positive integer").Once()
+			},
+		},
+		{
+			name: "No prunable models",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(1000).Once()
+				s.mockContext.EXPECT().OptionSlice("model").Return([]string{"UnknownLog"}).Once()
+				s.mockConfig.EXPECT().Get("database.prunable").Return([]any{&PrunableLog{}}).Once()
+				s.mockContext.EXPECT().Info("No prunable models found").Once()
+			},
+		},
+		{
+			name: "Pretend",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(1000).Once()
+				s.mockContext.EXPECT().OptionSlice("model").Return(nil).Once()
+				s.mockConfig.EXPECT().Get("database.prunable").Return([]any{&PrunableLog{}, &NotPrunableLog{}}).Once()
+				s.mockContext.EXPECT().OptionBool("pretend").Return(true).Once()
+				s.mockQuery.EXPECT().Model(&PrunableLog{}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().WithTrashed().Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Count().Return(2, nil).Once()
+				s.mockContext.EXPECT().Info("2 [PrunableLog] records will be pruned").Once()
+				s.mockContext.EXPECT().Warning("NotPrunableLog is not prunable, it should implement orm.Prunable or orm.MassPrunable").Once()
+			},
+		},
+		{
+			name: "Prune one by one",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(2).Once()
+				s.mockContext.EXPECT().OptionSlice("model").Return([]string{"PrunableLog"}).Once()
+				s.mockConfig.EXPECT().Get("database.prunable").Return([]any{&PrunableLog{}, &MassPrunableLog{}}).Once()
+				s.mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				s.mockQuery.EXPECT().Model(&PrunableLog{}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().WithTrashed().Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().ChunkByID(2, "", mock.Anything).RunAndReturn(func(size int, column string, callback func(any) error) error {
+					return callback(&[]PrunableLog{{ID: 1}, {ID: 2}})
+				}).Once()
+				s.mockQuery.EXPECT().ForceDelete(&PrunableLog{ID: 1}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+				s.mockQuery.EXPECT().ForceDelete(&PrunableLog{ID: 2}).Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+				s.mockContext.EXPECT().Success("2 [PrunableLog] records have been pruned").Once()
+			},
+		},
+		{
+			name: "Mass prune",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(2).Once()
+				s.mockContext.EXPECT().OptionSlice("model").Return([]string{"console.MassPrunableLog"}).Once()
+				s.mockConfig.EXPECT().Get("database.prunable").Return([]any{&PrunableLog{}, &MassPrunableLog{}}).Once()
+				s.mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				s.mockQuery.EXPECT().Model(&MassPrunableLog{}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().WithoutEvents().Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Limit(2).Return(s.mockQuery).Twice()
+				s.mockQuery.EXPECT().Pluck("id", mock.Anything).RunAndReturn(func(column string, dest any) error {
+					*dest.(*[]any) = []any{1, 2}
+					return nil
+				}).Once()
+				s.mockQuery.EXPECT().WhereIn("id", []any{1, 2}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 2}, nil).Once()
+				s.mockQuery.EXPECT().Pluck("id", mock.Anything).Return(nil).Once()
+				s.mockContext.EXPECT().Success("2 [MassPrunableLog] records have been pruned").Once()
+			},
+		},
+		{
+			name: "Mass prune stops when nothing is deleted",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(2).Once()
+				s.mockContext.EXPECT().OptionSlice("model").Return([]string{"console.MassPrunableLog"}).Once()
+				s.mockConfig.EXPECT().Get("database.prunable").Return([]any{&MassPrunableLog{}}).Once()
+				s.mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				s.mockQuery.EXPECT().Model(&MassPrunableLog{}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().WithoutEvents().Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Limit(2).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Pluck("id", mock.Anything).RunAndReturn(func(column string, dest any) error {
+					*dest.(*[]any) = []any{1, 2}
+					return nil
+				}).Once()
+				s.mockQuery.EXPECT().WhereIn("id", []any{1, 2}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 0}, nil).Once()
+				s.mockContext.EXPECT().Success("0 [MassPrunableLog] records have been pruned").Once()
+			},
+		},
+		{
+			name: "Mass prune by the primary key",
+			setup: func() {
+				s.mockContext.EXPECT().OptionInt("chunk").Return(2).Once()
+				s.mockContext.EXPECT().OptionSlice("model").Return([]string{"console.MassPrunableUuidLog"}).Once()
+				s.mockConfig.EXPECT().Get("database.prunable").Return([]any{&MassPrunableUuidLog{}}).Once()
+				s.mockContext.EXPECT().OptionBool("pretend").Return(false).Once()
+				s.mockQuery.EXPECT().Model(&MassPrunableUuidLog{}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().WithoutEvents().Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Limit(2).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Pluck("uuid", mock.Anything).RunAndReturn(func(column string, dest any) error {
+					*dest.(*[]any) = []any{"a"}
+					return nil
+				}).Once()
+				s.mockQuery.EXPECT().WhereIn("uuid", []any{"a"}).Return(s.mockQuery).Once()
+				s.mockQuery.EXPECT().Delete().Return(&contractsdb.Result{RowsAffected: 1}, nil).Once()
+				s.mockContext.EXPECT().Success("1 [MassPrunableUuidLog] records have been pruned").Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			test.setup()
+
+			s.NoError(s.pruneCommand.Handle(s.mockContext))
+		})
+	}
+}
@@ -30,6 +30,23 @@ type {{.StructName}} struct {
 `
 }
 
+func (r Stubs) Cast() string {
+	return `package DummyPackage
+
+type DummyCast struct{}
+
+// Get converts the value of the column to the value of the model field when the model is retrieved.
+func (r DummyCast) Get(value any) (any, error) {
+	return value, nil
+}
+
+// Set converts the value of the model field to the value of the column when the model is saved.
+func (r DummyCast) Set(value any) (any, error) {
+	return value, nil
+}
+`
+}
+
 func (r Stubs) Observer() string {
 	return `package DummyPackage
 
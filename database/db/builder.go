@@ -2,8 +2,10 @@ package db
 
 import (
 	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
 	"gorm.io/gorm"
 
+	databasedriver "github.com/rusmanplatd/goravelframework/database/driver"
 	"github.com/rusmanplatd/goravelframework/support/str"
 )
 
@@ -41,10 +43,25 @@ func (r *Builder) Explain(sql string, args ...any) string {
 
 type TxBuilder struct {
 	*sqlx.Tx
-	gormDB *gorm.DB
+	gormDB    *gorm.DB
+	savepoint *databasedriver.Savepoint
 }
 
 func NewTxBuilder(gormDB *gorm.DB, driver string) (*TxBuilder, error) {
+	// The queries of the database tests are run in a transaction, a savepoint is begun on it instead.
+	if transaction, ok := gormDB.Statement.ConnPool.(*databasedriver.Transaction); ok {
+		savepoint, err := transaction.Savepoint(gormDB.Statement.Context)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TxBuilder{
+			Tx:        withNameMapper(transaction.Tx),
+			gormDB:    gormDB,
+			savepoint: savepoint,
+		}, nil
+	}
+
 	db, err := gormDB.DB()
 	if err != nil {
 		return nil, err
@@ -63,6 +80,43 @@ func NewTxBuilder(gormDB *gorm.DB, driver string) (*TxBuilder, error) {
 	}, nil
 }
 
+func (r *TxBuilder) Commit() error {
+	if r.savepoint != nil {
+		return r.savepoint.Commit()
+	}
+
+	return r.Tx.Commit()
+}
+
 func (r *TxBuilder) Explain(sql string, args ...any) string {
 	return r.gormDB.Explain(sql, args...)
 }
+
+func (r *TxBuilder) Rollback() error {
+	if r.savepoint != nil {
+		return r.savepoint.Rollback()
+	}
+
+	return r.Tx.Rollback()
+}
+
+// newTestingBuilder returns the builder of the transaction that the queries of the database tests are run in, see
+// databasedriver.BeginTransaction, ok is false if the queries are not run in a transaction.
+func newTestingBuilder(gormDB *gorm.DB) (builder *TxBuilder, ok bool) {
+	transaction, ok := gormDB.Statement.ConnPool.(*databasedriver.Transaction)
+	if !ok {
+		return nil, false
+	}
+
+	return &TxBuilder{
+		Tx:     withNameMapper(transaction.Tx).Unsafe(),
+		gormDB: gormDB,
+	}, true
+}
+
+func withNameMapper(tx *sqlx.Tx) *sqlx.Tx {
+	txWithMapper := *tx
+	txWithMapper.Mapper = reflectx.NewMapperFunc("db", NameMapper)
+
+	return &txWithMapper
+}
@@ -5,6 +5,7 @@ import (
 	databasesql "database/sql"
 	"fmt"
 	"reflect"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/plugin/dbresolver"
@@ -53,7 +54,7 @@ func BuildDB(ctx context.Context, config config.Config, log log.Log, connection
 	}
 
 	pool := driver.Pool()
-	logger := NewLogger(config, log)
+	logger := NewLogger(config, log, connection)
 	gorm, err := databasedriver.BuildGorm(config, logger.ToGorm(), pool, connection)
 	if err != nil {
 		return nil, err
@@ -90,6 +91,18 @@ func (r *DB) Connection(name string) contractsdb.DB {
 	return r.queries[name]
 }
 
+func (r *DB) FlushQueryCache(tables ...string) error {
+	return FlushQueryCache(r.ctx, tables...)
+}
+
+func (r *DB) ListenQueries(callback func(event contractsdb.QueryExecuted)) {
+	ListenQueries(callback)
+}
+
+func (r *DB) TrackQueryTime(ctx context.Context) context.Context {
+	return TrackQueryTime(ctx)
+}
+
 func (r *DB) Transaction(callback func(tx contractsdb.Tx) error) (err error) {
 	tx, err := r.BeginTransaction()
 	if err != nil {
@@ -116,6 +129,10 @@ func (r *DB) Transaction(callback func(tx contractsdb.Tx) error) (err error) {
 	return tx.Commit()
 }
 
+func (r *DB) WhenQueryingForLongerThan(threshold time.Duration, callback func(ctx context.Context, total time.Duration)) {
+	WhenQueryingForLongerThan(threshold, callback)
+}
+
 func (r *DB) WithContext(ctx context.Context) contractsdb.DB {
 	db, err := NewDB(ctx, r.config, r.driver, r.logger, r.gorm)
 	if err != nil {
@@ -127,6 +144,7 @@ func (r *DB) WithContext(ctx context.Context) contractsdb.DB {
 
 type Tx struct {
 	ctx        context.Context
+	connection string
 	grammar    contractsdriver.Grammar
 	logger     contractslogger.Logger
 	txBuilder  contractsdb.TxBuilder
@@ -148,6 +166,7 @@ func NewTx(
 
 	return &Tx{
 		ctx:        ctx,
+		connection: QueryCacheConnection(pool.Writers[0]),
 		driverName: driverName,
 		gormDB:     gormDB,
 		grammar:    driver.Grammar(),
@@ -165,9 +184,12 @@ func (r *Tx) Commit() error {
 	if err := r.txBuilder.Commit(); err != nil {
 		return err
 	}
+	if err := FlushQueryCacheOnCommit(r.ctx, r.txLogs); err != nil {
+		return err
+	}
 
 	for _, log := range *r.txLogs {
-		r.logger.Trace(log.ctx, log.begin, log.sql, log.rowsAffected, log.err)
+		traceTxLog(r.logger, log)
 	}
 
 	return nil
@@ -186,6 +208,8 @@ func (r *Tx) Rollback() error {
 		return errors.DatabaseTransactionNotStarted
 	}
 
+	ForgetQueryCacheFlushes(r.txLogs)
+
 	return r.txBuilder.Rollback()
 }
 
@@ -206,13 +230,14 @@ func (r *Tx) Select(dest any, sql string, args ...any) error {
 	}
 
 	realSql = builder.Explain(sql, args...)
+	now := carbon.Now()
 
 	destValue := reflect.Indirect(reflect.ValueOf(dest))
 
 	rowsAffected := int64(1)
 	if destValue.Kind() == reflect.Slice {
 		if err = builder.SelectContext(r.ctx, dest, realSql, args...); err != nil {
-			r.logger.Trace(r.ctx, carbon.Now(), realSql, -1, err)
+			trace(r.logger, r.ctx, now, realSql, args, -1, err)
 
 			return err
 		}
@@ -220,13 +245,13 @@ func (r *Tx) Select(dest any, sql string, args ...any) error {
 		rowsAffected = int64(destValue.Len())
 	} else {
 		if err = builder.GetContext(r.ctx, dest, realSql, args...); err != nil {
-			r.logger.Trace(r.ctx, carbon.Now(), realSql, -1, err)
+			trace(r.logger, r.ctx, now, realSql, args, -1, err)
 
 			return err
 		}
 	}
 
-	r.logger.Trace(r.ctx, carbon.Now(), realSql, rowsAffected, nil)
+	trace(r.logger, r.ctx, now, realSql, args, rowsAffected, nil)
 
 	return nil
 }
@@ -239,7 +264,10 @@ func (r *Tx) Statement(sql string, args ...any) error {
 
 func (r *Tx) Table(name string) contractsdb.Query {
 	if r.txBuilder != nil {
-		return NewQuery(r.ctx, r.txBuilder, r.txBuilder, r.grammar, r.logger, name, r.txLogs)
+		query := NewQuery(r.ctx, r.txBuilder, r.txBuilder, r.grammar, r.logger, name, r.txLogs)
+		query.connection = r.connection
+
+		return query
 	}
 
 	readBuilder, err := r.readBuilder()
@@ -254,7 +282,10 @@ func (r *Tx) Table(name string) contractsdb.Query {
 		return nil
 	}
 
-	return NewQuery(r.ctx, readBuilder, writeBuilder, r.grammar, r.logger, name, nil)
+	query := NewQuery(r.ctx, readBuilder, writeBuilder, r.grammar, r.logger, name, nil)
+	query.connection = r.connection
+
+	return query
 }
 
 func (r *Tx) Update(sql string, args ...any) (*contractsdb.Result, error) {
@@ -279,24 +310,29 @@ func (r *Tx) exec(sql string, args ...any) (*contractsdb.Result, error) {
 	}
 
 	realSql = builder.Explain(sql, args...)
+	now := carbon.Now()
 	result, err = builder.ExecContext(r.ctx, sql, args...)
 	if err != nil {
-		r.logger.Trace(r.ctx, carbon.Now(), realSql, -1, err)
+		trace(r.logger, r.ctx, now, realSql, args, -1, err)
 		return nil, err
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		r.logger.Trace(r.ctx, carbon.Now(), realSql, -1, err)
+		trace(r.logger, r.ctx, now, realSql, args, -1, err)
 		return nil, err
 	}
 
-	r.logger.Trace(r.ctx, carbon.Now(), realSql, rowsAffected, nil)
+	trace(r.logger, r.ctx, now, realSql, args, rowsAffected, nil)
 
 	return &contractsdb.Result{RowsAffected: rowsAffected}, nil
 }
 
-func (r *Tx) readBuilder() (contractsdb.Builder, error) {
+func (r *Tx) readBuilder() (contractsdb.CommonBuilder, error) {
+	if builder, ok := newTestingBuilder(r.gormDB); ok {
+		return builder, nil
+	}
+
 	builder, err := NewBuilder(r.gormDB.Clauses(dbresolver.Read), r.driverName)
 	if err != nil {
 		return nil, err
@@ -305,7 +341,11 @@ func (r *Tx) readBuilder() (contractsdb.Builder, error) {
 	return builder, nil
 }
 
-func (r *Tx) writeBuilder() (contractsdb.Builder, error) {
+func (r *Tx) writeBuilder() (contractsdb.CommonBuilder, error) {
+	if builder, ok := newTestingBuilder(r.gormDB); ok {
+		return builder, nil
+	}
+
 	builder, err := NewBuilder(r.gormDB.Clauses(dbresolver.Write), r.driverName)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	contractsdriver "github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+var fullTextLanguageRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// CompileWhereFullText compiles the "where full-text" clause of the columns, it's compiled by the grammar if the
+// grammar implements driver.CompileFullTextGrammar, otherwise it's compiled by the driver name. The table is the FTS5
+// virtual table of SQLite, it's used to search multiple columns, the alias of the table is ignored.
+func CompileWhereFullText(grammar contractsdriver.Grammar, driver string, table string, columns []string, value string, options ...contractsdb.FullTextOptions) (string, []any, error) {
+	option := fullTextOption(options...)
+	if compileFullTextGrammar, ok := grammar.(contractsdriver.CompileFullTextGrammar); ok {
+		return compileFullTextGrammar.CompileWhereFullText(columns, value, option)
+	}
+
+	switch driver {
+	case "mysql":
+		against, err := mysqlFullTextAgainst(driver, option)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("MATCH (%s) AGAINST (?%s)", strings.Join(columns, ", "), against), []any{value}, nil
+	case "postgres":
+		vector, query, err := postgresFullText(driver, columns, option)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("(%s) @@ %s", vector, query), []any{value}, nil
+	case "sqlite":
+		// The columns should belong to a FTS5 virtual table, multiple columns are searched by the column filter of
+		// the table, eg: posts MATCH '{title body} : (goravel)', because the MATCH clauses of a table can't be
+		// combined by OR.
+		if len(columns) == 1 {
+			return columns[0] + " MATCH ?", []any{value}, nil
+		}
+
+		table, names := sqliteFullTextColumns(table, columns)
+		if table == "" {
+			return "", nil, errors.DatabaseFullTextTableNotSet.Args(strings.Join(columns, ", "), driver)
+		}
+
+		return table + " MATCH ?", []any{fmt.Sprintf("{%s} : (%s)", strings.Join(names, " "), value)}, nil
+	case "sqlserver":
+		function := "FREETEXT"
+		switch option.Mode {
+		case "natural":
+		case "boolean":
+			function = "CONTAINS"
+		default:
+			return "", nil, errors.DatabaseFullTextModeNotSupported.Args(option.Mode, driver)
+		}
+
+		return fmt.Sprintf("%s((%s), ?)", function, strings.Join(columns, ", ")), []any{value}, nil
+	default:
+		return "", nil, errors.DatabaseFullTextNotSupported.Args(driver)
+	}
+}
+
+// CompileOrderByFullText compiles the order by clause of the full-text relevance, the most relevant first.
+func CompileOrderByFullText(grammar contractsdriver.Grammar, driver string, columns []string, value string, options ...contractsdb.FullTextOptions) (string, []any, error) {
+	option := fullTextOption(options...)
+	if compileFullTextGrammar, ok := grammar.(contractsdriver.CompileFullTextGrammar); ok {
+		return compileFullTextGrammar.CompileOrderByFullText(columns, value, option)
+	}
+
+	switch driver {
+	case "mysql":
+		against, err := mysqlFullTextAgainst(driver, option)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("MATCH (%s) AGAINST (?%s) DESC", strings.Join(columns, ", "), against), []any{value}, nil
+	case "postgres":
+		vector, query, err := postgresFullText(driver, columns, option)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("ts_rank(%s, %s) DESC", vector, query), []any{value}, nil
+	case "sqlite":
+		// The rank of FTS5 is smaller when the row is more relevant.
+		return "rank", nil, nil
+	default:
+		return "", nil, errors.DatabaseFullTextOrderNotSupported.Args(driver)
+	}
+}
+
+func fullTextOption(options ...contractsdb.FullTextOptions) contractsdb.FullTextOptions {
+	var option contractsdb.FullTextOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+	if option.Language == "" {
+		option.Language = "english"
+	}
+	if option.Mode == "" {
+		option.Mode = "natural"
+	}
+
+	return option
+}
+
+// sqliteFullTextColumns gets the table and the column names without the table, the table is got from the qualified
+// columns if it's empty, eg: "posts.title" -> "posts", "title".
+func sqliteFullTextColumns(table string, columns []string) (string, []string) {
+	table = queryCacheTable(table)
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column
+		if index := strings.LastIndex(column, "."); index >= 0 {
+			names[i] = column[index+1:]
+			if table == "" {
+				table = column[:index]
+			}
+		}
+	}
+
+	return table, names
+}
+
+func mysqlFullTextAgainst(driver string, option contractsdb.FullTextOptions) (string, error) {
+	switch option.Mode {
+	case "natural":
+		return " IN NATURAL LANGUAGE MODE", nil
+	case "boolean":
+		return " IN BOOLEAN MODE", nil
+	case "expanded":
+		return " WITH QUERY EXPANSION", nil
+	default:
+		return "", errors.DatabaseFullTextModeNotSupported.Args(option.Mode, driver)
+	}
+}
+
+func postgresFullText(driver string, columns []string, option contractsdb.FullTextOptions) (vector string, query string, err error) {
+	// The language is a part of the SQL, so it's validated to avoid the SQL injection.
+	if !fullTextLanguageRegex.MatchString(option.Language) {
+		return "", "", errors.DatabaseFullTextLanguageInvalid.Args(option.Language)
+	}
+
+	var function string
+	switch option.Mode {
+	case "natural":
+		function = "plainto_tsquery"
+	case "boolean":
+		function = "to_tsquery"
+	case "phrase":
+		function = "phraseto_tsquery"
+	case "websearch":
+		function = "websearch_to_tsquery"
+	default:
+		return "", "", errors.DatabaseFullTextModeNotSupported.Args(option.Mode, driver)
+	}
+
+	vectors := make([]string, len(columns))
+	for i, column := range columns {
+		vectors[i] = fmt.Sprintf("to_tsvector('%s', %s)", option.Language, column)
+	}
+
+	return strings.Join(vectors, " || "), fmt.Sprintf("%s('%s', ?)", function, option.Language), nil
+}
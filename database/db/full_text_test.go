@@ -0,0 +1,195 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksdriver "github.com/rusmanplatd/goravelframework/mocks/database/driver"
+)
+
+type fullTextGrammar struct {
+	*mocksdriver.Grammar
+	*mocksdriver.CompileFullTextGrammar
+}
+
+func TestCompileWhereFullText(t *testing.T) {
+	tests := []struct {
+		name        string
+		driver      string
+		table       string
+		columns     []string
+		options     []contractsdb.FullTextOptions
+		expectSql   string
+		expectArgs  []any
+		expectError error
+	}{
+		{
+			name:       "mysql",
+			driver:     "mysql",
+			columns:    []string{"title", "body"},
+			expectSql:  "MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:       "mysql boolean mode",
+			driver:     "mysql",
+			columns:    []string{"title"},
+			options:    []contractsdb.FullTextOptions{{Mode: "boolean"}},
+			expectSql:  "MATCH (title) AGAINST (? IN BOOLEAN MODE)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:       "mysql expanded mode",
+			driver:     "mysql",
+			columns:    []string{"title"},
+			options:    []contractsdb.FullTextOptions{{Mode: "expanded"}},
+			expectSql:  "MATCH (title) AGAINST (? WITH QUERY EXPANSION)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:        "mysql unsupported mode",
+			driver:      "mysql",
+			columns:     []string{"title"},
+			options:     []contractsdb.FullTextOptions{{Mode: "websearch"}},
+			expectError: errors.DatabaseFullTextModeNotSupported.Args("websearch", "mysql"),
+		},
+		{
+			name:       "postgres",
+			driver:     "postgres",
+			columns:    []string{"title", "body"},
+			expectSql:  "(to_tsvector('english', title) || to_tsvector('english', body)) @@ plainto_tsquery('english', ?)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:       "postgres with language and websearch mode",
+			driver:     "postgres",
+			columns:    []string{"title"},
+			options:    []contractsdb.FullTextOptions{{Language: "simple", Mode: "websearch"}},
+			expectSql:  "(to_tsvector('simple', title)) @@ websearch_to_tsquery('simple', ?)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:        "postgres invalid language",
+			driver:      "postgres",
+			columns:     []string{"title"},
+			options:     []contractsdb.FullTextOptions{{Language: "english'); DROP TABLE users; --"}},
+			expectError: errors.DatabaseFullTextLanguageInvalid.Args("english'); DROP TABLE users; --"),
+		},
+		{
+			name:       "sqlite",
+			driver:     "sqlite",
+			columns:    []string{"title"},
+			expectSql:  "title MATCH ?",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:       "sqlite with multiple columns",
+			driver:     "sqlite",
+			table:      "posts as p",
+			columns:    []string{"title", "body"},
+			expectSql:  "posts MATCH ?",
+			expectArgs: []any{"{title body} : (goravel)"},
+		},
+		{
+			name:       "sqlite with multiple qualified columns",
+			driver:     "sqlite",
+			columns:    []string{"posts.title", "posts.body"},
+			expectSql:  "posts MATCH ?",
+			expectArgs: []any{"{title body} : (goravel)"},
+		},
+		{
+			name:        "sqlite with multiple columns without table",
+			driver:      "sqlite",
+			columns:     []string{"title", "body"},
+			expectError: errors.DatabaseFullTextTableNotSet.Args("title, body", "sqlite"),
+		},
+		{
+			name:       "sqlserver",
+			driver:     "sqlserver",
+			columns:    []string{"title", "body"},
+			expectSql:  "FREETEXT((title, body), ?)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:       "sqlserver boolean mode",
+			driver:     "sqlserver",
+			columns:    []string{"title"},
+			options:    []contractsdb.FullTextOptions{{Mode: "boolean"}},
+			expectSql:  "CONTAINS((title), ?)",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:        "unsupported driver",
+			driver:      "oracle",
+			columns:     []string{"title"},
+			expectError: errors.DatabaseFullTextNotSupported.Args("oracle"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sql, args, err := CompileWhereFullText(mocksdriver.NewGrammar(t), test.driver, test.table, test.columns, "goravel", test.options...)
+			assert.Equal(t, test.expectError, err)
+			assert.Equal(t, test.expectSql, sql)
+			assert.Equal(t, test.expectArgs, args)
+		})
+	}
+
+	t.Run("compiled by the grammar", func(t *testing.T) {
+		grammar := &fullTextGrammar{Grammar: mocksdriver.NewGrammar(t), CompileFullTextGrammar: mocksdriver.NewCompileFullTextGrammar(t)}
+		grammar.CompileFullTextGrammar.EXPECT().CompileWhereFullText([]string{"title"}, "goravel", contractsdb.FullTextOptions{Language: "english", Mode: "natural"}).
+			Return("CONTAINS(title, ?)", []any{"goravel"}, nil).Once()
+
+		sql, args, err := CompileWhereFullText(grammar, "custom", "", []string{"title"}, "goravel")
+		assert.Nil(t, err)
+		assert.Equal(t, "CONTAINS(title, ?)", sql)
+		assert.Equal(t, []any{"goravel"}, args)
+	})
+}
+
+func TestCompileOrderByFullText(t *testing.T) {
+	tests := []struct {
+		name        string
+		driver      string
+		options     []contractsdb.FullTextOptions
+		expectSql   string
+		expectArgs  []any
+		expectError error
+	}{
+		{
+			name:       "mysql",
+			driver:     "mysql",
+			expectSql:  "MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE) DESC",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:       "postgres",
+			driver:     "postgres",
+			options:    []contractsdb.FullTextOptions{{Mode: "phrase"}},
+			expectSql:  "ts_rank(to_tsvector('english', title) || to_tsvector('english', body), phraseto_tsquery('english', ?)) DESC",
+			expectArgs: []any{"goravel"},
+		},
+		{
+			name:      "sqlite",
+			driver:    "sqlite",
+			expectSql: "rank",
+		},
+		{
+			name:        "sqlserver",
+			driver:      "sqlserver",
+			expectError: errors.DatabaseFullTextOrderNotSupported.Args("sqlserver"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sql, args, err := CompileOrderByFullText(mocksdriver.NewGrammar(t), test.driver, []string{"title", "body"}, "goravel", test.options...)
+			assert.Equal(t, test.expectError, err)
+			assert.Equal(t, test.expectSql, sql)
+			assert.Equal(t, test.expectArgs, args)
+		})
+	}
+}
@@ -2,11 +2,13 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	gormlogger "gorm.io/gorm/logger"
 
 	"github.com/rusmanplatd/goravelframework/contracts/config"
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
 	"github.com/rusmanplatd/goravelframework/contracts/database/logger"
 	"github.com/rusmanplatd/goravelframework/contracts/log"
 	"github.com/rusmanplatd/goravelframework/errors"
@@ -20,7 +22,7 @@ var (
 	traceErrStr  = "[%.3fms] [rows:%v] %s\t%s"
 )
 
-func NewLogger(config config.Config, log log.Log) logger.Logger {
+func NewLogger(config config.Config, log log.Log, connection string) logger.Logger {
 	level := logger.Warn
 	if config.GetBool("app.debug") {
 		level = logger.Info
@@ -30,15 +32,34 @@ func NewLogger(config config.Config, log log.Log) logger.Logger {
 	if slowThreshold <= 0 {
 		slowThreshold = 200
 	}
+	if connection != "" {
+		slowThreshold = config.GetInt(fmt.Sprintf("database.connections.%s.slow_threshold", connection), slowThreshold)
+		if slowThreshold <= 0 {
+			slowThreshold = 200
+		}
+	}
 
 	return &Logger{
+		connection:    connection,
 		log:           log,
 		level:         level,
 		slowThreshold: time.Duration(slowThreshold) * time.Millisecond,
 	}
 }
 
+// bindingsTracer is implemented by the loggers that can pass the query bindings to the query listeners.
+type bindingsTracer interface {
+	TraceWithBindings(ctx context.Context, begin *carbon.Carbon, sql string, bindings []any, rowsAffected int64, err error)
+}
+
+// durationTracer is implemented by the loggers that can trace a query whose duration has been measured, eg: the
+// queries of a transaction are traced after it's committed.
+type durationTracer interface {
+	TraceWithDuration(ctx context.Context, duration time.Duration, sql string, bindings []any, rowsAffected int64, err error)
+}
+
 type Logger struct {
+	connection    string
 	log           log.Log
 	level         logger.Level
 	slowThreshold time.Duration
@@ -85,11 +106,27 @@ func (r *Logger) Panicf(ctx context.Context, msg string, data ...any) {
 }
 
 func (r *Logger) Trace(ctx context.Context, begin *carbon.Carbon, sql string, rowsAffected int64, err error) {
+	r.TraceWithBindings(ctx, begin, sql, nil, rowsAffected, err)
+}
+
+func (r *Logger) TraceWithBindings(ctx context.Context, begin *carbon.Carbon, sql string, bindings []any, rowsAffected int64, err error) {
+	r.TraceWithDuration(ctx, begin.DiffInDuration(), sql, bindings, rowsAffected, err)
+}
+
+func (r *Logger) TraceWithDuration(ctx context.Context, duration time.Duration, sql string, bindings []any, rowsAffected int64, err error) {
+	notifyQueryListeners(ctx, contractsdb.QueryExecuted{
+		SQL:          sql,
+		Bindings:     bindings,
+		Connection:   r.connection,
+		Duration:     duration,
+		RowsAffected: rowsAffected,
+		Error:        err,
+	}, r.slowThreshold != 0 && duration > r.slowThreshold)
+
 	if r.level <= logger.Silent {
 		return
 	}
 
-	duration := begin.DiffInDuration()
 	elapsed := float64(duration.Nanoseconds()) / 1e6
 
 	addQueryLogToContext(ctx, sql, elapsed)
@@ -153,6 +190,25 @@ func (r *Gorm) Trace(ctx context.Context, begin time.Time, fc func() (string, in
 	r.logger.Trace(ctx, carbon.FromStdTime(begin), sql, rowsAffected, err)
 }
 
+func trace(logger logger.Logger, ctx context.Context, begin *carbon.Carbon, sql string, bindings []any, rowsAffected int64, err error) {
+	if tracer, ok := logger.(bindingsTracer); ok {
+		tracer.TraceWithBindings(ctx, begin, sql, bindings, rowsAffected, err)
+		return
+	}
+
+	logger.Trace(ctx, begin, sql, rowsAffected, err)
+}
+
+// traceTxLog traces the query of a committed transaction with the duration measured when the query ran.
+func traceTxLog(logger logger.Logger, txLog TxLog) {
+	if tracer, ok := logger.(durationTracer); ok {
+		tracer.TraceWithDuration(txLog.ctx, txLog.duration, txLog.sql, txLog.bindings, txLog.rowsAffected, txLog.err)
+		return
+	}
+
+	trace(logger, txLog.ctx, txLog.begin, txLog.sql, txLog.bindings, txLog.rowsAffected, txLog.err)
+}
+
 func addQueryLogToContext(ctx context.Context, sql string, time float64) {
 	value := ctx.Value(queryLogKey{})
 	if value == nil {
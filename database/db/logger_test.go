@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
 	"github.com/rusmanplatd/goravelframework/contracts/database/logger"
 	mocksconfig "github.com/rusmanplatd/goravelframework/mocks/config"
 	mockslog "github.com/rusmanplatd/goravelframework/mocks/log"
@@ -22,10 +23,11 @@ func TestNewLogger(t *testing.T) {
 		mockConfig *mocksconfig.Config
 	)
 	tests := []struct {
-		name      string
-		setup     func()
-		wantLevel logger.Level
-		wantSlow  time.Duration
+		name       string
+		setup      func()
+		connection string
+		wantLevel  logger.Level
+		wantSlow   time.Duration
 	}{
 		{
 			name: "debug mode enabled",
@@ -45,6 +47,17 @@ func TestNewLogger(t *testing.T) {
 			wantLevel: logger.Warn,
 			wantSlow:  300 * time.Millisecond,
 		},
+		{
+			name:       "connection slow threshold",
+			connection: "mysql",
+			setup: func() {
+				mockConfig.EXPECT().GetBool("app.debug").Return(false).Once()
+				mockConfig.EXPECT().GetInt("database.slow_threshold", 200).Return(300).Once()
+				mockConfig.EXPECT().GetInt("database.connections.mysql.slow_threshold", 300).Return(50).Once()
+			},
+			wantLevel: logger.Warn,
+			wantSlow:  50 * time.Millisecond,
+		},
 		{
 			name: "negative slow threshold",
 			setup: func() {
@@ -60,8 +73,9 @@ func TestNewLogger(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockConfig = mocksconfig.NewConfig(t)
 			tt.setup()
-			logger := NewLogger(mockConfig, nil)
+			logger := NewLogger(mockConfig, nil, tt.connection)
 
+			assert.Equal(t, tt.connection, logger.(*Logger).connection)
 			assert.Equal(t, tt.wantLevel, logger.(*Logger).level)
 			assert.Equal(t, tt.wantSlow, logger.(*Logger).slowThreshold)
 		})
@@ -81,6 +95,7 @@ func TestLoggerSuite(t *testing.T) {
 func (s *LoggerTestSuite) SetupTest() {
 	s.mockLog = mockslog.NewLog(s.T())
 	s.logger = &Logger{
+		connection:    "mysql",
 		log:           s.mockLog,
 		level:         logger.Info,
 		slowThreshold: 200 * time.Millisecond,
@@ -243,3 +258,57 @@ func (s *LoggerTestSuite) TestTrace() {
 		})
 	}
 }
+
+func (s *LoggerTestSuite) TestTraceWithBindings() {
+	defer FlushQueryListeners()
+
+	var (
+		events     []contractsdb.QueryExecuted
+		slowEvents []contractsdb.QueryExecuted
+	)
+	ListenQueries(func(event contractsdb.QueryExecuted) {
+		events = append(events, event)
+	})
+	ListenSlowQueries(func(event contractsdb.QueryExecuted) {
+		slowEvents = append(slowEvents, event)
+	})
+
+	s.logger.level = logger.Silent
+	s.logger.TraceWithBindings(context.Background(), carbon.Now().SubDuration("50ms"), "SELECT * FROM users WHERE id = 1", []any{1}, 1, nil)
+	s.logger.TraceWithBindings(context.Background(), carbon.Now().SubDuration("300ms"), "SELECT * FROM users WHERE id = 2", []any{2}, 1, nil)
+
+	s.Require().Len(events, 2)
+	s.Equal("SELECT * FROM users WHERE id = 1", events[0].SQL)
+	s.Equal([]any{1}, events[0].Bindings)
+	s.Equal("mysql", events[0].Connection)
+	s.Equal(int64(1), events[0].RowsAffected)
+	s.GreaterOrEqual(events[0].Duration, 50*time.Millisecond)
+
+	s.Require().Len(slowEvents, 1)
+	s.Equal("SELECT * FROM users WHERE id = 2", slowEvents[0].SQL)
+	s.Equal([]any{2}, slowEvents[0].Bindings)
+}
+
+func (s *LoggerTestSuite) TestTraceTxLog() {
+	defer FlushQueryListeners()
+
+	var events []contractsdb.QueryExecuted
+	ListenQueries(func(event contractsdb.QueryExecuted) {
+		events = append(events, event)
+	})
+
+	// The query ran an hour ago, it's traced with the duration measured then, not the time since it began.
+	s.logger.level = logger.Silent
+	traceTxLog(s.logger, TxLog{
+		ctx:          context.Background(),
+		begin:        carbon.Now().SubHour(),
+		duration:     10 * time.Millisecond,
+		sql:          "SELECT * FROM users WHERE id = 1",
+		bindings:     []any{1},
+		rowsAffected: 1,
+	})
+
+	s.Require().Len(events, 1)
+	s.Equal("SELECT * FROM users WHERE id = 1", events[0].SQL)
+	s.Equal(10*time.Millisecond, events[0].Duration)
+}
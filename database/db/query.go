@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -19,18 +20,21 @@ import (
 	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 	"github.com/rusmanplatd/goravelframework/support/convert"
+	"github.com/rusmanplatd/goravelframework/support/database"
 	"github.com/rusmanplatd/goravelframework/support/deep"
 	"github.com/rusmanplatd/goravelframework/support/str"
 )
 
 type Query struct {
 	ctx          context.Context
+	connection   string
 	err          error
 	grammar      contractsdriver.Grammar
 	logger       logger.Logger
 	readBuilder  db.CommonBuilder
 	writeBuilder db.CommonBuilder
 	txLogs       *[]TxLog
+	remember     *RememberOptions
 	conditions   contractsdriver.Conditions
 }
 
@@ -81,7 +85,12 @@ func (r *Query) Chunk(size uint64, callback func(rows []db.Row) error) error {
 }
 
 func (r *Query) Count() (int64, error) {
+	if len(r.conditions.Unions) > 0 {
+		return r.aggregateUnions().Count()
+	}
+
 	r.conditions.Selects = []string{"COUNT(*)"}
+	r.conditions.SubSelects = nil
 
 	sql, args, err := r.buildSelect()
 	if err != nil {
@@ -89,16 +98,22 @@ func (r *Query) Count() (int64, error) {
 	}
 
 	var count int64
-	now := carbon.Now()
-	err = r.readBuilder.GetContext(r.ctx, &count, sql, args...)
-	if err != nil {
-		r.trace(r.readBuilder, sql, args, now, -1, err)
+	err = r.rememberQuery(sql, args, &count, func() error {
+		now := carbon.Now()
+		if err := r.readBuilder.GetContext(r.ctx, &count, sql, args...); err != nil {
+			r.trace(r.readBuilder, sql, args, now, -1, err)
+
+			return err
+		}
 
+		r.trace(r.readBuilder, sql, args, now, -1, nil)
+
+		return nil
+	})
+	if err != nil {
 		return 0, err
 	}
 
-	r.trace(r.readBuilder, sql, args, now, -1, nil)
-
 	return count, nil
 }
 
@@ -163,6 +178,29 @@ func (r *Query) Cursor() chan db.Row {
 	return ch
 }
 
+func (r *Query) CursorPaginate(limit int, cursor string, dest any) (next, prev string, err error) {
+	if r.conditions.InRandomOrder != nil && *r.conditions.InRandomOrder {
+		return "", "", errors.DatabaseCursorInvalidOrder.Args("random")
+	}
+	// The bindings of the full text orders can't be kept when the orders are replaced by the cursor orders.
+	if len(r.conditions.OrderByArgs) > 0 {
+		return "", "", errors.DatabaseCursorInvalidOrder.Args("full text")
+	}
+
+	// The query builder doesn't know the model, so the primary key is assumed to be "id".
+	return database.CursorPaginate(r.conditions.OrderBy, "id", limit, cursor, dest, func(orders []string, where string, args []any, limit int) error {
+		q := r.clone()
+		q.conditions.OrderBy = orders
+		if where != "" {
+			q.conditions.Where = deep.Append(q.conditions.Where, contractsdriver.Where{
+				Query: sq.Expr(where, args...),
+			})
+		}
+
+		return q.Limit(uint64(limit)).Get(dest)
+	})
+}
+
 func (r *Query) Decrement(column string, value ...uint64) error {
 	v := uint64(1)
 	if len(value) > 0 {
@@ -183,24 +221,7 @@ func (r *Query) Delete() (*db.Result, error) {
 		return nil, err
 	}
 
-	now := carbon.Now()
-	result, err := r.writeBuilder.ExecContext(r.ctx, sql, args...)
-	if err != nil {
-		r.trace(r.writeBuilder, sql, args, now, -1, err)
-		return nil, err
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		r.trace(r.writeBuilder, sql, args, now, -1, err)
-		return nil, err
-	}
-
-	r.trace(r.writeBuilder, sql, args, now, rowsAffected, nil)
-
-	return &db.Result{
-		RowsAffected: rowsAffected,
-	}, nil
+	return r.exec(sql, args)
 }
 
 func (r *Query) Distinct(columns ...string) db.Query {
@@ -281,22 +302,23 @@ func (r *Query) First(dest any) error {
 		return err
 	}
 
-	now := carbon.Now()
-	err = r.readBuilder.GetContext(r.ctx, dest, sql, args...)
-	if err != nil {
-		if errors.Is(err, databasesql.ErrNoRows) {
-			r.trace(r.readBuilder, sql, args, now, 0, nil)
-			return nil
-		}
+	return r.rememberQuery(sql, args, dest, func() error {
+		now := carbon.Now()
+		if err := r.readBuilder.GetContext(r.ctx, dest, sql, args...); err != nil {
+			if errors.Is(err, databasesql.ErrNoRows) {
+				r.trace(r.readBuilder, sql, args, now, 0, nil)
+				return nil
+			}
 
-		r.trace(r.readBuilder, sql, args, now, -1, err)
+			r.trace(r.readBuilder, sql, args, now, -1, err)
 
-		return err
-	}
+			return err
+		}
 
-	r.trace(r.readBuilder, sql, args, now, 1, nil)
+		r.trace(r.readBuilder, sql, args, now, 1, nil)
 
-	return nil
+		return nil
+	})
 }
 
 func (r *Query) FirstOr(dest any, callback func() error) error {
@@ -349,22 +371,45 @@ func (r *Query) Get(dest any) error {
 		return err
 	}
 
-	now := carbon.Now()
-	err = r.readBuilder.SelectContext(r.ctx, dest, sql, args...)
-	if err != nil {
-		r.trace(r.readBuilder, sql, args, now, -1, err)
-		return err
+	return r.rememberQuery(sql, args, dest, func() error {
+		now := carbon.Now()
+		if err := r.readBuilder.SelectContext(r.ctx, dest, sql, args...); err != nil {
+			r.trace(r.readBuilder, sql, args, now, -1, err)
+			return err
+		}
+
+		destValue := reflect.Indirect(reflect.ValueOf(dest))
+		rowsAffected := int64(-1)
+		if destValue.Kind() == reflect.Slice {
+			rowsAffected = int64(destValue.Len())
+		}
+
+		r.trace(r.readBuilder, sql, args, now, rowsAffected, nil)
+
+		return nil
+	})
+}
+
+func (r *Query) FromSub(query db.Query, as string) db.Query {
+	subQuery, ok := query.(*Query)
+	if !ok {
+		r.err = errors.DatabaseUnsupportedType.Args(fmt.Sprintf("%T", query), "*db.Query")
+		return r
 	}
 
-	destValue := reflect.Indirect(reflect.ValueOf(dest))
-	rowsAffected := int64(-1)
-	if destValue.Kind() == reflect.Slice {
-		rowsAffected = int64(destValue.Len())
+	builder, err := subQuery.selectBuilder()
+	if err != nil {
+		r.err = err
+		return r
 	}
 
-	r.trace(r.readBuilder, sql, args, now, rowsAffected, nil)
+	q := r.clone()
+	q.conditions.FromSub = &contractsdriver.FromSub{
+		Query: builder,
+		As:    as,
+	}
 
-	return nil
+	return q
 }
 
 func (r *Query) GroupBy(column ...string) db.Query {
@@ -398,6 +443,16 @@ func (r *Query) Join(query string, args ...any) db.Query {
 	return q
 }
 
+func (r *Query) JoinSub(query db.Query, as string, on string, args ...any) db.Query {
+	sql, subArgs, err := r.subQuery(query)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	return r.Join(fmt.Sprintf("(%s) AS %s ON %s", sql, as, on), append(subArgs, args...)...)
+}
+
 func (r *Query) Increment(column string, value ...uint64) error {
 	v := uint64(1)
 	if len(value) > 0 {
@@ -433,24 +488,7 @@ func (r *Query) Insert(data any) (*db.Result, error) {
 		return nil, err
 	}
 
-	now := carbon.Now()
-	result, err := r.writeBuilder.ExecContext(r.ctx, sql, args...)
-	if err != nil {
-		r.trace(r.writeBuilder, sql, args, now, -1, err)
-		return nil, err
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		r.trace(r.writeBuilder, sql, args, now, -1, err)
-		return nil, err
-	}
-
-	r.trace(r.writeBuilder, sql, args, now, rowsAffected, nil)
-
-	return &db.Result{
-		RowsAffected: rowsAffected,
-	}, nil
+	return r.exec(sql, args)
 }
 
 func (r *Query) InsertGetID(data any) (int64, error) {
@@ -481,10 +519,28 @@ func (r *Query) InsertGetID(data any) (int64, error) {
 	}
 
 	r.trace(r.writeBuilder, sql, args, now, 1, nil)
+	flushQueryCacheOnWrite(r.ctx, r.transaction(), queryCacheTable(r.conditions.Table))
 
 	return id, nil
 }
 
+func (r *Query) InsertOrIgnore(data any, uniqueBy ...string) (*db.Result, error) {
+	mapData, err := convertToSliceMap(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapData) == 0 {
+		return nil, errors.DatabaseDataIsEmpty
+	}
+
+	sql, args, err := r.buildInsertOrIgnore(mapData, uniqueBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.exec(sql, args)
+}
+
 func (r *Query) Latest(column ...string) db.Query {
 	col := "created_at"
 	if len(column) > 0 {
@@ -504,6 +560,16 @@ func (r *Query) LeftJoin(query string, args ...any) db.Query {
 	return q
 }
 
+func (r *Query) LeftJoinSub(query db.Query, as string, on string, args ...any) db.Query {
+	sql, subArgs, err := r.subQuery(query)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	return r.LeftJoin(fmt.Sprintf("(%s) AS %s ON %s", sql, as, on), append(subArgs, args...)...)
+}
+
 func (r *Query) Limit(limit uint64) db.Query {
 	q := r.clone()
 	q.conditions.Limit = &limit
@@ -544,6 +610,20 @@ func (r *Query) OrderByDesc(column string) db.Query {
 	return q
 }
 
+func (r *Query) OrderByFullText(columns []string, value string, options ...db.FullTextOptions) db.Query {
+	sql, args, err := CompileOrderByFullText(r.grammar, r.writeBuilder.DriverName(), columns, value, options...)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	q := r.clone()
+	q.conditions.OrderBy = deep.Append(q.conditions.OrderBy, sql)
+	q.conditions.OrderByArgs = deep.Append(q.conditions.OrderByArgs, args...)
+
+	return q
+}
+
 func (r *Query) OrderByRaw(raw string) db.Query {
 	q := r.clone()
 	q.conditions.OrderBy = deep.Append(q.conditions.OrderBy, raw)
@@ -576,7 +656,21 @@ func (r *Query) OrWhereColumn(column1 string, column2 ...string) db.Query {
 	return r.OrWhere(sq.Expr(fmt.Sprintf("%s %s %s", column1, column2[0], column2[1])))
 }
 
-func (r *Query) OrWhereIn(column string, values []any) db.Query {
+func (r *Query) OrWhereFullText(columns []string, value string, options ...db.FullTextOptions) db.Query {
+	sql, args, err := CompileWhereFullText(r.grammar, r.writeBuilder.DriverName(), r.conditions.Table, columns, value, options...)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	return r.OrWhere(sq.Expr(sql, args...))
+}
+
+func (r *Query) OrWhereIn(column string, values any) db.Query {
+	if query, ok := values.(db.Query); ok {
+		return r.whereSub(column+" IN", query, true)
+	}
+
 	return r.OrWhere(column, values)
 }
 
@@ -658,7 +752,11 @@ func (r *Query) OrWhereNotBetween(column string, x, y any) db.Query {
 	return r.OrWhere(sq.Expr(fmt.Sprintf("%s NOT BETWEEN ? AND ?", column), x, y))
 }
 
-func (r *Query) OrWhereNotIn(column string, values []any) db.Query {
+func (r *Query) OrWhereNotIn(column string, values any) db.Query {
+	if query, ok := values.(db.Query); ok {
+		return r.whereSub(column+" NOT IN", query, true)
+	}
+
 	return r.OrWhere(sq.NotEq{column: values})
 }
 
@@ -694,10 +792,23 @@ func (r *Query) Paginate(page, limit int, dest any, total *int64) error {
 
 func (r *Query) Pluck(column string, dest any) error {
 	r.conditions.Selects = []string{column}
+	r.conditions.SubSelects = nil
 
 	return r.Get(dest)
 }
 
+func (r *Query) Remember(ttl time.Duration, key ...string) db.Query {
+	q := r.clone()
+	q.remember = &RememberOptions{
+		TTL: ttl,
+	}
+	if len(key) > 0 {
+		q.remember.Key = key[0]
+	}
+
+	return q
+}
+
 func (r *Query) RightJoin(query string, args ...any) db.Query {
 	q := r.clone()
 	q.conditions.RightJoin = deep.Append(q.conditions.RightJoin, contractsdriver.Join{
@@ -715,6 +826,22 @@ func (r *Query) Select(columns ...string) db.Query {
 	return q
 }
 
+func (r *Query) SelectSub(as string, query db.Query) db.Query {
+	sql, args, err := r.subQuery(query)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	q := r.clone()
+	q.conditions.SubSelects = deep.Append(q.conditions.SubSelects, contractsdriver.Join{
+		Query: fmt.Sprintf("(%s) AS %s", sql, as),
+		Args:  args,
+	})
+
+	return q
+}
+
 func (r *Query) SharedLock() db.Query {
 	q := r.clone()
 	q.conditions.SharedLock = convert.Pointer(true)
@@ -768,6 +895,14 @@ func (r *Query) ToRawSql() db.ToSql {
 	return NewToSql(q, true)
 }
 
+func (r *Query) Union(query db.Query) db.Query {
+	return r.union(query, false)
+}
+
+func (r *Query) UnionAll(query db.Query) db.Query {
+	return r.union(query, true)
+}
+
 func (r *Query) Update(column any, value ...any) (*db.Result, error) {
 	columnStr, ok := column.(string)
 	if ok {
@@ -788,24 +923,7 @@ func (r *Query) Update(column any, value ...any) (*db.Result, error) {
 		return nil, err
 	}
 
-	now := carbon.Now()
-	result, err := r.writeBuilder.ExecContext(r.ctx, sql, args...)
-	if err != nil {
-		r.trace(r.writeBuilder, sql, args, now, -1, err)
-		return nil, err
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		r.trace(r.writeBuilder, sql, args, now, -1, err)
-		return nil, err
-	}
-
-	r.trace(r.writeBuilder, sql, args, now, rowsAffected, nil)
-
-	return &db.Result{
-		RowsAffected: rowsAffected,
-	}, nil
+	return r.exec(sql, args)
 }
 
 func (r *Query) UpdateOrInsert(attributes any, values any) (*db.Result, error) {
@@ -833,6 +951,26 @@ func (r *Query) UpdateOrInsert(attributes any, values any) (*db.Result, error) {
 	return r.Insert(mapAttributes)
 }
 
+func (r *Query) Upsert(data any, uniqueBy []string, update []string) (*db.Result, error) {
+	mapData, err := convertToSliceMap(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapData) == 0 {
+		return nil, errors.DatabaseDataIsEmpty
+	}
+	if len(uniqueBy) == 0 {
+		return nil, errors.DatabaseUpsertUniqueByIsRequired
+	}
+
+	sql, args, err := r.buildUpsert(mapData, uniqueBy, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.exec(sql, args)
+}
+
 func (r *Query) Value(column string, dest any) error {
 	return r.Select(column).Limit(1).First(dest)
 }
@@ -849,6 +987,14 @@ func (r *Query) When(condition bool, callback func(query db.Query) db.Query, fal
 	return r
 }
 
+func (r *Query) With(name string, query db.Query) db.Query {
+	return r.with(name, query, false)
+}
+
+func (r *Query) WithRecursive(name string, query db.Query) db.Query {
+	return r.with(name, query, true)
+}
+
 func (r *Query) Where(query any, args ...any) db.Query {
 	return r.addWhere(contractsdriver.Where{
 		Query: query,
@@ -917,19 +1063,30 @@ func (r *Query) WhereColumn(column1 string, column2 ...string) db.Query {
 }
 
 func (r *Query) WhereExists(query func() db.Query) db.Query {
-	subQuery := query()
-	sql, args, err := subQuery.(*Query).buildSelect()
+	sql, args, err := r.subQuery(query())
 	if err != nil {
 		r.err = err
 		return r
 	}
 
-	sql = r.readBuilder.Explain(sql, args...)
+	return r.Where(sq.Expr(fmt.Sprintf("EXISTS (%s)", sql), args...))
+}
+
+func (r *Query) WhereFullText(columns []string, value string, options ...db.FullTextOptions) db.Query {
+	sql, args, err := CompileWhereFullText(r.grammar, r.writeBuilder.DriverName(), r.conditions.Table, columns, value, options...)
+	if err != nil {
+		r.err = err
+		return r
+	}
 
-	return r.Where(sq.Expr(fmt.Sprintf("EXISTS (%s)", sql)))
+	return r.Where(sq.Expr(sql, args...))
 }
 
-func (r *Query) WhereIn(column string, values []any) db.Query {
+func (r *Query) WhereIn(column string, values any) db.Query {
+	if query, ok := values.(db.Query); ok {
+		return r.whereSub(column+" IN", query, false)
+	}
+
 	return r.Where(column, values)
 }
 
@@ -1033,7 +1190,11 @@ func (r *Query) WhereNotBetween(column string, x, y any) db.Query {
 	return r.Where(sq.Expr(fmt.Sprintf("%s NOT BETWEEN ? AND ?", column), x, y))
 }
 
-func (r *Query) WhereNotIn(column string, values []any) db.Query {
+func (r *Query) WhereNotIn(column string, values any) db.Query {
+	if query, ok := values.(db.Query); ok {
+		return r.whereSub(column+" NOT IN", query, false)
+	}
+
 	return r.Where(sq.NotEq{column: values})
 }
 
@@ -1053,6 +1214,19 @@ func (r *Query) WhereRaw(raw string, args []any) db.Query {
 	return r.Where(sq.Expr(raw, args...))
 }
 
+// aggregateUnions wraps the union query as a sub query, then the aggregate functions can be applied to
+// the combined rows.
+func (r *Query) aggregateUnions() *Query {
+	subQuery := r.clone()
+	subQuery.conditions.With = nil
+
+	query := NewQuery(r.ctx, r.readBuilder, r.writeBuilder, r.grammar, r.logger, "", r.txLogs).FromSub(subQuery, "aggregate").(*Query)
+	query.connection = r.connection
+	query.conditions.With = r.conditions.With
+
+	return query
+}
+
 func (r *Query) addWhere(where contractsdriver.Where) db.Query {
 	q := r.clone()
 	q.conditions.Where = deep.Append(q.conditions.Where, where)
@@ -1083,131 +1257,59 @@ func (r *Query) buildDelete() (sql string, args []any, err error) {
 }
 
 func (r *Query) buildInsert(data []map[string]any) (sql string, args []any, err error) {
-	if r.err != nil {
-		return "", nil, r.err
-	}
-
-	if r.conditions.Table == "" {
-		return "", nil, errors.DatabaseTableIsRequired
+	columns, values, err := r.insertValues(data)
+	if err != nil {
+		return "", nil, err
 	}
 
-	builder := sq.Insert(r.conditions.Table)
+	builder := insertBuilder(r.conditions.Table, columns, values)
 	if placeholderFormat := r.grammar.CompilePlaceholderFormat(); placeholderFormat != nil {
 		builder = builder.PlaceholderFormat(placeholderFormat)
 	}
 
-	first := data[0]
-	cols := make([]string, 0, len(first))
-	for col := range first {
-		cols = append(cols, col)
+	return builder.ToSql()
+}
+
+func (r *Query) buildInsertOrIgnore(data []map[string]any, uniqueBy []string) (sql string, args []any, err error) {
+	columns, values, err := r.insertValues(data)
+	if err != nil {
+		return "", nil, err
 	}
-	sort.Strings(cols)
-	builder = builder.Columns(cols...)
 
-	for _, row := range data {
-		vals := make([]any, 0, len(first))
-		for _, col := range cols {
-			vals = append(vals, row[col])
-		}
-		builder = builder.Values(vals...)
+	sql, args, err = CompileInsertOrIgnore(r.grammar, r.writeBuilder.DriverName(), r.conditions.Table, columns, values, uniqueBy)
+	if err != nil {
+		return "", nil, err
 	}
 
-	return builder.ToSql()
+	return r.replacePlaceholders(sql, args)
 }
 
-func (r *Query) buildSelect() (sql string, args []any, err error) {
-	if r.err != nil {
-		return "", nil, r.err
+func (r *Query) buildUpsert(data []map[string]any, uniqueBy []string, update []string) (sql string, args []any, err error) {
+	columns, values, err := r.insertValues(data)
+	if err != nil {
+		return "", nil, err
 	}
 
-	if r.conditions.Table == "" {
-		return "", nil, errors.DatabaseTableIsRequired
+	if len(update) == 0 {
+		update = columns
 	}
 
-	selects := "*"
-	if len(r.conditions.Selects) > 0 {
-		selects = strings.Join(r.conditions.Selects, ", ")
+	sql, args, err = CompileUpsert(r.grammar, r.writeBuilder.DriverName(), r.conditions.Table, columns, values, uniqueBy, update)
+	if err != nil {
+		return "", nil, err
 	}
 
-	builder := sq.Select(selects)
+	return r.replacePlaceholders(sql, args)
+}
 
-	if r.conditions.Distinct != nil && *r.conditions.Distinct {
-		builder = builder.Distinct()
-	}
-
-	if placeholderFormat := r.grammar.CompilePlaceholderFormat(); placeholderFormat != nil {
-		builder = builder.PlaceholderFormat(placeholderFormat)
-	}
-
-	builder = builder.From(r.conditions.Table)
-
-	for _, join := range r.conditions.Join {
-		builder = builder.Join(join.Query, join.Args...)
-	}
-
-	for _, leftJoin := range r.conditions.LeftJoin {
-		builder = builder.LeftJoin(leftJoin.Query, leftJoin.Args...)
-	}
-
-	for _, rightJoin := range r.conditions.RightJoin {
-		builder = builder.RightJoin(rightJoin.Query, rightJoin.Args...)
-	}
-
-	for _, crossJoin := range r.conditions.CrossJoin {
-		builder = builder.CrossJoin(crossJoin.Query, crossJoin.Args...)
-	}
-
-	sqlizer, err := r.buildWheres(r.conditions.Where)
+func (r *Query) buildSelect() (sql string, args []any, err error) {
+	builder, err := r.selectBuilder()
 	if err != nil {
 		return "", nil, err
 	}
 
-	builder = builder.Where(sqlizer)
-
-	if r.conditions.InRandomOrder != nil && *r.conditions.InRandomOrder {
-		builder = r.grammar.CompileInRandomOrder(builder, &r.conditions)
-	}
-
-	if len(r.conditions.GroupBy) > 0 {
-		builder = builder.GroupBy(r.conditions.GroupBy...)
-
-		if r.conditions.Having != nil {
-			builder = builder.Having(r.conditions.Having.Query, r.conditions.Having.Args...)
-		}
-	}
-
-	compileOrderByGrammar, ok := r.grammar.(contractsdriver.CompileOrderByGrammar)
-	if ok {
-		builder = compileOrderByGrammar.CompileOrderBy(builder, &r.conditions)
-	} else {
-		if len(r.conditions.OrderBy) > 0 {
-			builder = builder.OrderBy(r.conditions.OrderBy...)
-		}
-	}
-
-	compileOffsetGrammar, ok := r.grammar.(contractsdriver.CompileOffsetGrammar)
-	if ok {
-		builder = compileOffsetGrammar.CompileOffset(builder, &r.conditions)
-	} else {
-		if r.conditions.Offset != nil {
-			builder = builder.Offset(*r.conditions.Offset)
-		}
-	}
-
-	compileLimitGrammar, ok := r.grammar.(contractsdriver.CompileLimitGrammar)
-	if ok {
-		builder = compileLimitGrammar.CompileLimit(builder, &r.conditions)
-	} else {
-		if r.conditions.Limit != nil {
-			builder = builder.Limit(*r.conditions.Limit)
-		}
-	}
-
-	if r.conditions.LockForUpdate != nil && *r.conditions.LockForUpdate {
-		builder = r.grammar.CompileLockForUpdate(builder, &r.conditions)
-	}
-	if r.conditions.SharedLock != nil && *r.conditions.SharedLock {
-		builder = r.grammar.CompileSharedLock(builder, &r.conditions)
+	if placeholderFormat := r.grammar.CompilePlaceholderFormat(); placeholderFormat != nil {
+		builder = builder.PlaceholderFormat(placeholderFormat)
 	}
 
 	return builder.ToSql()
@@ -1343,14 +1445,239 @@ func (r *Query) buildWheres(wheres []contractsdriver.Where) (sq.Sqlizer, error)
 	return sq.And(sqlizers), nil
 }
 
+// insertValues gets the sorted columns of the first record and the values of every record in the same order.
+func (r *Query) insertValues(data []map[string]any) ([]string, [][]any, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	if r.conditions.Table == "" {
+		return nil, nil, errors.DatabaseTableIsRequired
+	}
+
+	first := data[0]
+	columns := make([]string, 0, len(first))
+	for column := range first {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	values := make([][]any, len(data))
+	for i, row := range data {
+		values[i] = make([]any, 0, len(columns))
+		for _, column := range columns {
+			values[i] = append(values[i], row[column])
+		}
+	}
+
+	return columns, values, nil
+}
+
+// rememberQuery executes the callback, the result is cached if Remember is called.
+func (r *Query) rememberQuery(sql string, args []any, dest any, callback func() error) error {
+	if r.remember == nil {
+		return callback()
+	}
+
+	return RememberQuery(r.ctx, *r.remember, r.connection, queryCacheTable(r.conditions.Table), sql, args, dest, callback)
+}
+
+// replacePlaceholders replaces the question placeholders of the statement with the placeholder format of the grammar.
+func (r *Query) replacePlaceholders(sql string, args []any) (string, []any, error) {
+	placeholderFormat := r.grammar.CompilePlaceholderFormat()
+	if placeholderFormat == nil {
+		return sql, args, nil
+	}
+
+	sql, err := placeholderFormat.ReplacePlaceholders(sql)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql, args, nil
+}
+
+// selectBuilder builds the select statement with the question placeholder, the placeholders are replaced when
+// building the final statement, so the statement can be used as a sub query of another one.
+func (r *Query) selectBuilder() (sq.SelectBuilder, error) {
+	if r.err != nil {
+		return sq.SelectBuilder{}, r.err
+	}
+
+	if r.conditions.Table == "" && r.conditions.FromSub == nil {
+		return sq.SelectBuilder{}, errors.DatabaseTableIsRequired
+	}
+
+	selects := "*"
+	if len(r.conditions.Selects) > 0 {
+		selects = strings.Join(r.conditions.Selects, ", ")
+	}
+
+	builder := sq.Select(selects)
+
+	for _, subSelect := range r.conditions.SubSelects {
+		builder = builder.Column(sq.Expr(subSelect.Query, subSelect.Args...))
+	}
+
+	if r.conditions.Distinct != nil && *r.conditions.Distinct {
+		builder = builder.Distinct()
+	}
+
+	if r.conditions.FromSub != nil {
+		builder = builder.FromSelect(r.conditions.FromSub.Query, r.conditions.FromSub.As)
+	} else {
+		builder = builder.From(r.conditions.Table)
+	}
+
+	for _, join := range r.conditions.Join {
+		builder = builder.Join(join.Query, join.Args...)
+	}
+
+	for _, leftJoin := range r.conditions.LeftJoin {
+		builder = builder.LeftJoin(leftJoin.Query, leftJoin.Args...)
+	}
+
+	for _, rightJoin := range r.conditions.RightJoin {
+		builder = builder.RightJoin(rightJoin.Query, rightJoin.Args...)
+	}
+
+	for _, crossJoin := range r.conditions.CrossJoin {
+		builder = builder.CrossJoin(crossJoin.Query, crossJoin.Args...)
+	}
+
+	sqlizer, err := r.buildWheres(r.conditions.Where)
+	if err != nil {
+		return sq.SelectBuilder{}, err
+	}
+
+	builder = builder.Where(sqlizer)
+
+	if len(r.conditions.GroupBy) > 0 {
+		builder = builder.GroupBy(r.conditions.GroupBy...)
+
+		if r.conditions.Having != nil {
+			builder = builder.Having(r.conditions.Having.Query, r.conditions.Having.Args...)
+		}
+	}
+
+	if len(r.conditions.Unions) > 0 {
+		for _, union := range r.conditions.Unions {
+			keyword := "UNION"
+			if union.All {
+				keyword = "UNION ALL"
+			}
+
+			builder = builder.Suffix(keyword+" "+union.Query, union.Args...)
+		}
+
+		// The orders and limits of a union query are applied to the combined rows, the union query is wrapped
+		// as a sub query, because not all drivers support the parentheses around the select statements, eg: SQLite.
+		if len(r.conditions.OrderBy) > 0 || r.conditions.Offset != nil || r.conditions.Limit != nil ||
+			(r.conditions.InRandomOrder != nil && *r.conditions.InRandomOrder) {
+			builder = sq.Select("*").FromSelect(builder, "unions")
+		}
+	}
+
+	if r.conditions.InRandomOrder != nil && *r.conditions.InRandomOrder {
+		builder = r.grammar.CompileInRandomOrder(builder, &r.conditions)
+	}
+
+	compileOrderByGrammar, ok := r.grammar.(contractsdriver.CompileOrderByGrammar)
+	if ok {
+		builder = compileOrderByGrammar.CompileOrderBy(builder, &r.conditions)
+	} else {
+		if len(r.conditions.OrderByArgs) > 0 {
+			builder = builder.OrderByClause(strings.Join(r.conditions.OrderBy, ", "), r.conditions.OrderByArgs...)
+		} else if len(r.conditions.OrderBy) > 0 {
+			builder = builder.OrderBy(r.conditions.OrderBy...)
+		}
+	}
+
+	compileOffsetGrammar, ok := r.grammar.(contractsdriver.CompileOffsetGrammar)
+	if ok {
+		builder = compileOffsetGrammar.CompileOffset(builder, &r.conditions)
+	} else {
+		if r.conditions.Offset != nil {
+			builder = builder.Offset(*r.conditions.Offset)
+		}
+	}
+
+	compileLimitGrammar, ok := r.grammar.(contractsdriver.CompileLimitGrammar)
+	if ok {
+		builder = compileLimitGrammar.CompileLimit(builder, &r.conditions)
+	} else {
+		if r.conditions.Limit != nil {
+			builder = builder.Limit(*r.conditions.Limit)
+		}
+	}
+
+	if r.conditions.LockForUpdate != nil && *r.conditions.LockForUpdate {
+		builder = r.grammar.CompileLockForUpdate(builder, &r.conditions)
+	}
+	if r.conditions.SharedLock != nil && *r.conditions.SharedLock {
+		builder = r.grammar.CompileSharedLock(builder, &r.conditions)
+	}
+
+	if len(r.conditions.With) > 0 {
+		if compileWithGrammar, ok := r.grammar.(contractsdriver.CompileWithGrammar); ok {
+			builder = compileWithGrammar.CompileWith(builder, &r.conditions)
+		} else {
+			var (
+				args      []any
+				recursive bool
+				withs     []string
+			)
+			for _, with := range r.conditions.With {
+				withs = append(withs, fmt.Sprintf("%s AS (%s)", with.Name, with.Query))
+				args = append(args, with.Args...)
+				recursive = recursive || with.Recursive
+			}
+
+			// SQL Server doesn't support the RECURSIVE keyword, the common table expressions are recursive by default.
+			keyword := "WITH "
+			if recursive && r.writeBuilder.DriverName() != "sqlserver" {
+				keyword = "WITH RECURSIVE "
+			}
+
+			builder = builder.Prefix(keyword+strings.Join(withs, ", "), args...)
+		}
+	}
+
+	return builder, nil
+}
+
 func (r *Query) clone() *Query {
 	query := NewQuery(r.ctx, r.readBuilder, r.writeBuilder, r.grammar, r.logger, r.conditions.Table, r.txLogs)
+	query.connection = r.connection
 	query.conditions = r.conditions
 	query.err = r.err
+	query.remember = r.remember
 
 	return query
 }
 
+func (r *Query) exec(sql string, args []any) (*db.Result, error) {
+	now := carbon.Now()
+	result, err := r.writeBuilder.ExecContext(r.ctx, sql, args...)
+	if err != nil {
+		r.trace(r.writeBuilder, sql, args, now, -1, err)
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.trace(r.writeBuilder, sql, args, now, -1, err)
+		return nil, err
+	}
+
+	r.trace(r.writeBuilder, sql, args, now, rowsAffected, nil)
+	flushQueryCacheOnWrite(r.ctx, r.transaction(), queryCacheTable(r.conditions.Table))
+
+	return &db.Result{
+		RowsAffected: rowsAffected,
+	}, nil
+}
+
 func (r *Query) findQuery(conds []any) (db.Query, error) {
 	var q db.Query
 	if len(conds) > 2 {
@@ -1366,6 +1693,21 @@ func (r *Query) findQuery(conds []any) (db.Query, error) {
 	return q, nil
 }
 
+// subQuery builds the given query as a sub query, its bindings are merged into the current query.
+func (r *Query) subQuery(query db.Query) (string, []any, error) {
+	subQuery, ok := query.(*Query)
+	if !ok {
+		return "", nil, errors.DatabaseUnsupportedType.Args(fmt.Sprintf("%T", query), "*db.Query")
+	}
+
+	builder, err := subQuery.selectBuilder()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return builder.ToSql()
+}
+
 func (r *Query) toSqlizer(query any, args []any) (sq.Sqlizer, error) {
 	switch q := query.(type) {
 	case map[string]any:
@@ -1379,16 +1721,78 @@ func (r *Query) toSqlizer(query any, args []any) (sq.Sqlizer, error) {
 	}
 }
 
+func (r *Query) union(query db.Query, all bool) db.Query {
+	sql, args, err := r.subQuery(query)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	q := r.clone()
+	q.conditions.Unions = deep.Append(q.conditions.Unions, contractsdriver.Union{
+		Query: sql,
+		Args:  args,
+		All:   all,
+	})
+
+	return q
+}
+
+func (r *Query) whereSub(condition string, query db.Query, or bool) db.Query {
+	sql, args, err := r.subQuery(query)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	expr := sq.Expr(fmt.Sprintf("%s (%s)", condition, sql), args...)
+	if or {
+		return r.OrWhere(expr)
+	}
+
+	return r.Where(expr)
+}
+
+func (r *Query) with(name string, query db.Query, recursive bool) db.Query {
+	sql, args, err := r.subQuery(query)
+	if err != nil {
+		r.err = err
+		return r
+	}
+
+	q := r.clone()
+	q.conditions.With = deep.Append(q.conditions.With, contractsdriver.With{
+		Name:      name,
+		Query:     sql,
+		Args:      args,
+		Recursive: recursive,
+	})
+
+	return q
+}
+
+// transaction gets the identity of the transaction that the query is run in, nil is returned if the query is not
+// run in a transaction.
+func (r *Query) transaction() any {
+	if r.txLogs == nil {
+		return nil
+	}
+
+	return r.txLogs
+}
+
 func (r *Query) trace(builder db.CommonBuilder, sql string, args []any, now *carbon.Carbon, rowsAffected int64, err error) {
 	if r.txLogs != nil {
 		*r.txLogs = append(*r.txLogs, TxLog{
 			ctx:          r.ctx,
 			begin:        now,
+			duration:     now.DiffInDuration(),
 			sql:          builder.Explain(sql, args...),
+			bindings:     args,
 			rowsAffected: rowsAffected,
 			err:          err,
 		})
 	} else {
-		r.logger.Trace(r.ctx, now, builder.Explain(sql, args...), rowsAffected, err)
+		trace(r.logger, r.ctx, now, builder.Explain(sql, args...), args, rowsAffected, err)
 	}
 }
@@ -0,0 +1,305 @@
+package db
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	contractscache "github.com/rusmanplatd/goravelframework/contracts/cache"
+	contractsdatabase "github.com/rusmanplatd/goravelframework/contracts/database"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	databasedriver "github.com/rusmanplatd/goravelframework/database/driver"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+const (
+	queryCachePrefix   = "goravel_query_cache"
+	queryCacheCallback = "goravel:flush_query_cache"
+)
+
+var (
+	queryCache            = &queryCacheRegistry{}
+	queryCacheCallbackMux sync.Mutex
+	// queryCacheTablesRegexp matches the tables after FROM and JOIN in the SQL, the quotes are trimmed later.
+	queryCacheTablesRegexp = regexp.MustCompile(`(?i)\b(?:from|join)\s+([` + "`" + `"\[\]\w.]+)`)
+)
+
+type queryCacheRegistry struct {
+	resolver func() contractscache.Driver
+	// pending holds the tables written in the transactions, they are flushed again once the transaction is committed,
+	// the key is the identity of the transaction.
+	pending      map[any][]string
+	flushOnWrite bool
+	mu           sync.RWMutex
+}
+
+// RememberOptions are the options of a remembered query.
+type RememberOptions struct {
+	// Key is the cache key of the result, it's generated from the SQL and bindings if it's empty.
+	Key string
+	TTL time.Duration
+}
+
+// SetQueryCache sets the resolver of the cache store that the remembered query results are stored in,
+// the tables of the remembered queries are flushed after each write if flushOnWrite is true.
+func SetQueryCache(resolver func() contractscache.Driver, flushOnWrite bool) {
+	queryCache.mu.Lock()
+	defer queryCache.mu.Unlock()
+
+	queryCache.resolver = resolver
+	queryCache.flushOnWrite = flushOnWrite
+}
+
+// FlushQueryCache invalidates the remembered query results of the tables.
+func FlushQueryCache(ctx context.Context, tables ...string) error {
+	store := queryCacheStore(ctx)
+	if store == nil {
+		return nil
+	}
+
+	version := strconv.FormatInt(carbon.Now().TimestampNano(), 10)
+	for _, table := range tables {
+		if !store.Forever(queryCacheVersionKey(table), version) {
+			return errors.DatabaseQueryCacheFlushFailed.Args(table)
+		}
+	}
+
+	return nil
+}
+
+// FlushQueryCacheOnCommit flushes the tables written in the transaction again after it's committed, the results
+// cached by other connections before the commit would be stale otherwise. tx is the identity of the transaction.
+func FlushQueryCacheOnCommit(ctx context.Context, tx any) error {
+	queryCache.mu.Lock()
+	tables := queryCache.pending[tx]
+	delete(queryCache.pending, tx)
+	queryCache.mu.Unlock()
+
+	if len(tables) == 0 {
+		return nil
+	}
+
+	return FlushQueryCache(ctx, tables...)
+}
+
+// ForgetQueryCacheFlushes forgets the tables written in the transaction after it's rolled back.
+func ForgetQueryCacheFlushes(tx any) {
+	queryCache.mu.Lock()
+	defer queryCache.mu.Unlock()
+
+	delete(queryCache.pending, tx)
+}
+
+// RememberQuery gets the result of the query from the cache and populates the destination object, the callback
+// is executed and its result is stored in the cache if the result is missing. The callback is executed directly
+// if the cache store is not set. The results are cached per connection, see QueryCacheConnection, and they are
+// invalidated once any table in the query is flushed.
+func RememberQuery(ctx context.Context, options RememberOptions, connection, table, sql string, args []any, dest any, callback func() error) error {
+	store := queryCacheStore(ctx)
+	if store == nil {
+		return callback()
+	}
+	if err := checkQueryCacheable(dest); err != nil {
+		return err
+	}
+
+	key := queryCacheKey(store, options.Key, connection, queryCacheTables(table, sql), sql, args)
+	if value, ok := store.Get(key).(string); ok {
+		if err := json.Unmarshal([]byte(value), dest); err == nil {
+			return nil
+		}
+	}
+
+	if err := callback(); err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(dest)
+	if err != nil {
+		return err
+	}
+
+	return store.Put(key, string(value), options.TTL)
+}
+
+// RegisterQueryCacheCallbacks registers the gorm callbacks that flush the remembered query results of the
+// table after it's written by the Orm.
+func RegisterQueryCacheCallbacks(instance *gorm.DB) error {
+	queryCacheCallbackMux.Lock()
+	defer queryCacheCallbackMux.Unlock()
+
+	callbacks := instance.Callback()
+	if callbacks.Create().Get(queryCacheCallback) != nil {
+		return nil
+	}
+
+	callback := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.DryRun {
+			return
+		}
+
+		// The root transaction of the database tests is never committed, see databasedriver.BeginTransaction.
+		var transaction any
+		if _, ok := tx.Statement.ConnPool.(gorm.TxCommitter); ok {
+			if _, ok := tx.Statement.ConnPool.(*databasedriver.Transaction); !ok {
+				transaction = tx.Statement.ConnPool
+			}
+		}
+
+		flushQueryCacheOnWrite(tx.Statement.Context, transaction, tx.Statement.Table)
+	}
+
+	if err := callbacks.Create().After("gorm:create").Register(queryCacheCallback, callback); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(queryCacheCallback, callback); err != nil {
+		return err
+	}
+
+	return callbacks.Delete().After("gorm:delete").Register(queryCacheCallback, callback)
+}
+
+// flushQueryCacheOnWrite flushes the table after it's written, the table is flushed again once the transaction is
+// committed if tx isn't nil, see FlushQueryCacheOnCommit.
+func flushQueryCacheOnWrite(ctx context.Context, tx any, table string) {
+	queryCache.mu.Lock()
+	flushOnWrite := queryCache.flushOnWrite
+	if flushOnWrite && table != "" && tx != nil {
+		if queryCache.pending == nil {
+			queryCache.pending = make(map[any][]string)
+		}
+		if !slices.Contains(queryCache.pending[tx], table) {
+			queryCache.pending[tx] = append(queryCache.pending[tx], table)
+		}
+	}
+	queryCache.mu.Unlock()
+
+	if flushOnWrite && table != "" {
+		_ = FlushQueryCache(ctx, table)
+	}
+}
+
+// QueryCacheConnection gets the identity of the connection that the remembered results are cached for, the results
+// of the same query on different connections, eg: the connections of different tenants, are cached separately.
+func QueryCacheConnection(config contractsdatabase.Config) string {
+	connection := fmt.Sprintf("%s.%s", config.Connection, config.Database)
+	if config.Schema != "" {
+		connection += "." + config.Schema
+	}
+
+	return connection
+}
+
+// checkQueryCacheable returns an error if the results can't be restored from the cache exactly. The results are
+// encoded as JSON, so the fields ignored by JSON would be lost, and the casted values, eg: the encrypted values, would
+// be cached as the plain values.
+func checkQueryCacheable(dest any) error {
+	t := reflect.TypeOf(dest)
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if _, ok := reflect.New(t).Interface().(contractsorm.ModelWithCasts); ok {
+		return errors.DatabaseQueryCacheUnsupportedModel.Args(t.String(), "the model has casts")
+	}
+	if field := jsonIgnoredField(t); field != "" {
+		return errors.DatabaseQueryCacheUnsupportedModel.Args(t.String(), fmt.Sprintf("the field %s is ignored by JSON", field))
+	}
+
+	return nil
+}
+
+func jsonIgnoredField(t reflect.Type) string {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if name := jsonIgnoredField(field.Type); name != "" {
+				return name
+			}
+			continue
+		}
+		if field.IsExported() && field.Tag.Get("json") == "-" {
+			return field.Name
+		}
+	}
+
+	return ""
+}
+
+func queryCacheStore(ctx context.Context) contractscache.Driver {
+	queryCache.mu.RLock()
+	resolver := queryCache.resolver
+	queryCache.mu.RUnlock()
+
+	if resolver == nil {
+		return nil
+	}
+
+	store := resolver()
+	if store == nil {
+		return nil
+	}
+
+	return store.WithContext(ctx)
+}
+
+// queryCacheKey generates the key with the connection and the versions of the tables, so the results are
+// invalidated once any of the tables is flushed.
+func queryCacheKey(store contractscache.Driver, key, connection string, tables []string, sql string, args []any) string {
+	if key == "" {
+		hash := sha1.Sum([]byte(fmt.Sprintf("%s%v", sql, args)))
+		key = hex.EncodeToString(hash[:])
+	}
+
+	versions := make([]string, 0, len(tables))
+	for _, table := range tables {
+		versions = append(versions, fmt.Sprintf("%s:%s", table, store.GetString(queryCacheVersionKey(table))))
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%s", queryCachePrefix, connection, strings.Join(versions, ","), key)
+}
+
+func queryCacheVersionKey(table string) string {
+	return fmt.Sprintf("%s:%s:version", queryCachePrefix, table)
+}
+
+// queryCacheTables gets the table of the query and the other tables in the SQL, eg: the joined tables.
+func queryCacheTables(table, sql string) []string {
+	var tables []string
+	if table != "" {
+		tables = append(tables, table)
+	}
+
+	for _, match := range queryCacheTablesRegexp.FindAllStringSubmatch(sql, -1) {
+		name := strings.NewReplacer("`", "", `"`, "", "[", "", "]", "").Replace(match[1])
+		if name != "" && !slices.Contains(tables, name) {
+			tables = append(tables, name)
+		}
+	}
+
+	return tables
+}
+
+// queryCacheTable gets the table name without the alias, eg: "users as u" -> "users".
+func queryCacheTable(table string) string {
+	if fields := strings.Fields(table); len(fields) > 0 {
+		return fields[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractscache "github.com/rusmanplatd/goravelframework/contracts/cache"
+	contractsdatabase "github.com/rusmanplatd/goravelframework/contracts/database"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mockscache "github.com/rusmanplatd/goravelframework/mocks/cache"
+)
+
+type cachedUser struct {
+	Name string
+	Age  int
+}
+
+type cachedSecretUser struct {
+	cachedUser
+	Password string `json:"-"`
+}
+
+type cachedCastUser struct {
+	Name string
+}
+
+func (r *cachedCastUser) Casts() map[string]contractsorm.Cast {
+	return nil
+}
+
+func TestRememberQuery(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		mockStore *mockscache.Driver
+		options   = RememberOptions{Key: "active_users", TTL: time.Minute}
+	)
+
+	beforeEach := func() {
+		mockStore = mockscache.NewDriver(t)
+		SetQueryCache(func() contractscache.Driver {
+			return mockStore
+		}, true)
+	}
+	t.Cleanup(func() {
+		SetQueryCache(nil, false)
+	})
+
+	t.Run("execute directly if the cache store is not set", func(t *testing.T) {
+		SetQueryCache(nil, false)
+
+		var calls int
+		var user cachedUser
+		assert.NoError(t, RememberQuery(ctx, options, "postgres.goravel", "users", "SELECT * FROM users", nil, &user, func() error {
+			calls++
+			return nil
+		}))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("store the result if it's missing", func(t *testing.T) {
+		beforeEach()
+
+		mockStore.EXPECT().WithContext(ctx).Return(mockStore).Once()
+		mockStore.EXPECT().GetString("goravel_query_cache:users:version").Return("1").Once()
+		mockStore.EXPECT().Get("goravel_query_cache:postgres.goravel:users:1:active_users").Return(nil).Once()
+		mockStore.EXPECT().Put("goravel_query_cache:postgres.goravel:users:1:active_users", `{"Name":"John","Age":18}`, time.Minute).Return(nil).Once()
+
+		var user cachedUser
+		assert.NoError(t, RememberQuery(ctx, options, "postgres.goravel", "users", "SELECT * FROM users", nil, &user, func() error {
+			user = cachedUser{Name: "John", Age: 18}
+			return nil
+		}))
+		assert.Equal(t, cachedUser{Name: "John", Age: 18}, user)
+	})
+
+	t.Run("get the result from the cache", func(t *testing.T) {
+		beforeEach()
+
+		mockStore.EXPECT().WithContext(ctx).Return(mockStore).Once()
+		mockStore.EXPECT().GetString("goravel_query_cache:users:version").Return("1").Once()
+		mockStore.EXPECT().Get("goravel_query_cache:postgres.goravel:users:1:active_users").Return(`{"Name":"John","Age":18}`).Once()
+
+		var user cachedUser
+		assert.NoError(t, RememberQuery(ctx, options, "postgres.goravel", "users", "SELECT * FROM users", nil, &user, func() error {
+			t.Fatal("the query should not be executed")
+			return nil
+		}))
+		assert.Equal(t, cachedUser{Name: "John", Age: 18}, user)
+	})
+
+	t.Run("return error if the model can't be restored from the cache exactly", func(t *testing.T) {
+		beforeEach()
+
+		mockStore.EXPECT().WithContext(ctx).Return(mockStore).Twice()
+
+		var users []*cachedSecretUser
+		assert.Equal(t, errors.DatabaseQueryCacheUnsupportedModel.Args("db.cachedSecretUser", "the field Password is ignored by JSON"), RememberQuery(ctx, options, "postgres.goravel", "users", "SELECT * FROM users", nil, &users, func() error {
+			t.Fatal("the query should not be executed")
+			return nil
+		}))
+
+		var user cachedCastUser
+		assert.Equal(t, errors.DatabaseQueryCacheUnsupportedModel.Args("db.cachedCastUser", "the model has casts"), RememberQuery(ctx, options, "postgres.goravel", "users", "SELECT * FROM users", nil, &user, func() error {
+			t.Fatal("the query should not be executed")
+			return nil
+		}))
+	})
+
+	t.Run("don't store the result if the query fails", func(t *testing.T) {
+		beforeEach()
+
+		mockStore.EXPECT().WithContext(ctx).Return(mockStore).Once()
+		mockStore.EXPECT().GetString("goravel_query_cache:users:version").Return("").Once()
+		mockStore.EXPECT().Get("goravel_query_cache:postgres.goravel:users::active_users").Return(nil).Once()
+
+		var user cachedUser
+		assert.Equal(t, assert.AnError, RememberQuery(ctx, options, "postgres.goravel", "users", "SELECT * FROM users", nil, &user, func() error {
+			return assert.AnError
+		}))
+	})
+}
+
+func TestFlushQueryCache(t *testing.T) {
+	ctx := context.Background()
+	mockStore := mockscache.NewDriver(t)
+	SetQueryCache(func() contractscache.Driver {
+		return mockStore
+	}, false)
+	t.Cleanup(func() {
+		SetQueryCache(nil, false)
+	})
+
+	// The tables are not flushed after writing if flushOnWrite is false.
+	flushQueryCacheOnWrite(ctx, nil, "users")
+
+	mockStore.EXPECT().WithContext(ctx).Return(mockStore).Twice()
+	mockStore.EXPECT().Forever("goravel_query_cache:users:version", mock.AnythingOfType("string")).Return(true).Once()
+	mockStore.EXPECT().Forever("goravel_query_cache:books:version", mock.AnythingOfType("string")).Return(true).Once()
+	assert.NoError(t, FlushQueryCache(ctx, "users", "books"))
+
+	mockStore.EXPECT().Forever("goravel_query_cache:users:version", mock.AnythingOfType("string")).Return(false).Once()
+	assert.Equal(t, errors.DatabaseQueryCacheFlushFailed.Args("users"), FlushQueryCache(ctx, "users"))
+}
+
+func TestFlushQueryCacheOnCommit(t *testing.T) {
+	ctx := context.Background()
+	mockStore := mockscache.NewDriver(t)
+	SetQueryCache(func() contractscache.Driver {
+		return mockStore
+	}, true)
+	t.Cleanup(func() {
+		SetQueryCache(nil, false)
+	})
+
+	committed := &[]TxLog{}
+	rolledBack := &[]TxLog{}
+
+	// The tables are flushed immediately, and flushed again after the transaction is committed.
+	mockStore.EXPECT().WithContext(ctx).Return(mockStore).Times(3)
+	mockStore.EXPECT().Forever("goravel_query_cache:users:version", mock.AnythingOfType("string")).Return(true).Twice()
+	mockStore.EXPECT().Forever("goravel_query_cache:books:version", mock.AnythingOfType("string")).Return(true).Once()
+	flushQueryCacheOnWrite(ctx, committed, "users")
+	flushQueryCacheOnWrite(ctx, committed, "users")
+	flushQueryCacheOnWrite(ctx, rolledBack, "books")
+
+	ForgetQueryCacheFlushes(rolledBack)
+	assert.NoError(t, FlushQueryCacheOnCommit(ctx, rolledBack))
+
+	mockStore.EXPECT().WithContext(ctx).Return(mockStore).Once()
+	mockStore.EXPECT().Forever("goravel_query_cache:users:version", mock.AnythingOfType("string")).Return(true).Once()
+	assert.NoError(t, FlushQueryCacheOnCommit(ctx, committed))
+	assert.NoError(t, FlushQueryCacheOnCommit(ctx, committed))
+}
+
+func TestQueryCacheKey(t *testing.T) {
+	mockStore := mockscache.NewDriver(t)
+	mockStore.EXPECT().GetString("goravel_query_cache:users:version").Return("1").Times(4)
+	mockStore.EXPECT().GetString("goravel_query_cache:books:version").Return("2").Once()
+
+	key := queryCacheKey(mockStore, "", "postgres.goravel", []string{"users"}, "SELECT * FROM users WHERE id = ?", []any{1})
+	assert.Regexp(t, `^goravel_query_cache:postgres.goravel:users:1:[0-9a-f]{40}$`, key)
+	assert.NotEqual(t, key, queryCacheKey(mockStore, "", "postgres.goravel", []string{"users"}, "SELECT * FROM users WHERE id = ?", []any{2}))
+	assert.NotEqual(t, key, queryCacheKey(mockStore, "", "postgres.tenant", []string{"users"}, "SELECT * FROM users WHERE id = ?", []any{1}))
+	assert.Equal(t, "goravel_query_cache:postgres.goravel:users:1,books:2:active_users", queryCacheKey(mockStore, "active_users", "postgres.goravel", []string{"users", "books"}, "", nil))
+
+	assert.Equal(t, "postgres.goravel.public", QueryCacheConnection(contractsdatabase.Config{Connection: "postgres", Database: "goravel", Schema: "public"}))
+	assert.Equal(t, []string{"users", "books", "public.roles"}, queryCacheTables("users", `SELECT * FROM "users" LEFT JOIN `+"`books`"+` ON books.user_id = users.id join public.roles on 1 = 1 WHERE id IN (SELECT user_id FROM users)`))
+	assert.Equal(t, []string{"users"}, queryCacheTables("", "select count(*) from (select * from users) as aggregate_users"))
+
+	assert.Equal(t, "users", queryCacheTable("users as u"))
+	assert.Equal(t, "", queryCacheTable(""))
+}
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+)
+
+var queryListeners = &queryListenerRegistry{}
+
+type queryListenerRegistry struct {
+	listeners        []func(event contractsdb.QueryExecuted)
+	slowListeners    []func(event contractsdb.QueryExecuted)
+	slowQueryEvent   func(event contractsdb.QueryExecuted)
+	durationHandlers []*durationHandler
+	mu               sync.RWMutex
+}
+
+type durationHandler struct {
+	callback  func(ctx context.Context, total time.Duration)
+	threshold time.Duration
+}
+
+type queryTimeKey struct{}
+
+type queryTimeValue struct {
+	fired map[*durationHandler]bool
+	total time.Duration
+	mu    sync.Mutex
+}
+
+// ListenQueries registers a listener that is called after each executed query.
+func ListenQueries(callback func(event contractsdb.QueryExecuted)) {
+	queryListeners.mu.Lock()
+	defer queryListeners.mu.Unlock()
+
+	queryListeners.listeners = append(queryListeners.listeners, callback)
+}
+
+// ListenSlowQueries registers a listener that is called after each query that takes longer than the slow
+// threshold of its connection.
+func ListenSlowQueries(callback func(event contractsdb.QueryExecuted)) {
+	queryListeners.mu.Lock()
+	defer queryListeners.mu.Unlock()
+
+	queryListeners.slowListeners = append(queryListeners.slowListeners, callback)
+}
+
+// SetSlowQueryEvent sets the dispatcher of the QueryExecuted event of the slow queries, unlike ListenSlowQueries,
+// it replaces the previous one, so the event isn't dispatched twice when the service provider is booted again.
+func SetSlowQueryEvent(dispatch func(event contractsdb.QueryExecuted)) {
+	queryListeners.mu.Lock()
+	defer queryListeners.mu.Unlock()
+
+	queryListeners.slowQueryEvent = dispatch
+}
+
+// WhenQueryingForLongerThan registers a callback that is called once when the cumulative query time of a
+// context prepared by TrackQueryTime exceeds the threshold.
+func WhenQueryingForLongerThan(threshold time.Duration, callback func(ctx context.Context, total time.Duration)) {
+	queryListeners.mu.Lock()
+	defer queryListeners.mu.Unlock()
+
+	queryListeners.durationHandlers = append(queryListeners.durationHandlers, &durationHandler{
+		callback:  callback,
+		threshold: threshold,
+	})
+}
+
+// FlushQueryListeners removes all the registered query listeners.
+func FlushQueryListeners() {
+	queryListeners.mu.Lock()
+	defer queryListeners.mu.Unlock()
+
+	queryListeners.listeners = nil
+	queryListeners.slowListeners = nil
+	queryListeners.durationHandlers = nil
+}
+
+// TrackQueryTime enables the cumulative query time tracking for the context, eg: a request.
+func TrackQueryTime(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryTimeKey{}, &queryTimeValue{
+		fired: make(map[*durationHandler]bool),
+	})
+}
+
+// GetQueryTime gets the cumulative query time of the context prepared by TrackQueryTime.
+func GetQueryTime(ctx context.Context) time.Duration {
+	value, ok := ctx.Value(queryTimeKey{}).(*queryTimeValue)
+	if !ok {
+		return 0
+	}
+
+	value.mu.Lock()
+	defer value.mu.Unlock()
+
+	return value.total
+}
+
+func notifyQueryListeners(ctx context.Context, event contractsdb.QueryExecuted, slow bool) {
+	queryListeners.mu.RLock()
+	listeners := queryListeners.listeners
+	slowListeners := queryListeners.slowListeners
+	slowQueryEvent := queryListeners.slowQueryEvent
+	durationHandlers := queryListeners.durationHandlers
+	queryListeners.mu.RUnlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+
+	if slow {
+		for _, listener := range slowListeners {
+			listener(event)
+		}
+		if slowQueryEvent != nil {
+			slowQueryEvent(event)
+		}
+	}
+
+	addQueryTimeToContext(ctx, event.Duration, durationHandlers)
+}
+
+func addQueryTimeToContext(ctx context.Context, duration time.Duration, handlers []*durationHandler) {
+	value, ok := ctx.Value(queryTimeKey{}).(*queryTimeValue)
+	if !ok {
+		return
+	}
+
+	value.mu.Lock()
+	value.total += duration
+	total := value.total
+
+	var exceeded []*durationHandler
+	for _, handler := range handlers {
+		if total > handler.threshold && !value.fired[handler] {
+			value.fired[handler] = true
+			exceeded = append(exceeded, handler)
+		}
+	}
+	value.mu.Unlock()
+
+	for _, handler := range exceeded {
+		handler.callback(ctx, total)
+	}
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+)
+
+func TestWhenQueryingForLongerThan(t *testing.T) {
+	defer FlushQueryListeners()
+
+	var (
+		calls int
+		total time.Duration
+	)
+	WhenQueryingForLongerThan(100*time.Millisecond, func(ctx context.Context, duration time.Duration) {
+		calls++
+		total = duration
+	})
+
+	ctx := TrackQueryTime(context.Background())
+	notifyQueryListeners(ctx, contractsdb.QueryExecuted{Duration: 60 * time.Millisecond}, false)
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 60*time.Millisecond, GetQueryTime(ctx))
+
+	notifyQueryListeners(ctx, contractsdb.QueryExecuted{Duration: 60 * time.Millisecond}, false)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 120*time.Millisecond, total)
+
+	notifyQueryListeners(ctx, contractsdb.QueryExecuted{Duration: 60 * time.Millisecond}, false)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 180*time.Millisecond, GetQueryTime(ctx))
+
+	// The query time of an untracked context is ignored.
+	notifyQueryListeners(context.Background(), contractsdb.QueryExecuted{Duration: time.Second}, false)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, time.Duration(0), GetQueryTime(context.Background()))
+
+	// Each tracked context is measured separately.
+	anotherCtx := TrackQueryTime(context.Background())
+	notifyQueryListeners(anotherCtx, contractsdb.QueryExecuted{Duration: time.Second}, false)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, time.Second, total)
+}
+
+func TestListenQueries(t *testing.T) {
+	defer FlushQueryListeners()
+
+	var (
+		events     []contractsdb.QueryExecuted
+		slowEvents []contractsdb.QueryExecuted
+	)
+	ListenQueries(func(event contractsdb.QueryExecuted) {
+		events = append(events, event)
+	})
+	ListenSlowQueries(func(event contractsdb.QueryExecuted) {
+		slowEvents = append(slowEvents, event)
+	})
+
+	notifyQueryListeners(context.Background(), contractsdb.QueryExecuted{SQL: "SELECT 1"}, false)
+	notifyQueryListeners(context.Background(), contractsdb.QueryExecuted{SQL: "SELECT 2"}, true)
+
+	assert.Equal(t, []contractsdb.QueryExecuted{{SQL: "SELECT 1"}, {SQL: "SELECT 2"}}, events)
+	assert.Equal(t, []contractsdb.QueryExecuted{{SQL: "SELECT 2"}}, slowEvents)
+
+	FlushQueryListeners()
+	notifyQueryListeners(context.Background(), contractsdb.QueryExecuted{SQL: "SELECT 3"}, true)
+	assert.Len(t, events, 2)
+}
+
+func TestSetSlowQueryEvent(t *testing.T) {
+	defer SetSlowQueryEvent(nil)
+
+	var first, second int
+	SetSlowQueryEvent(func(event contractsdb.QueryExecuted) {
+		first++
+	})
+	// Setting it again replaces the previous one, eg: the service provider is booted twice.
+	SetSlowQueryEvent(func(event contractsdb.QueryExecuted) {
+		second++
+	})
+
+	notifyQueryListeners(context.Background(), contractsdb.QueryExecuted{}, false)
+	notifyQueryListeners(context.Background(), contractsdb.QueryExecuted{}, true)
+
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 1, second)
+}
@@ -4,19 +4,23 @@ import (
 	"context"
 	databasesql "database/sql"
 	"testing"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	contractscache "github.com/rusmanplatd/goravelframework/contracts/cache"
 	"github.com/rusmanplatd/goravelframework/contracts/database/db"
 	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
 	"github.com/rusmanplatd/goravelframework/errors"
+	mockscache "github.com/rusmanplatd/goravelframework/mocks/cache"
 	mocksdb "github.com/rusmanplatd/goravelframework/mocks/database/db"
 	mocksdriver "github.com/rusmanplatd/goravelframework/mocks/database/driver"
 	mockslogger "github.com/rusmanplatd/goravelframework/mocks/database/logger"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
+	"github.com/rusmanplatd/goravelframework/support/database"
 )
 
 // TestUser is a test model
@@ -440,6 +444,19 @@ func (s *QueryTestSuite) TestFirstOrFail() {
 	})
 }
 
+func (s *QueryTestSuite) TestFromSub() {
+	var users []TestUser
+
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM (SELECT * FROM users WHERE age > $1) AS adults WHERE name = $2", 18, "John").Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM (SELECT * FROM users WHERE age > $1) AS adults WHERE name = $2", 18, "John").Return("SELECT * FROM (SELECT * FROM users WHERE age > 18) AS adults WHERE name = 'John'").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM (SELECT * FROM users WHERE age > 18) AS adults WHERE name = 'John'", int64(0), nil).Return().Once()
+
+	subQuery := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "users", nil).Where("age > ?", 18)
+	err := s.query.FromSub(subQuery, "adults").Where("name", "John").Get(&users)
+	s.Nil(err)
+}
+
 func (s *QueryTestSuite) TestGet() {
 	s.Run("success", func() {
 		var users []TestUser
@@ -716,6 +733,96 @@ func (s *QueryTestSuite) TestInsertGetID() {
 	})
 }
 
+func (s *QueryTestSuite) TestInsertOrIgnore() {
+	users := []map[string]any{
+		{"email": "john@example.com", "name": "John"},
+		{"email": "jane@example.com", "name": "Jane"},
+	}
+
+	s.Run("empty", func() {
+		result, err := s.query.InsertOrIgnore(nil)
+		s.Equal(errors.DatabaseDataIsEmpty, err)
+		s.Nil(result)
+	})
+
+	s.Run("mysql", func() {
+		mockResult := &MockResult{}
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockWriteBuilder.EXPECT().DriverName().Return("mysql").Once()
+		s.mockWriteBuilder.EXPECT().ExecContext(s.ctx, "INSERT IGNORE INTO users (email,name) VALUES (?,?),(?,?)", "john@example.com", "John", "jane@example.com", "Jane").Return(mockResult, nil).Once()
+		s.mockWriteBuilder.EXPECT().Explain("INSERT IGNORE INTO users (email,name) VALUES (?,?),(?,?)", "john@example.com", "John", "jane@example.com", "Jane").Return("INSERT IGNORE INTO users (email,name) VALUES (\"john@example.com\",\"John\"),(\"jane@example.com\",\"Jane\")").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "INSERT IGNORE INTO users (email,name) VALUES (\"john@example.com\",\"John\"),(\"jane@example.com\",\"Jane\")", int64(1), nil).Return().Once()
+
+		result, err := s.query.InsertOrIgnore(users)
+		s.Nil(err)
+		s.Equal(int64(1), result.RowsAffected)
+
+		mockResult.AssertExpectations(s.T())
+	})
+
+	s.Run("postgres", func() {
+		mockResult := &MockResult{}
+		mockResult.On("RowsAffected").Return(int64(2), nil)
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+		s.mockWriteBuilder.EXPECT().DriverName().Return("postgres").Once()
+		s.mockWriteBuilder.EXPECT().ExecContext(s.ctx, "INSERT INTO users (email,name) VALUES ($1,$2),($3,$4) ON CONFLICT DO NOTHING", "john@example.com", "John", "jane@example.com", "Jane").Return(mockResult, nil).Once()
+		s.mockWriteBuilder.EXPECT().Explain("INSERT INTO users (email,name) VALUES ($1,$2),($3,$4) ON CONFLICT DO NOTHING", "john@example.com", "John", "jane@example.com", "Jane").Return("INSERT INTO users (email,name) VALUES ('john@example.com','John'),('jane@example.com','Jane') ON CONFLICT DO NOTHING").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "INSERT INTO users (email,name) VALUES ('john@example.com','John'),('jane@example.com','Jane') ON CONFLICT DO NOTHING", int64(2), nil).Return().Once()
+
+		result, err := s.query.InsertOrIgnore(users)
+		s.Nil(err)
+		s.Equal(int64(2), result.RowsAffected)
+
+		mockResult.AssertExpectations(s.T())
+	})
+
+	s.Run("postgres with unique by", func() {
+		mockResult := &MockResult{}
+		mockResult.On("RowsAffected").Return(int64(2), nil)
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+		s.mockWriteBuilder.EXPECT().DriverName().Return("postgres").Once()
+		s.mockWriteBuilder.EXPECT().ExecContext(s.ctx, "INSERT INTO users (email,name) VALUES ($1,$2),($3,$4) ON CONFLICT (email) DO NOTHING", "john@example.com", "John", "jane@example.com", "Jane").Return(mockResult, nil).Once()
+		s.mockWriteBuilder.EXPECT().Explain("INSERT INTO users (email,name) VALUES ($1,$2),($3,$4) ON CONFLICT (email) DO NOTHING", "john@example.com", "John", "jane@example.com", "Jane").Return("INSERT INTO users (email,name) VALUES ('john@example.com','John'),('jane@example.com','Jane') ON CONFLICT (email) DO NOTHING").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "INSERT INTO users (email,name) VALUES ('john@example.com','John'),('jane@example.com','Jane') ON CONFLICT (email) DO NOTHING", int64(2), nil).Return().Once()
+
+		result, err := s.query.InsertOrIgnore(users, "email")
+		s.Nil(err)
+		s.Equal(int64(2), result.RowsAffected)
+
+		mockResult.AssertExpectations(s.T())
+	})
+
+	s.Run("sqlserver", func() {
+		mockResult := &MockResult{}
+		mockResult.On("RowsAffected").Return(int64(2), nil)
+
+		sql := "MERGE INTO [users] AS [target] USING (VALUES (@p1,@p2),(@p3,@p4)) AS [goravel_upsert_alias] ([email],[name]) ON [target].[email] = [goravel_upsert_alias].[email] WHEN NOT MATCHED THEN INSERT ([email],[name]) VALUES ([goravel_upsert_alias].[email],[goravel_upsert_alias].[name]);"
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.AtP).Once()
+		s.mockWriteBuilder.EXPECT().DriverName().Return("sqlserver").Once()
+		s.mockWriteBuilder.EXPECT().ExecContext(s.ctx, sql, "john@example.com", "John", "jane@example.com", "Jane").Return(mockResult, nil).Once()
+		s.mockWriteBuilder.EXPECT().Explain(sql, "john@example.com", "John", "jane@example.com", "Jane").Return(sql).Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, sql, int64(2), nil).Return().Once()
+
+		result, err := s.query.InsertOrIgnore(users, "email")
+		s.Nil(err)
+		s.Equal(int64(2), result.RowsAffected)
+
+		mockResult.AssertExpectations(s.T())
+	})
+
+	s.Run("sqlserver without unique by", func() {
+		s.mockWriteBuilder.EXPECT().DriverName().Return("sqlserver").Once()
+
+		result, err := s.query.InsertOrIgnore(users)
+		s.Equal(errors.DatabaseIgnoreUniqueByIsRequired.Args("sqlserver"), err)
+		s.Nil(result)
+	})
+}
+
 func (s *QueryTestSuite) TestJoin() {
 	var users []TestUser
 
@@ -728,6 +835,20 @@ func (s *QueryTestSuite) TestJoin() {
 	s.Nil(err)
 }
 
+func (s *QueryTestSuite) TestJoinSub() {
+	var users []TestUser
+
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users JOIN (SELECT user_id, MAX(created_at) AS last_posted_at FROM posts WHERE status = $1 GROUP BY user_id) AS latest ON latest.user_id = users.id AND latest.last_posted_at > $2 WHERE age = $3", "published", "2024-01-01", 25).Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users JOIN (SELECT user_id, MAX(created_at) AS last_posted_at FROM posts WHERE status = $1 GROUP BY user_id) AS latest ON latest.user_id = users.id AND latest.last_posted_at > $2 WHERE age = $3", "published", "2024-01-01", 25).Return("SELECT * FROM users JOIN (SELECT user_id, MAX(created_at) AS last_posted_at FROM posts WHERE status = 'published' GROUP BY user_id) AS latest ON latest.user_id = users.id AND latest.last_posted_at > '2024-01-01' WHERE age = 25").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users JOIN (SELECT user_id, MAX(created_at) AS last_posted_at FROM posts WHERE status = 'published' GROUP BY user_id) AS latest ON latest.user_id = users.id AND latest.last_posted_at > '2024-01-01' WHERE age = 25", int64(0), nil).Return().Once()
+
+	subQuery := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "posts", nil).
+		Select("user_id", "MAX(created_at) AS last_posted_at").Where("status", "published").GroupBy("user_id")
+	err := s.query.JoinSub(subQuery, "latest", "latest.user_id = users.id AND latest.last_posted_at > ?", "2024-01-01").Where("age", 25).Get(&users)
+	s.Nil(err)
+}
+
 func (s *QueryTestSuite) TestLatest() {
 	s.Run("default column", func() {
 		var user TestUser
@@ -766,6 +887,19 @@ func (s *QueryTestSuite) TestLeftJoin() {
 	s.Nil(err)
 }
 
+func (s *QueryTestSuite) TestLeftJoinSub() {
+	var users []TestUser
+
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users LEFT JOIN (SELECT * FROM posts WHERE status = ?) AS p ON p.user_id = users.id WHERE age = ?", "published", 25).Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users LEFT JOIN (SELECT * FROM posts WHERE status = ?) AS p ON p.user_id = users.id WHERE age = ?", "published", 25).Return("SELECT * FROM users LEFT JOIN (SELECT * FROM posts WHERE status = \"published\") AS p ON p.user_id = users.id WHERE age = 25").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users LEFT JOIN (SELECT * FROM posts WHERE status = \"published\") AS p ON p.user_id = users.id WHERE age = 25", int64(0), nil).Return().Once()
+
+	subQuery := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "posts", nil).Where("status", "published")
+	err := s.query.LeftJoinSub(subQuery, "p", "p.user_id = users.id").Where("age", 25).Get(&users)
+	s.Nil(err)
+}
+
 func (s *QueryTestSuite) TestLockForUpdate() {
 	s.Run("FOR UPDATE", func() {
 		var users []TestUser
@@ -860,6 +994,19 @@ func (s *QueryTestSuite) TestOrderByRaw() {
 	s.Nil(err)
 }
 
+func (s *QueryTestSuite) TestOrderByFullText() {
+	var users []TestUser
+
+	s.mockWriteBuilder.EXPECT().DriverName().Return("mysql").Twice()
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE) ORDER BY MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE) DESC, id ASC", "goravel", "goravel").Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE) ORDER BY MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE) DESC, id ASC", "goravel", "goravel").Return("SELECT * FROM users WHERE MATCH (title, body) AGAINST (\"goravel\" IN NATURAL LANGUAGE MODE) ORDER BY MATCH (title, body) AGAINST (\"goravel\" IN NATURAL LANGUAGE MODE) DESC, id ASC").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users WHERE MATCH (title, body) AGAINST (\"goravel\" IN NATURAL LANGUAGE MODE) ORDER BY MATCH (title, body) AGAINST (\"goravel\" IN NATURAL LANGUAGE MODE) DESC, id ASC", int64(0), nil).Return().Once()
+
+	err := s.query.WhereFullText([]string{"title", "body"}, "goravel").OrderByFullText([]string{"title", "body"}, "goravel").OrderBy("id").Get(&users)
+	s.Nil(err)
+}
+
 func (s *QueryTestSuite) TestOrWhere() {
 	now := carbon.Now()
 	carbon.SetTestNow(now)
@@ -1060,6 +1207,49 @@ func (s *QueryTestSuite) TestPaginate() {
 	s.Equal(2, len(users))
 }
 
+func (s *QueryTestSuite) TestCursorPaginate() {
+	database.SetCursorKey("key")
+	defer database.SetCursorKey("")
+
+	var users []TestUser
+
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE name = ? ORDER BY id DESC LIMIT 2", "John").Run(func(ctx context.Context, dest any, query string, args ...any) {
+		destUsers := dest.(*[]TestUser)
+		*destUsers = []TestUser{{ID: 3}, {ID: 2}}
+	}).Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE name = ? ORDER BY id DESC LIMIT 2", "John").Return("SELECT * FROM users WHERE name = \"John\" ORDER BY id DESC LIMIT 2").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users WHERE name = \"John\" ORDER BY id DESC LIMIT 2", int64(2), nil).Return().Once()
+
+	next, prev, err := s.query.Where("name", "John").OrderByDesc("id").CursorPaginate(1, "", &users)
+	s.NoError(err)
+	s.Equal([]TestUser{{ID: 3}}, users)
+	s.NotEmpty(next)
+	s.Empty(prev)
+
+	users = nil
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE (name = ? AND ((id < ?))) ORDER BY id DESC LIMIT 2", "John", int64(3)).Run(func(ctx context.Context, dest any, query string, args ...any) {
+		destUsers := dest.(*[]TestUser)
+		*destUsers = []TestUser{{ID: 2}}
+	}).Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE (name = ? AND ((id < ?))) ORDER BY id DESC LIMIT 2", "John", int64(3)).Return("SELECT * FROM users WHERE (name = \"John\" AND ((id < 3))) ORDER BY id DESC LIMIT 2").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users WHERE (name = \"John\" AND ((id < 3))) ORDER BY id DESC LIMIT 2", int64(1), nil).Return().Once()
+
+	next, prev, err = s.query.Where("name", "John").OrderByDesc("id").CursorPaginate(1, next, &users)
+	s.NoError(err)
+	s.Equal([]TestUser{{ID: 2}}, users)
+	s.Empty(next)
+	s.NotEmpty(prev)
+
+	_, _, err = s.query.InRandomOrder().CursorPaginate(1, "", &users)
+	s.Equal(errors.DatabaseCursorInvalidOrder.Args("random"), err)
+
+	s.mockWriteBuilder.EXPECT().DriverName().Return("mysql").Once()
+	_, _, err = s.query.OrderByFullText([]string{"title", "body"}, "goravel").CursorPaginate(1, "", &users)
+	s.Equal(errors.DatabaseCursorInvalidOrder.Args("full text"), err)
+}
+
 func (s *QueryTestSuite) TestPluck() {
 	var names []string
 
@@ -1076,6 +1266,63 @@ func (s *QueryTestSuite) TestPluck() {
 	s.Equal([]string{"John"}, names)
 }
 
+func (s *QueryTestSuite) TestRemember() {
+	mockStore := mockscache.NewDriver(s.T())
+	SetQueryCache(func() contractscache.Driver {
+		return mockStore
+	}, true)
+	defer SetQueryCache(nil, false)
+	s.query.connection = "postgres.goravel"
+
+	s.Run("miss", func() {
+		var names []string
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		mockStore.EXPECT().WithContext(s.ctx).Return(mockStore).Once()
+		mockStore.EXPECT().GetString("goravel_query_cache:users:version").Return("1").Once()
+		mockStore.EXPECT().Get("goravel_query_cache:postgres.goravel:users:1:names").Return(nil).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &names, "SELECT name FROM users").Run(func(ctx context.Context, dest any, query string, args ...any) {
+			destNames := dest.(*[]string)
+			*destNames = []string{"John"}
+		}).Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("SELECT name FROM users").Return("SELECT name FROM users").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT name FROM users", int64(1), nil).Return().Once()
+		mockStore.EXPECT().Put("goravel_query_cache:postgres.goravel:users:1:names", `["John"]`, time.Minute).Return(nil).Once()
+
+		s.NoError(s.query.Remember(time.Minute, "names").Pluck("name", &names))
+		s.Equal([]string{"John"}, names)
+	})
+
+	s.Run("hit", func() {
+		var names []string
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		mockStore.EXPECT().WithContext(s.ctx).Return(mockStore).Once()
+		mockStore.EXPECT().GetString("goravel_query_cache:users:version").Return("1").Once()
+		mockStore.EXPECT().Get("goravel_query_cache:postgres.goravel:users:1:names").Return(`["John"]`).Once()
+
+		s.NoError(s.query.Remember(time.Minute, "names").Pluck("name", &names))
+		s.Equal([]string{"John"}, names)
+	})
+
+	s.Run("flush after writing", func() {
+		mockResult := &MockResult{}
+		mockResult.On("RowsAffected").Return(int64(1), nil)
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockWriteBuilder.EXPECT().ExecContext(s.ctx, "DELETE FROM users WHERE name = ?", "John").Return(mockResult, nil).Once()
+		s.mockWriteBuilder.EXPECT().Explain("DELETE FROM users WHERE name = ?", "John").Return("DELETE FROM users WHERE name = \"John\"").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "DELETE FROM users WHERE name = \"John\"", int64(1), nil).Return().Once()
+		mockStore.EXPECT().WithContext(s.ctx).Return(mockStore).Once()
+		mockStore.EXPECT().Forever("goravel_query_cache:users:version", mock.AnythingOfType("string")).Return(true).Once()
+
+		_, err := s.query.Where("name", "John").Delete()
+		s.NoError(err)
+
+		mockResult.AssertExpectations(s.T())
+	})
+}
+
 func (s *QueryTestSuite) TestRightJoin() {
 	var users []TestUser
 
@@ -1100,6 +1347,20 @@ func (s *QueryTestSuite) TestSelect() {
 	s.Nil(err)
 }
 
+func (s *QueryTestSuite) TestSelectSub() {
+	var users []TestUser
+
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT id, name, (SELECT COUNT(*) FROM posts WHERE posts.user_id = users.id AND status = $1) AS posts_count FROM users WHERE age = $2", "published", 25).Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT id, name, (SELECT COUNT(*) FROM posts WHERE posts.user_id = users.id AND status = $1) AS posts_count FROM users WHERE age = $2", "published", 25).Return("SELECT id, name, (SELECT COUNT(*) FROM posts WHERE posts.user_id = users.id AND status = 'published') AS posts_count FROM users WHERE age = 25").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT id, name, (SELECT COUNT(*) FROM posts WHERE posts.user_id = users.id AND status = 'published') AS posts_count FROM users WHERE age = 25", int64(0), nil).Return().Once()
+
+	subQuery := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "posts", nil).
+		Select("COUNT(*)").WhereRaw("posts.user_id = users.id AND status = ?", []any{"published"})
+	err := s.query.Select("id", "name").SelectSub("posts_count", subQuery).Where("age", 25).Get(&users)
+	s.Nil(err)
+}
+
 func (s *QueryTestSuite) TestSharedLock() {
 	s.Run("FOR SHARE", func() {
 		var users []TestUser
@@ -1364,6 +1625,54 @@ func (s *QueryTestSuite) TestToRawSql() {
 	})
 }
 
+func (s *QueryTestSuite) TestUnion() {
+	s.Run("union", func() {
+		var users []TestUser
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT id, name FROM users WHERE age = $1 UNION SELECT id, name FROM admins WHERE role = $2", 25, "owner").Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("SELECT id, name FROM users WHERE age = $1 UNION SELECT id, name FROM admins WHERE role = $2", 25, "owner").Return("SELECT id, name FROM users WHERE age = 25 UNION SELECT id, name FROM admins WHERE role = 'owner'").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT id, name FROM users WHERE age = 25 UNION SELECT id, name FROM admins WHERE role = 'owner'", int64(0), nil).Return().Once()
+
+		admins := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "admins", nil).Select("id", "name").Where("role", "owner")
+		err := s.query.Select("id", "name").Where("age", 25).Union(admins).Get(&users)
+		s.Nil(err)
+	})
+
+	s.Run("union all with order and limit", func() {
+		var users []TestUser
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM (SELECT id, name FROM users WHERE age = ? UNION ALL SELECT id, name FROM admins WHERE role = ?) AS unions ORDER BY name ASC LIMIT 10", 25, "owner").Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("SELECT * FROM (SELECT id, name FROM users WHERE age = ? UNION ALL SELECT id, name FROM admins WHERE role = ?) AS unions ORDER BY name ASC LIMIT 10", 25, "owner").Return("SELECT * FROM (SELECT id, name FROM users WHERE age = 25 UNION ALL SELECT id, name FROM admins WHERE role = \"owner\") AS unions ORDER BY name ASC LIMIT 10").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM (SELECT id, name FROM users WHERE age = 25 UNION ALL SELECT id, name FROM admins WHERE role = \"owner\") AS unions ORDER BY name ASC LIMIT 10", int64(0), nil).Return().Once()
+
+		admins := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "admins", nil).Select("id", "name").Where("role", "owner")
+		err := s.query.Select("id", "name").Where("age", 25).UnionAll(admins).OrderBy("name").Limit(10).Get(&users)
+		s.Nil(err)
+	})
+
+	s.Run("count", func() {
+		var count int64
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockReadBuilder.EXPECT().GetContext(s.ctx, &count, "SELECT COUNT(*) FROM (SELECT id FROM users WHERE age = ? UNION SELECT id FROM admins) AS aggregate", 25).Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("SELECT COUNT(*) FROM (SELECT id FROM users WHERE age = ? UNION SELECT id FROM admins) AS aggregate", 25).Return("SELECT COUNT(*) FROM (SELECT id FROM users WHERE age = 25 UNION SELECT id FROM admins) AS aggregate").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT COUNT(*) FROM (SELECT id FROM users WHERE age = 25 UNION SELECT id FROM admins) AS aggregate", int64(-1), nil).Return().Once()
+
+		admins := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "admins", nil).Select("id")
+		_, err := s.query.Select("id").Where("age", 25).Union(admins).Count()
+		s.Nil(err)
+	})
+
+	s.Run("invalid query", func() {
+		var users []TestUser
+
+		err := s.query.Union(nil).Get(&users)
+		s.Equal(errors.DatabaseUnsupportedType.Args("<nil>", "*db.Query"), err)
+	})
+}
+
 func (s *QueryTestSuite) TestUpdate() {
 	s.Run("single struct", func() {
 		user := TestUser{
@@ -1661,6 +1970,79 @@ func (s *QueryTestSuite) TestUpdateOrInsert() {
 	})
 }
 
+func (s *QueryTestSuite) TestUpsert() {
+	users := []map[string]any{
+		{"email": "john@example.com", "name": "John"},
+		{"email": "jane@example.com", "name": "Jane"},
+	}
+	args := []any{"john@example.com", "John", "jane@example.com", "Jane"}
+
+	s.Run("empty", func() {
+		result, err := s.query.Upsert(nil, []string{"email"}, nil)
+		s.Equal(errors.DatabaseDataIsEmpty, err)
+		s.Nil(result)
+	})
+
+	s.Run("unique by is required", func() {
+		result, err := s.query.Upsert(users, nil, nil)
+		s.Equal(errors.DatabaseUpsertUniqueByIsRequired, err)
+		s.Nil(result)
+	})
+
+	tests := []struct {
+		name              string
+		driver            string
+		placeholderFormat sq.PlaceholderFormat
+		update            []string
+		expectSql         string
+	}{
+		{
+			name:      "mysql",
+			driver:    "mysql",
+			update:    []string{"name"},
+			expectSql: "INSERT INTO users (email,name) VALUES (?,?),(?,?) AS goravel_upsert_alias ON DUPLICATE KEY UPDATE name = goravel_upsert_alias.name",
+		},
+		{
+			name:              "postgres",
+			driver:            "postgres",
+			placeholderFormat: sq.Dollar,
+			update:            []string{"name"},
+			expectSql:         "INSERT INTO users (email,name) VALUES ($1,$2),($3,$4) ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name",
+		},
+		{
+			name:      "sqlite updates all columns by default",
+			driver:    "sqlite",
+			expectSql: "INSERT INTO users (email,name) VALUES (?,?),(?,?) ON CONFLICT (email) DO UPDATE SET email = EXCLUDED.email, name = EXCLUDED.name",
+		},
+		{
+			name:              "sqlserver",
+			driver:            "sqlserver",
+			placeholderFormat: sq.AtP,
+			update:            []string{"name"},
+			expectSql:         "MERGE INTO [users] AS [target] USING (VALUES (@p1,@p2),(@p3,@p4)) AS [goravel_upsert_alias] ([email],[name]) ON [target].[email] = [goravel_upsert_alias].[email] WHEN MATCHED THEN UPDATE SET [name] = [goravel_upsert_alias].[name] WHEN NOT MATCHED THEN INSERT ([email],[name]) VALUES ([goravel_upsert_alias].[email],[goravel_upsert_alias].[name]);",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			mockResult := &MockResult{}
+			mockResult.On("RowsAffected").Return(int64(2), nil)
+
+			s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(test.placeholderFormat).Once()
+			s.mockWriteBuilder.EXPECT().DriverName().Return(test.driver).Once()
+			s.mockWriteBuilder.EXPECT().ExecContext(s.ctx, test.expectSql, args...).Return(mockResult, nil).Once()
+			s.mockWriteBuilder.EXPECT().Explain(test.expectSql, args...).Return(test.expectSql).Once()
+			s.mockLogger.EXPECT().Trace(s.ctx, s.now, test.expectSql, int64(2), nil).Return().Once()
+
+			result, err := s.query.Upsert(users, []string{"email"}, test.update)
+			s.Nil(err)
+			s.Equal(int64(2), result.RowsAffected)
+
+			mockResult.AssertExpectations(s.T())
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestValue() {
 	var name string
 
@@ -1723,6 +2105,58 @@ func (s *QueryTestSuite) TestWhen() {
 	})
 }
 
+func (s *QueryTestSuite) TestWith() {
+	s.Run("with", func() {
+		var users []TestUser
+
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "WITH active_users AS (SELECT * FROM users WHERE status = $1) SELECT * FROM active_users WHERE age = $2", "active", 25).Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("WITH active_users AS (SELECT * FROM users WHERE status = $1) SELECT * FROM active_users WHERE age = $2", "active", 25).Return("WITH active_users AS (SELECT * FROM users WHERE status = 'active') SELECT * FROM active_users WHERE age = 25").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "WITH active_users AS (SELECT * FROM users WHERE status = 'active') SELECT * FROM active_users WHERE age = 25", int64(0), nil).Return().Once()
+
+		activeUsers := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "users", nil).Where("status", "active")
+		query := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "active_users", nil)
+		err := query.With("active_users", activeUsers).Where("age", 25).Get(&users)
+		s.Nil(err)
+	})
+
+	s.Run("with recursive", func() {
+		var categories []TestUser
+
+		s.mockWriteBuilder.EXPECT().DriverName().Return("postgres").Once()
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &categories, "WITH RECURSIVE tree AS (SELECT id, parent_id FROM categories WHERE id = ? UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree", 1).Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("WITH RECURSIVE tree AS (SELECT id, parent_id FROM categories WHERE id = ? UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree", 1).Return("WITH RECURSIVE tree AS (SELECT id, parent_id FROM categories WHERE id = 1 UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "WITH RECURSIVE tree AS (SELECT id, parent_id FROM categories WHERE id = 1 UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree", int64(0), nil).Return().Once()
+
+		children := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "categories", nil).
+			Select("categories.id", "categories.parent_id").Join("tree ON categories.parent_id = tree.id")
+		tree := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "categories", nil).
+			Select("id", "parent_id").Where("id", 1).UnionAll(children)
+		query := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "tree", nil)
+		err := query.WithRecursive("tree", tree).Get(&categories)
+		s.Nil(err)
+	})
+
+	s.Run("with recursive on sqlserver", func() {
+		var categories []TestUser
+
+		s.mockWriteBuilder.EXPECT().DriverName().Return("sqlserver").Once()
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &categories, "WITH tree AS (SELECT id, parent_id FROM categories WHERE id = ? UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree", 1).Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("WITH tree AS (SELECT id, parent_id FROM categories WHERE id = ? UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree", 1).Return("WITH tree AS (SELECT id, parent_id FROM categories WHERE id = 1 UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "WITH tree AS (SELECT id, parent_id FROM categories WHERE id = 1 UNION ALL SELECT categories.id, categories.parent_id FROM categories JOIN tree ON categories.parent_id = tree.id) SELECT * FROM tree", int64(0), nil).Return().Once()
+
+		children := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "categories", nil).
+			Select("categories.id", "categories.parent_id").Join("tree ON categories.parent_id = tree.id")
+		tree := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "categories", nil).
+			Select("id", "parent_id").Where("id", 1).UnionAll(children)
+		query := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "tree", nil)
+		err := query.WithRecursive("tree", tree).Get(&categories)
+		s.Nil(err)
+	})
+}
+
 func (s *QueryTestSuite) TestWhere() {
 	s.Run("simple condition", func() {
 		var user TestUser
@@ -1812,10 +2246,9 @@ func (s *QueryTestSuite) TestWhereColumn() {
 func (s *QueryTestSuite) TestWhereExists() {
 	var users []TestUser
 
-	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Twice()
-	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM agents WHERE age = ?", 25).Return("SELECT * FROM agents WHERE age = 25").Once()
-	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE (name = ? AND EXISTS (SELECT * FROM agents WHERE age = 25))", "John").Return(nil).Once()
-	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE (name = ? AND EXISTS (SELECT * FROM agents WHERE age = 25))", "John").Return("SELECT * FROM users WHERE (name = \"John\" AND EXISTS (SELECT * FROM agents WHERE age = 25))").Once()
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE (name = ? AND EXISTS (SELECT * FROM agents WHERE age = ?))", "John", 25).Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE (name = ? AND EXISTS (SELECT * FROM agents WHERE age = ?))", "John", 25).Return("SELECT * FROM users WHERE (name = \"John\" AND EXISTS (SELECT * FROM agents WHERE age = 25))").Once()
 	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users WHERE (name = \"John\" AND EXISTS (SELECT * FROM agents WHERE age = 25))", int64(0), nil).Return().Once()
 
 	err := s.query.Where("name", "John").WhereExists(func() db.Query {
@@ -1825,6 +2258,30 @@ func (s *QueryTestSuite) TestWhereExists() {
 
 }
 
+func (s *QueryTestSuite) TestWhereFullText() {
+	s.Run("simple condition", func() {
+		var users []TestUser
+
+		s.mockWriteBuilder.EXPECT().DriverName().Return("postgres").Once()
+		s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(nil).Once()
+		s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE (age = ? OR (to_tsvector('english', bio)) @@ plainto_tsquery('english', ?))", 25, "goravel").Return(nil).Once()
+		s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE (age = ? OR (to_tsvector('english', bio)) @@ plainto_tsquery('english', ?))", 25, "goravel").Return("SELECT * FROM users WHERE (age = 25 OR (to_tsvector('english', bio)) @@ plainto_tsquery('english', 'goravel'))").Once()
+		s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users WHERE (age = 25 OR (to_tsvector('english', bio)) @@ plainto_tsquery('english', 'goravel'))", int64(0), nil).Return().Once()
+
+		err := s.query.Where("age", 25).OrWhereFullText([]string{"bio"}, "goravel").Get(&users)
+		s.Nil(err)
+	})
+
+	s.Run("unsupported driver", func() {
+		var users []TestUser
+
+		s.mockWriteBuilder.EXPECT().DriverName().Return("oracle").Once()
+
+		err := s.query.WhereFullText([]string{"bio"}, "goravel").Get(&users)
+		s.Equal(errors.DatabaseFullTextNotSupported.Args("oracle"), err)
+	})
+}
+
 func (s *QueryTestSuite) TestWhereIn() {
 	var users []TestUser
 
@@ -1837,6 +2294,20 @@ func (s *QueryTestSuite) TestWhereIn() {
 	s.Nil(err)
 }
 
+func (s *QueryTestSuite) TestWhereInSub() {
+	var users []TestUser
+
+	s.mockGrammar.EXPECT().CompilePlaceholderFormat().Return(sq.Dollar).Once()
+	s.mockReadBuilder.EXPECT().SelectContext(s.ctx, &users, "SELECT * FROM users WHERE ((age = $1 AND id IN (SELECT user_id FROM posts WHERE status = $2)) OR id NOT IN (SELECT user_id FROM bans WHERE reason = $3))", 25, "published", "spam").Return(nil).Once()
+	s.mockReadBuilder.EXPECT().Explain("SELECT * FROM users WHERE ((age = $1 AND id IN (SELECT user_id FROM posts WHERE status = $2)) OR id NOT IN (SELECT user_id FROM bans WHERE reason = $3))", 25, "published", "spam").Return("SELECT * FROM users WHERE ((age = 25 AND id IN (SELECT user_id FROM posts WHERE status = 'published')) OR id NOT IN (SELECT user_id FROM bans WHERE reason = 'spam'))").Once()
+	s.mockLogger.EXPECT().Trace(s.ctx, s.now, "SELECT * FROM users WHERE ((age = 25 AND id IN (SELECT user_id FROM posts WHERE status = 'published')) OR id NOT IN (SELECT user_id FROM bans WHERE reason = 'spam'))", int64(0), nil).Return().Once()
+
+	posts := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "posts", nil).Select("user_id").Where("status", "published")
+	bans := NewQuery(s.ctx, s.mockReadBuilder, s.mockWriteBuilder, s.mockGrammar, s.mockLogger, "bans", nil).Select("user_id").Where("reason", "spam")
+	err := s.query.Where("age", 25).WhereIn("id", posts).OrWhereNotIn("id", bans).Get(&users)
+	s.Nil(err)
+}
+
 func (s *QueryTestSuite) TestWhereLike() {
 	var users []TestUser
 
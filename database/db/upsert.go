@@ -0,0 +1,131 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	contractsdriver "github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+// upsertAlias is the alias of the inserted rows, it's used by the update clauses of MySQL and SQL Server.
+const upsertAlias = "goravel_upsert_alias"
+
+// CompileInsertOrIgnore compiles the insert statement that ignores the records violating the unique constraints, it's
+// compiled by the grammar if the grammar implements driver.CompileUpsertGrammar, otherwise it's compiled by the driver
+// name. The uniqueBy columns are the conflict target, they are required by SQL Server only.
+func CompileInsertOrIgnore(grammar contractsdriver.Grammar, driver string, table string, columns []string, values [][]any, uniqueBy []string) (string, []any, error) {
+	if compileUpsertGrammar, ok := grammar.(contractsdriver.CompileUpsertGrammar); ok {
+		return compileUpsertGrammar.CompileInsertOrIgnore(table, columns, values, uniqueBy)
+	}
+
+	switch driver {
+	case "mysql":
+		return insertBuilder(table, columns, values).Options("IGNORE").ToSql()
+	case "sqlserver":
+		if len(uniqueBy) == 0 {
+			return "", nil, errors.DatabaseIgnoreUniqueByIsRequired.Args(driver)
+		}
+
+		return compileMerge(table, columns, values, uniqueBy, nil)
+	default:
+		conflict := "ON CONFLICT DO NOTHING"
+		if len(uniqueBy) > 0 {
+			conflict = fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(uniqueBy, ", "))
+		}
+
+		return insertBuilder(table, columns, values).Suffix(conflict).ToSql()
+	}
+}
+
+// CompileUpsert compiles the insert statement that updates the given columns of the existing records, it's compiled by
+// the grammar if the grammar implements driver.CompileUpsertGrammar, otherwise it's compiled by the driver name. The
+// row alias of MySQL 8.0.19 is used, the grammar should be implemented for the older versions and MariaDB.
+func CompileUpsert(grammar contractsdriver.Grammar, driver string, table string, columns []string, values [][]any, uniqueBy []string, update []string) (string, []any, error) {
+	if compileUpsertGrammar, ok := grammar.(contractsdriver.CompileUpsertGrammar); ok {
+		return compileUpsertGrammar.CompileUpsert(table, columns, values, uniqueBy, update)
+	}
+
+	switch driver {
+	case "mysql":
+		sets := make([]string, len(update))
+		for i, column := range update {
+			sets[i] = fmt.Sprintf("%s = %s.%s", column, upsertAlias, column)
+		}
+
+		return insertBuilder(table, columns, values).
+			Suffix(fmt.Sprintf("AS %s ON DUPLICATE KEY UPDATE %s", upsertAlias, strings.Join(sets, ", "))).
+			ToSql()
+	case "sqlserver":
+		return compileMerge(table, columns, values, uniqueBy, update)
+	default:
+		sets := make([]string, len(update))
+		for i, column := range update {
+			sets[i] = fmt.Sprintf("%s = EXCLUDED.%s", column, column)
+		}
+
+		return insertBuilder(table, columns, values).
+			Suffix(fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(uniqueBy, ", "), strings.Join(sets, ", "))).
+			ToSql()
+	}
+}
+
+// compileMerge compiles the MERGE statement of SQL Server, it doesn't support ON CONFLICT. The matched records are
+// updated if the update columns are not empty, otherwise they are ignored.
+func compileMerge(table string, columns []string, values [][]any, uniqueBy []string, update []string) (string, []any, error) {
+	var args []any
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+	rows := make([]string, len(values))
+	for i, row := range values {
+		rows[i] = placeholders
+		args = append(args, row...)
+	}
+
+	target := sqlserverWrap("target")
+	source := sqlserverWrap(upsertAlias)
+	wrappedColumns := make([]string, len(columns))
+	sourceColumns := make([]string, len(columns))
+	for i, column := range columns {
+		wrappedColumns[i] = sqlserverWrap(column)
+		sourceColumns[i] = source + "." + sqlserverWrap(column)
+	}
+
+	ons := make([]string, len(uniqueBy))
+	for i, column := range uniqueBy {
+		ons[i] = fmt.Sprintf("%s.%s = %s.%s", target, sqlserverWrap(column), source, sqlserverWrap(column))
+	}
+
+	var matched string
+	if len(update) > 0 {
+		sets := make([]string, len(update))
+		for i, column := range update {
+			sets[i] = fmt.Sprintf("%s = %s.%s", sqlserverWrap(column), source, sqlserverWrap(column))
+		}
+		matched = " WHEN MATCHED THEN UPDATE SET " + strings.Join(sets, ", ")
+	}
+
+	return fmt.Sprintf("MERGE INTO %s AS %s USING (VALUES %s) AS %s (%s) ON %s%s WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s);",
+		sqlserverWrap(table), target, strings.Join(rows, ","), source, strings.Join(wrappedColumns, ","), strings.Join(ons, " AND "),
+		matched, strings.Join(wrappedColumns, ","), strings.Join(sourceColumns, ",")), args, nil
+}
+
+func insertBuilder(table string, columns []string, values [][]any) sq.InsertBuilder {
+	builder := sq.Insert(table).Columns(columns...)
+	for _, row := range values {
+		builder = builder.Values(row...)
+	}
+
+	return builder
+}
+
+// sqlserverWrap wraps the identifier of SQL Server in brackets, eg: "dbo.users" -> "[dbo].[users]".
+func sqlserverWrap(identifier string) string {
+	segments := strings.Split(identifier, ".")
+	for i, segment := range segments {
+		segments[i] = "[" + strings.ReplaceAll(segment, "]", "]]") + "]"
+	}
+
+	return strings.Join(segments, ".")
+}
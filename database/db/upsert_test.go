@@ -0,0 +1,52 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocksdriver "github.com/rusmanplatd/goravelframework/mocks/database/driver"
+)
+
+type upsertGrammar struct {
+	*mocksdriver.Grammar
+	*mocksdriver.CompileUpsertGrammar
+}
+
+func TestCompileInsertOrIgnore(t *testing.T) {
+	columns := []string{"email", "name"}
+	values := [][]any{{"john@example.com", "John"}}
+
+	t.Run("compiled by the grammar", func(t *testing.T) {
+		grammar := &upsertGrammar{Grammar: mocksdriver.NewGrammar(t), CompileUpsertGrammar: mocksdriver.NewCompileUpsertGrammar(t)}
+		grammar.CompileUpsertGrammar.EXPECT().CompileInsertOrIgnore("users", columns, values, []string(nil)).
+			Return("INSERT IGNORE INTO users (email,name) VALUES (?,?)", []any{"john@example.com", "John"}, nil).Once()
+
+		sql, args, err := CompileInsertOrIgnore(grammar, "mariadb", "users", columns, values, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT IGNORE INTO users (email,name) VALUES (?,?)", sql)
+		assert.Equal(t, []any{"john@example.com", "John"}, args)
+	})
+}
+
+func TestCompileUpsert(t *testing.T) {
+	columns := []string{"email", "name"}
+	values := [][]any{{"john@example.com", "John"}}
+
+	t.Run("compiled by the grammar", func(t *testing.T) {
+		grammar := &upsertGrammar{Grammar: mocksdriver.NewGrammar(t), CompileUpsertGrammar: mocksdriver.NewCompileUpsertGrammar(t)}
+		grammar.CompileUpsertGrammar.EXPECT().CompileUpsert("users", columns, values, []string{"email"}, []string{"name"}).
+			Return("INSERT INTO users (email,name) VALUES (?,?) ON DUPLICATE KEY UPDATE name = VALUES(name)", []any{"john@example.com", "John"}, nil).Once()
+
+		sql, args, err := CompileUpsert(grammar, "mariadb", "users", columns, values, []string{"email"}, []string{"name"})
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO users (email,name) VALUES (?,?) ON DUPLICATE KEY UPDATE name = VALUES(name)", sql)
+		assert.Equal(t, []any{"john@example.com", "John"}, args)
+	})
+}
+
+func TestSqlserverWrap(t *testing.T) {
+	assert.Equal(t, "[users]", sqlserverWrap("users"))
+	assert.Equal(t, "[dbo].[users]", sqlserverWrap("dbo.users"))
+	assert.Equal(t, "[a]]b]", sqlserverWrap("a]b"))
+}
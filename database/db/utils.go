@@ -5,6 +5,7 @@ import (
 	"maps"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
@@ -14,7 +15,9 @@ type TxLog struct {
 	ctx          context.Context
 	err          error
 	begin        *carbon.Carbon
+	duration     time.Duration
 	sql          string
+	bindings     []any
 	rowsAffected int64
 }
 
@@ -0,0 +1,141 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jmoiron/sqlx"
+	"gorm.io/gorm"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+var (
+	_ gorm.ConnPool         = (*Transaction)(nil)
+	_ gorm.ConnPoolBeginner = (*Transaction)(nil)
+	_ gorm.GetDBConnector   = (*Transaction)(nil)
+	_ gorm.TxCommitter      = (*Savepoint)(nil)
+)
+
+// Transaction is the connection pool of a connection whose queries are all run in a single transaction, it's used to
+// isolate the database tests, see BeginTransaction. The transactions begun on it are run in savepoints.
+type Transaction struct {
+	*sqlx.Tx
+	db         *sql.DB
+	driver     string
+	savepoints atomic.Int64
+}
+
+// BeginTransaction begins a transaction on the connection, all the ORM and DB queries of the connection are run in the
+// transaction until the returned rollback function is called. The connection should be initialized first.
+func BeginTransaction(connection string) (rollback func() error, err error) {
+	connectionToDBLock.Lock()
+	defer connectionToDBLock.Unlock()
+
+	instance, ok := connectionToDB[connection]
+	if !ok {
+		return nil, errors.DatabaseConnectionNotInitialized.Args(connection)
+	}
+	if _, ok := instance.Statement.ConnPool.(*Transaction); ok {
+		return nil, errors.DatabaseTransactionAlreadyStarted.Args(connection)
+	}
+
+	db, err := instance.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	driver := instance.Dialector.Name()
+	tx, err := sqlx.NewDb(db, driver).Beginx()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := instance.Statement.ConnPool
+	transaction := &Transaction{
+		Tx:     tx,
+		db:     db,
+		driver: driver,
+	}
+	instance.ConnPool = transaction
+	instance.Statement.ConnPool = transaction
+
+	return func() error {
+		connectionToDBLock.Lock()
+		defer connectionToDBLock.Unlock()
+
+		instance.ConnPool = pool
+		instance.Statement.ConnPool = pool
+
+		return tx.Rollback()
+	}, nil
+}
+
+// BeginTx begins a savepoint instead of a transaction, it's called by gorm.DB.Begin.
+func (r *Transaction) BeginTx(ctx context.Context, _ *sql.TxOptions) (gorm.ConnPool, error) {
+	return r.Savepoint(ctx)
+}
+
+// Commit returns an error directly, the transaction is only rolled back by the function returned by BeginTransaction.
+func (r *Transaction) Commit() error {
+	return gorm.ErrInvalidTransaction
+}
+
+func (r *Transaction) GetDBConn() (*sql.DB, error) {
+	return r.db, nil
+}
+
+// Rollback returns an error directly, the transaction is only rolled back by the function returned by
+// BeginTransaction.
+func (r *Transaction) Rollback() error {
+	return gorm.ErrInvalidTransaction
+}
+
+// Savepoint creates a savepoint in the transaction, it acts as a nested transaction.
+func (r *Transaction) Savepoint(ctx context.Context) (*Savepoint, error) {
+	name := fmt.Sprintf("goravel_savepoint_%d", r.savepoints.Add(1))
+	query := "SAVEPOINT " + name
+	if r.driver == "sqlserver" {
+		query = "SAVE TRANSACTION " + name
+	}
+
+	if _, err := r.ExecContext(ctx, query); err != nil {
+		return nil, err
+	}
+
+	return &Savepoint{
+		Transaction: r,
+		name:        name,
+	}, nil
+}
+
+type Savepoint struct {
+	*Transaction
+	name string
+}
+
+// Commit releases the savepoint, the changes are kept in the transaction.
+func (r *Savepoint) Commit() error {
+	// SQL Server doesn't support releasing a savepoint.
+	if r.driver == "sqlserver" {
+		return nil
+	}
+
+	_, err := r.Exec("RELEASE SAVEPOINT " + r.name)
+
+	return err
+}
+
+// Rollback rolls back the changes made after the savepoint.
+func (r *Savepoint) Rollback() error {
+	query := "ROLLBACK TO SAVEPOINT " + r.name
+	if r.driver == "sqlserver" {
+		query = "ROLLBACK TRANSACTION " + r.name
+	}
+
+	_, err := r.Exec(query)
+
+	return err
+}
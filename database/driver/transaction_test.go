@@ -0,0 +1,33 @@
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+func TestBeginTransaction(t *testing.T) {
+	rollback, err := BeginTransaction("not-initialized")
+	assert.Nil(t, rollback)
+	assert.Equal(t, errors.DatabaseConnectionNotInitialized.Args("not-initialized"), err)
+
+	connectionToDB["testing"] = &gorm.DB{
+		Config:    &gorm.Config{},
+		Statement: &gorm.Statement{ConnPool: &Transaction{}},
+	}
+	defer delete(connectionToDB, "testing")
+
+	rollback, err = BeginTransaction("testing")
+	assert.Nil(t, rollback)
+	assert.Equal(t, errors.DatabaseTransactionAlreadyStarted.Args("testing"), err)
+}
+
+func TestTransaction_CommitAndRollback(t *testing.T) {
+	transaction := &Transaction{}
+
+	assert.Equal(t, gorm.ErrInvalidTransaction, transaction.Commit())
+	assert.Equal(t, gorm.ErrInvalidTransaction, transaction.Rollback())
+}
@@ -3,17 +3,34 @@ package factory
 import (
 	"maps"
 	"reflect"
+	"slices"
 
 	"github.com/go-viper/mapstructure/v2"
 
 	"github.com/rusmanplatd/goravelframework/contracts/database/factory"
 	ormcontract "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/database/gorm"
 	"github.com/rusmanplatd/goravelframework/errors"
 )
 
 type FactoryImpl struct {
-	count *int              // number of models to generate
-	query ormcontract.Query // query instance
+	count         *int              // number of models to generate
+	query         ormcontract.Query // query instance
+	states        []state           // states that are applied to the definition in order
+	relations     []relation        // related models that are built with the model
+	afterMaking   []func(model any) error
+	afterCreating []func(model any) error
+}
+
+// state modifies the attributes of the model, the index is the position of the model in the models that are made.
+type state func(value any, attributes map[string]any, index int) (map[string]any, error)
+
+type relation struct {
+	name    string
+	factory ormcontract.Factory
+	parent  any
+	// belongsTo is true if the relation is built by For, the parent is shared by all the models.
+	belongsTo bool
 }
 
 func NewFactoryImpl(query ormcontract.Query) *FactoryImpl {
@@ -22,6 +39,22 @@ func NewFactoryImpl(query ormcontract.Query) *FactoryImpl {
 	}
 }
 
+// AfterCreating Register a callback that is called after each model is created.
+func (f *FactoryImpl) AfterCreating(callback func(model any) error) ormcontract.Factory {
+	instance := f.clone()
+	instance.afterCreating = append(instance.afterCreating, callback)
+
+	return instance
+}
+
+// AfterMaking Register a callback that is called after each model is made.
+func (f *FactoryImpl) AfterMaking(callback func(model any) error) ormcontract.Factory {
+	instance := f.clone()
+	instance.afterMaking = append(instance.afterMaking, callback)
+
+	return instance
+}
+
 // Count Specify the number of models you wish to create / make.
 func (f *FactoryImpl) Count(count int) ormcontract.Factory {
 	return f.newInstance(map[string]any{"count": count})
@@ -29,82 +62,300 @@ func (f *FactoryImpl) Count(count int) ormcontract.Factory {
 
 // Create a model and persist it in the database.
 func (f *FactoryImpl) Create(value any, attributes ...map[string]any) error {
-	if err := f.Make(value, attributes...); err != nil {
+	instance, err := f.withParents(value, func(parentFactory ormcontract.Factory, parent any) error {
+		return parentFactory.Create(parent)
+	})
+	if err != nil {
 		return err
 	}
 
-	return f.query.Create(value)
+	if err := instance.Make(value, attributes...); err != nil {
+		return err
+	}
+
+	if err := instance.creatingQuery(instance.query).Create(value); err != nil {
+		return err
+	}
+
+	return instance.callAfterCreating(value)
 }
 
 // CreateQuietly create a model and persist it in the database without firing any events.
 func (f *FactoryImpl) CreateQuietly(value any, attributes ...map[string]any) error {
-	if err := f.Make(value, attributes...); err != nil {
+	instance, err := f.withParents(value, func(parentFactory ormcontract.Factory, parent any) error {
+		return parentFactory.CreateQuietly(parent)
+	})
+	if err != nil {
 		return err
 	}
 
-	return f.query.WithoutEvents().Create(value)
+	if err := instance.Make(value, attributes...); err != nil {
+		return err
+	}
+
+	if err := instance.creatingQuery(instance.query.WithoutEvents()).Create(value); err != nil {
+		return err
+	}
+
+	return instance.callAfterCreating(value)
+}
+
+// For Build the parent model of the belongs to relationship, the parent can be a model or a factory, the parent
+// built by the factory is shared by all the models that are made or created at once.
+func (f *FactoryImpl) For(parent any, relationship string) ormcontract.Factory {
+	instance := f.clone()
+	if parentFactory, ok := parent.(ormcontract.Factory); ok {
+		instance.relations = append(instance.relations, relation{name: relationship, factory: parentFactory, belongsTo: true})
+	} else {
+		instance.relations = append(instance.relations, relation{name: relationship, parent: parent, belongsTo: true})
+	}
+
+	return instance
+}
+
+// Has Build the related models of the has one, has many or many to many relationship by the factory.
+func (f *FactoryImpl) Has(factory ormcontract.Factory, relationship string) ormcontract.Factory {
+	instance := f.clone()
+	instance.relations = append(instance.relations, relation{name: relationship, factory: factory})
+
+	return instance
 }
 
 // Make a model instance that's not persisted in the database.
 func (f *FactoryImpl) Make(value any, attributes ...map[string]any) error {
+	instance, err := f.withParents(value, func(parentFactory ormcontract.Factory, parent any) error {
+		return parentFactory.Make(parent)
+	})
+	if err != nil {
+		return err
+	}
+
 	reflectValue := reflect.Indirect(reflect.ValueOf(value))
 	switch reflectValue.Kind() {
 	case reflect.Array, reflect.Slice:
-		count := 1
-		if f.count != nil {
-			count = *f.count
-		}
-		for i := 0; i < count; i++ {
-			elemValue := reflect.New(reflectValue.Type().Elem()).Interface()
-			attributes, err := getRawAttributes(elemValue, attributes...)
-			if err != nil {
-				return err
-			}
-			if attributes == nil {
-				return errors.OrmFactoryMissingAttributes.SetModule(errors.ModuleOrm)
-			}
-			decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-				Squash: true,
-				Result: elemValue,
-			})
-			if err != nil {
+		elemType := reflectValue.Type().Elem()
+		isPtr := elemType.Kind() == reflect.Ptr
+		if isPtr {
+			elemType = elemType.Elem()
+		}
+
+		for i := 0; i < instance.getCount(); i++ {
+			elemValue := reflect.New(elemType)
+			if err := instance.makeOne(elemValue.Interface(), i, attributes...); err != nil {
 				return err
 			}
-			if err := decoder.Decode(attributes); err != nil {
-				return err
+			if isPtr {
+				reflectValue = reflect.Append(reflectValue, elemValue)
+			} else {
+				reflectValue = reflect.Append(reflectValue, elemValue.Elem())
 			}
-			reflectValue = reflect.Append(reflectValue, reflect.ValueOf(elemValue).Elem())
 		}
 
 		reflect.ValueOf(value).Elem().Set(reflectValue)
 
 		return nil
 	default:
-		attributes, err := getRawAttributes(value, attributes...)
-		if err != nil {
-			return err
+		return instance.makeOne(value, 0, attributes...)
+	}
+}
+
+// NamedState Apply the state that is defined in the States method of the model factory.
+func (f *FactoryImpl) NamedState(name string) ormcontract.Factory {
+	return f.withState(func(value any, attributes map[string]any, _ int) (map[string]any, error) {
+		factoryWithStates, ok := getFactory(value).(factory.FactoryWithStates)
+		if !ok {
+			return nil, errors.OrmFactoryStateNotFound.Args(name, reflect.TypeOf(value).String()).SetModule(errors.ModuleOrm)
 		}
-		if attributes == nil {
-			return errors.OrmFactoryMissingAttributes.SetModule(errors.ModuleOrm)
+
+		callback, exist := factoryWithStates.States()[name]
+		if !exist {
+			return nil, errors.OrmFactoryStateNotFound.Args(name, reflect.TypeOf(value).String()).SetModule(errors.ModuleOrm)
 		}
-		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-			Squash: true,
-			Result: value,
-		})
+
+		return callback(attributes), nil
+	})
+}
+
+// Raw Get the attributes of the models without making them, a map is returned for each model.
+func (f *FactoryImpl) Raw(value any, attributes ...map[string]any) ([]map[string]any, error) {
+	reflectType := reflect.TypeOf(value)
+	for reflectType.Kind() == reflect.Ptr || reflectType.Kind() == reflect.Slice || reflectType.Kind() == reflect.Array {
+		reflectType = reflectType.Elem()
+	}
+
+	model := reflect.New(reflectType).Interface()
+	raws := make([]map[string]any, 0, f.getCount())
+	for i := 0; i < f.getCount(); i++ {
+		raw, err := f.getAttributes(model, i, attributes...)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		raws = append(raws, raw)
+	}
+
+	return raws, nil
+}
+
+// Sequence Cycle the attributes of the made models through the given sequence.
+func (f *FactoryImpl) Sequence(sequence ...map[string]any) ormcontract.Factory {
+	return f.withState(func(_ any, attributes map[string]any, index int) (map[string]any, error) {
+		if len(sequence) > 0 {
+			maps.Copy(attributes, sequence[index%len(sequence)])
+		}
+
+		return attributes, nil
+	})
+}
+
+// State Modify the attributes of the model's default state.
+func (f *FactoryImpl) State(callback func(attributes map[string]any) map[string]any) ormcontract.Factory {
+	return f.withState(func(_ any, attributes map[string]any, _ int) (map[string]any, error) {
+		return callback(attributes), nil
+	})
+}
+
+func (f *FactoryImpl) buildRelations(value any) error {
+	reflectValue := reflect.Indirect(reflect.ValueOf(value))
+	for _, item := range f.relations {
+		field := reflectValue.FieldByName(item.name)
+		if !field.IsValid() || !field.CanSet() {
+			return errors.OrmFactoryRelationNotFound.Args(item.name, reflectValue.Type().String()).SetModule(errors.ModuleOrm)
+		}
+
+		if item.factory != nil {
+			related := reflect.New(field.Type())
+			if field.Kind() == reflect.Ptr {
+				related.Elem().Set(reflect.New(field.Type().Elem()))
+				if err := item.factory.Make(related.Elem().Interface()); err != nil {
+					return err
+				}
+			} else if err := item.factory.Make(related.Interface()); err != nil {
+				return err
+			}
+
+			field.Set(related.Elem())
+
+			continue
 		}
 
-		return decoder.Decode(attributes)
+		parent := reflect.ValueOf(item.parent)
+		switch {
+		case parent.Type().AssignableTo(field.Type()):
+			field.Set(parent)
+		case parent.Kind() == reflect.Ptr && parent.Elem().Type().AssignableTo(field.Type()):
+			field.Set(parent.Elem())
+		case parent.Kind() != reflect.Ptr && field.Kind() == reflect.Ptr && parent.Type().AssignableTo(field.Type().Elem()):
+			pointer := reflect.New(parent.Type())
+			pointer.Elem().Set(parent)
+			field.Set(pointer)
+		default:
+			return errors.OrmFactoryRelationNotFound.Args(item.name, reflectValue.Type().String()).SetModule(errors.ModuleOrm)
+		}
 	}
+
+	return nil
+}
+
+func (f *FactoryImpl) callAfterCreating(value any) error {
+	if len(f.afterCreating) == 0 {
+		return nil
+	}
+
+	return eachModel(value, func(model any) error {
+		for _, callback := range f.afterCreating {
+			if err := callback(model); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (f *FactoryImpl) clone() *FactoryImpl {
+	return &FactoryImpl{
+		count:         f.count,
+		query:         f.query,
+		states:        append([]state{}, f.states...),
+		relations:     append([]relation{}, f.relations...),
+		afterMaking:   append([]func(model any) error{}, f.afterMaking...),
+		afterCreating: append([]func(model any) error{}, f.afterCreating...),
+	}
+}
+
+// creatingQuery creates the related models with the model if the relationships are built.
+func (f *FactoryImpl) creatingQuery(query ormcontract.Query) ormcontract.Query {
+	if len(f.relations) == 0 {
+		return query
+	}
+
+	return query.Select(gorm.Associations)
+}
+
+func (f *FactoryImpl) getAttributes(value any, index int, attributes ...map[string]any) (map[string]any, error) {
+	definition, err := getRawAttributes(value)
+	if err != nil {
+		return nil, err
+	}
+	if definition == nil {
+		return nil, errors.OrmFactoryMissingAttributes.SetModule(errors.ModuleOrm)
+	}
+
+	for _, apply := range f.states {
+		if definition, err = apply(value, definition, index); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(attributes) > 0 {
+		maps.Copy(definition, attributes[0])
+	}
+
+	return definition, nil
+}
+
+func (f *FactoryImpl) getCount() int {
+	if f.count != nil {
+		return *f.count
+	}
+
+	return 1
+}
+
+func (f *FactoryImpl) makeOne(value any, index int, attributes ...map[string]any) error {
+	raw, err := f.getAttributes(value, index, attributes...)
+	if err != nil {
+		return err
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Squash: true,
+		Result: value,
+	})
+	if err != nil {
+		return err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return err
+	}
+
+	if err := f.buildRelations(value); err != nil {
+		return err
+	}
+
+	for _, callback := range f.afterMaking {
+		if err := callback(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // newInstance create a new factory instance.
 func (f *FactoryImpl) newInstance(attributes ...map[string]any) ormcontract.Factory {
-	instance := &FactoryImpl{
-		count: f.count,
-		query: f.query,
-	}
+	instance := f.clone()
 
 	if len(attributes) > 0 {
 		attr := attributes[0]
@@ -116,6 +367,80 @@ func (f *FactoryImpl) newInstance(attributes ...map[string]any) ormcontract.Fact
 	return instance
 }
 
+// withParents builds the parents of the For relationships by their factories once, they are shared by all the models,
+// otherwise a parent would be built for each model.
+func (f *FactoryImpl) withParents(value any, build func(parentFactory ormcontract.Factory, parent any) error) (*FactoryImpl, error) {
+	if !slices.ContainsFunc(f.relations, func(item relation) bool { return item.belongsTo && item.factory != nil }) {
+		return f, nil
+	}
+
+	modelType := reflect.TypeOf(value)
+	for modelType.Kind() == reflect.Ptr || modelType.Kind() == reflect.Slice || modelType.Kind() == reflect.Array {
+		modelType = modelType.Elem()
+	}
+
+	instance := f.clone()
+	for i, item := range instance.relations {
+		if !item.belongsTo || item.factory == nil {
+			continue
+		}
+
+		field, ok := modelType.FieldByName(item.name)
+		if !ok {
+			return nil, errors.OrmFactoryRelationNotFound.Args(item.name, modelType.String()).SetModule(errors.ModuleOrm)
+		}
+
+		parentType := field.Type
+		if parentType.Kind() == reflect.Ptr {
+			parentType = parentType.Elem()
+		}
+
+		parent := reflect.New(parentType).Interface()
+		if err := build(item.factory, parent); err != nil {
+			return nil, err
+		}
+
+		instance.relations[i] = relation{name: item.name, parent: parent, belongsTo: true}
+	}
+
+	return instance, nil
+}
+
+func (f *FactoryImpl) withState(apply state) ormcontract.Factory {
+	instance := f.clone()
+	instance.states = append(instance.states, apply)
+
+	return instance
+}
+
+// eachModel calls the callback with the pointer of each model, the value can be a model or a slice of models.
+func eachModel(value any, callback func(model any) error) error {
+	reflectValue := reflect.Indirect(reflect.ValueOf(value))
+	if reflectValue.Kind() != reflect.Array && reflectValue.Kind() != reflect.Slice {
+		return callback(value)
+	}
+
+	for i := 0; i < reflectValue.Len(); i++ {
+		elem := reflectValue.Index(i)
+		if elem.Kind() != reflect.Ptr {
+			elem = elem.Addr()
+		}
+		if err := callback(elem.Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getFactory(value any) factory.Factory {
+	if factoryModel, ok := value.(factory.Model); ok {
+		return factoryModel.Factory()
+	}
+
+	return nil
+}
+
 func getRawAttributes(value any, attributes ...map[string]any) (map[string]any, error) {
 	factoryModel, exist := value.(factory.Model)
 	if !exist {
@@ -6,9 +6,13 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	gormio "gorm.io/gorm"
 
 	"github.com/rusmanplatd/goravelframework/contracts/database/factory"
+	"github.com/rusmanplatd/goravelframework/database/gorm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 )
 
@@ -31,6 +35,7 @@ type User struct {
 	SoftDeletes
 	Name   string
 	Avatar string
+	Books  []*Book
 }
 
 func (u *User) Factory() factory.Factory {
@@ -51,6 +56,35 @@ func (u *UserFactory) Definition() map[string]any {
 	}
 }
 
+func (u *UserFactory) States() map[string]func(attributes map[string]any) map[string]any {
+	return map[string]func(attributes map[string]any) map[string]any{
+		"anonymous": func(attributes map[string]any) map[string]any {
+			attributes["Name"] = "anonymous"
+			return attributes
+		},
+	}
+}
+
+type Book struct {
+	Model
+	UserID uint
+	Name   string
+	User   *User
+}
+
+func (b *Book) Factory() factory.Factory {
+	return &BookFactory{}
+}
+
+type BookFactory struct {
+}
+
+func (b *BookFactory) Definition() map[string]any {
+	return map[string]any{
+		"Name": "book",
+	}
+}
+
 type House struct {
 	Model
 	Name          string
@@ -81,3 +115,131 @@ func TestGetRawAttributes(t *testing.T) {
 	assert.NotNil(t, attributes["UpdatedAt"])
 	assert.NotNil(t, attributes["DeletedAt"])
 }
+
+func TestState(t *testing.T) {
+	var users []User
+	assert.Nil(t, NewFactoryImpl(nil).Count(2).State(func(attributes map[string]any) map[string]any {
+		attributes["Avatar"] = "avatar"
+		return attributes
+	}).NamedState("anonymous").Make(&users))
+	assert.Len(t, users, 2)
+	for _, user := range users {
+		assert.Equal(t, "anonymous", user.Name)
+		assert.Equal(t, "avatar", user.Avatar)
+	}
+
+	var user User
+	assert.Nil(t, NewFactoryImpl(nil).NamedState("anonymous").Make(&user, map[string]any{"Name": "goravel"}))
+	assert.Equal(t, "goravel", user.Name)
+
+	assert.ErrorIs(t, NewFactoryImpl(nil).NamedState("unknown").Make(&user), errors.OrmFactoryStateNotFound)
+
+	var book Book
+	assert.ErrorIs(t, NewFactoryImpl(nil).NamedState("anonymous").Make(&book), errors.OrmFactoryStateNotFound)
+}
+
+func TestSequence(t *testing.T) {
+	var users []*User
+	assert.Nil(t, NewFactoryImpl(nil).Count(3).Sequence(
+		map[string]any{"Name": "a"},
+		map[string]any{"Name": "b"},
+	).Make(&users))
+	assert.Len(t, users, 3)
+	assert.Equal(t, "a", users[0].Name)
+	assert.Equal(t, "b", users[1].Name)
+	assert.Equal(t, "a", users[2].Name)
+}
+
+func TestRaw(t *testing.T) {
+	raws, err := NewFactoryImpl(nil).Count(2).Sequence(
+		map[string]any{"Name": "a"},
+		map[string]any{"Name": "b"},
+	).Raw(&User{}, map[string]any{"Avatar": "avatar"})
+	assert.Nil(t, err)
+	assert.Len(t, raws, 2)
+	assert.Equal(t, "a", raws[0]["Name"])
+	assert.Equal(t, "b", raws[1]["Name"])
+	assert.Equal(t, "avatar", raws[1]["Avatar"])
+
+	raws, err = NewFactoryImpl(nil).Raw(&[]User{})
+	assert.Nil(t, err)
+	assert.Len(t, raws, 1)
+
+	_, err = NewFactoryImpl(nil).Raw(&House{})
+	assert.ErrorIs(t, err, errors.OrmFactoryMissingMethod)
+}
+
+func TestRelations(t *testing.T) {
+	var user User
+	assert.Nil(t, NewFactoryImpl(nil).Has(NewFactoryImpl(nil).Count(2), "Books").Make(&user))
+	assert.Len(t, user.Books, 2)
+	assert.Equal(t, "book", user.Books[0].Name)
+
+	var book Book
+	assert.Nil(t, NewFactoryImpl(nil).For(NewFactoryImpl(nil).NamedState("anonymous"), "User").Make(&book))
+	assert.Equal(t, "anonymous", book.User.Name)
+
+	parent := User{Name: "parent"}
+	assert.Nil(t, NewFactoryImpl(nil).For(&parent, "User").Make(&book))
+	assert.Equal(t, &parent, book.User)
+
+	assert.Nil(t, NewFactoryImpl(nil).For(parent, "User").Make(&book))
+	assert.Equal(t, "parent", book.User.Name)
+
+	assert.ErrorIs(t, NewFactoryImpl(nil).For(&parent, "Author").Make(&book), errors.OrmFactoryRelationNotFound)
+	assert.ErrorIs(t, NewFactoryImpl(nil).For(&book, "User").Make(&book), errors.OrmFactoryRelationNotFound)
+	assert.ErrorIs(t, NewFactoryImpl(nil).For(NewFactoryImpl(nil), "Author").Make(&book), errors.OrmFactoryRelationNotFound)
+
+	// The parent built by the factory is shared by the models.
+	var made int
+	var books []Book
+	assert.Nil(t, NewFactoryImpl(nil).Count(3).For(NewFactoryImpl(nil).AfterMaking(func(model any) error {
+		made++
+		return nil
+	}), "User").Make(&books))
+	assert.Len(t, books, 3)
+	assert.Equal(t, 1, made)
+	assert.Same(t, books[0].User, books[1].User)
+	assert.Same(t, books[0].User, books[2].User)
+}
+
+func TestCreate(t *testing.T) {
+	mockQuery := mocksorm.NewQuery(t)
+
+	var made, created []string
+	userFactory := NewFactoryImpl(mockQuery).Count(2).AfterMaking(func(model any) error {
+		made = append(made, model.(*User).Name)
+		return nil
+	}).AfterCreating(func(model any) error {
+		created = append(created, model.(*User).Name)
+		return nil
+	}).Sequence(map[string]any{"Name": "a"}, map[string]any{"Name": "b"})
+
+	var users []User
+	mockQuery.EXPECT().Create(&users).Return(nil).Once()
+	assert.Nil(t, userFactory.Create(&users))
+	assert.Equal(t, []string{"a", "b"}, made)
+	assert.Equal(t, []string{"a", "b"}, created)
+
+	var user User
+	mockQuery.EXPECT().Select(gorm.Associations).Return(mockQuery).Once()
+	mockQuery.EXPECT().Create(&user).Return(nil).Once()
+	assert.Nil(t, NewFactoryImpl(mockQuery).Has(NewFactoryImpl(mockQuery), "Books").Create(&user))
+	assert.Len(t, user.Books, 1)
+
+	// The parent is created once before the models.
+	var books []Book
+	mockQuery.EXPECT().Create(mock.AnythingOfType("*factory.User")).Return(nil).Once()
+	mockQuery.EXPECT().Select(gorm.Associations).Return(mockQuery).Once()
+	mockQuery.EXPECT().Create(&books).Return(nil).Once()
+	assert.Nil(t, NewFactoryImpl(mockQuery).Count(2).For(NewFactoryImpl(mockQuery), "User").Create(&books))
+	assert.Len(t, books, 2)
+	assert.Same(t, books[0].User, books[1].User)
+
+	mockQuery.EXPECT().WithoutEvents().Return(mockQuery).Once()
+	mockQuery.EXPECT().Create(&user).Return(assert.AnError).Once()
+	assert.Equal(t, assert.AnError, NewFactoryImpl(mockQuery).AfterCreating(func(model any) error {
+		t.Fail()
+		return nil
+	}).CreateQuietly(&user))
+}
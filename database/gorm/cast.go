@@ -0,0 +1,179 @@
+package gorm
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"sync"
+
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+const castCallback = "goravel:casts"
+
+var (
+	// castSchemas caches the casts of the parsed schemas, the key is *schema.Schema, the value is a map of the
+	// field name and the cast.
+	castSchemas     sync.Map
+	castSchemaMux   sync.Mutex
+	castCallbackMux sync.Mutex
+)
+
+// castValue receives the column value when scanning rows, it's converted by the cast before setting the field.
+type castValue struct {
+	value any
+}
+
+func (r *castValue) Scan(src any) error {
+	if bytes, ok := src.([]byte); ok {
+		src = append([]byte(nil), bytes...)
+	}
+	r.value = src
+
+	return nil
+}
+
+// castValuer converts the field value by the cast when it's written to the database.
+type castValuer struct {
+	cast  contractsorm.Cast
+	value any
+}
+
+func (r castValuer) Value() (driver.Value, error) {
+	value, err := r.cast.Set(r.value)
+	if err != nil {
+		return nil, err
+	}
+	if valuer, ok := value.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return value, nil
+}
+
+// registerCastCallbacks registers the gorm callbacks that apply the casts of the models.
+func registerCastCallbacks(instance *gormio.DB) error {
+	castCallbackMux.Lock()
+	defer castCallbackMux.Unlock()
+
+	callbacks := instance.Callback()
+	if callbacks.Create().Get(castCallback) != nil {
+		return nil
+	}
+
+	if err := callbacks.Create().Before("gorm:create").Register(castCallback, applyCasts); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register(castCallback, applyCasts); err != nil {
+		return err
+	}
+
+	return callbacks.Query().Before("gorm:query").Register(castCallback, applyCasts)
+}
+
+func applyCasts(tx *gormio.DB) {
+	if tx.Error != nil || tx.Statement.Schema == nil {
+		return
+	}
+
+	casts, err := schemaCasts(tx.Statement.Schema)
+	if err != nil {
+		_ = tx.AddError(err)
+		return
+	}
+	if len(casts) == 0 {
+		return
+	}
+
+	if dest, ok := tx.Statement.Dest.(map[string]any); ok {
+		castDest := make(map[string]any, len(dest))
+		for key, value := range dest {
+			castDest[key] = value
+			field := tx.Statement.Schema.LookUpField(key)
+			if field == nil {
+				continue
+			}
+			cast, exist := casts[field.Name]
+			if !exist {
+				continue
+			}
+			if _, ok := value.(clause.Expression); ok {
+				continue
+			}
+
+			if castDest[key], err = cast.Set(value); err != nil {
+				_ = tx.AddError(err)
+				return
+			}
+		}
+		tx.Statement.Dest = castDest
+	}
+}
+
+// schemaCasts gets the casts of the model and patches the fields of the schema once, so the column values are
+// converted when the model is scanned and saved.
+func schemaCasts(modelSchema *schema.Schema) (map[string]contractsorm.Cast, error) {
+	if casts, ok := castSchemas.Load(modelSchema); ok {
+		return casts.(map[string]contractsorm.Cast), nil
+	}
+
+	castSchemaMux.Lock()
+	defer castSchemaMux.Unlock()
+
+	if casts, ok := castSchemas.Load(modelSchema); ok {
+		return casts.(map[string]contractsorm.Cast), nil
+	}
+
+	casts := make(map[string]contractsorm.Cast)
+	if model, ok := reflect.New(modelSchema.ModelType).Interface().(contractsorm.ModelWithCasts); ok {
+		for key, cast := range model.Casts() {
+			field := modelSchema.LookUpField(key)
+			if field == nil {
+				return nil, errors.OrmCastFieldNotFound.Args(key, modelSchema.Name)
+			}
+
+			casts[field.Name] = cast
+		}
+		for name, cast := range casts {
+			patchCastField(modelSchema.FieldsByName[name], cast)
+		}
+	}
+
+	castSchemas.Store(modelSchema, casts)
+
+	return casts, nil
+}
+
+func patchCastField(field *schema.Field, cast contractsorm.Cast) {
+	set := field.Set
+	valueOf := field.ValueOf
+
+	field.NewValuePool = &sync.Pool{
+		New: func() any {
+			return &castValue{}
+		},
+	}
+	field.Set = func(ctx context.Context, value reflect.Value, v any) error {
+		if scanned, ok := v.(*castValue); ok {
+			converted, err := cast.Get(scanned.value)
+			scanned.value = nil
+			if err != nil {
+				return err
+			}
+
+			return set(ctx, value, converted)
+		}
+
+		return set(ctx, value, v)
+	}
+	field.ValueOf = func(ctx context.Context, value reflect.Value) (any, bool) {
+		v, zero := valueOf(ctx, value)
+
+		return castValuer{cast: cast, value: v}, zero
+	}
+}
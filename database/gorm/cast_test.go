@@ -0,0 +1,86 @@
+package gorm
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+type upperCast struct{}
+
+func (r upperCast) Get(value any) (any, error) {
+	return strings.ToLower(value.(string)), nil
+}
+
+func (r upperCast) Set(value any) (any, error) {
+	return strings.ToUpper(value.(string)), nil
+}
+
+type CastUser struct {
+	ID   uint
+	Name string
+}
+
+func (r *CastUser) Casts() map[string]contractsorm.Cast {
+	return map[string]contractsorm.Cast{
+		"name": upperCast{},
+	}
+}
+
+type InvalidCastUser struct {
+	ID uint
+}
+
+func (r *InvalidCastUser) Casts() map[string]contractsorm.Cast {
+	return map[string]contractsorm.Cast{
+		"name": upperCast{},
+	}
+}
+
+func TestSchemaCasts(t *testing.T) {
+	ctx := context.Background()
+	userSchema, err := schema.Parse(&CastUser{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+
+	casts, err := schemaCasts(userSchema)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]contractsorm.Cast{"Name": upperCast{}}, casts)
+
+	// The schema is only patched once.
+	casts, err = schemaCasts(userSchema)
+	assert.NoError(t, err)
+	assert.Len(t, casts, 1)
+
+	field := userSchema.LookUpField("name")
+	user := CastUser{Name: "goravel"}
+	value, zero := field.ValueOf(ctx, reflect.ValueOf(&user).Elem())
+	assert.False(t, zero)
+	valuer, ok := value.(driver.Valuer)
+	assert.True(t, ok)
+	dbValue, err := valuer.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "GORAVEL", dbValue)
+
+	scanned := field.NewValuePool.Get()
+	assert.NoError(t, scanned.(interface{ Scan(any) error }).Scan("GORM"))
+	assert.NoError(t, field.Set(ctx, reflect.ValueOf(&user).Elem(), scanned))
+	assert.Equal(t, "gorm", user.Name)
+
+	// The other fields are not affected.
+	id, _ := userSchema.LookUpField("id").ValueOf(ctx, reflect.ValueOf(&user).Elem())
+	assert.Equal(t, uint(0), id)
+
+	invalidSchema, err := schema.Parse(&InvalidCastUser{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	_, err = schemaCasts(invalidSchema)
+	assert.Equal(t, errors.OrmCastFieldNotFound.Args("name", "InvalidCastUser"), err)
+}
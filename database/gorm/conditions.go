@@ -3,6 +3,7 @@ package gorm
 import (
 	contractsdriver "github.com/rusmanplatd/goravelframework/contracts/database/driver"
 	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/database/db"
 )
 
 type Conditions struct {
@@ -11,6 +12,7 @@ type Conditions struct {
 	having        *contractsdriver.Having
 	limit         *int
 	offset        *int
+	remember      *db.RememberOptions
 	table         *Table
 	groupBy       []string
 	join          []contractsdriver.Join
@@ -23,6 +25,7 @@ type Conditions struct {
 	with          []With
 	distinct      bool
 	lockForUpdate bool
+	onlyTrashed   bool
 	sharedLock    bool
 	withoutEvents bool
 	withTrashed   bool
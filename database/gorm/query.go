@@ -8,10 +8,12 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/cast"
 	gormio "gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 
 	"github.com/rusmanplatd/goravelframework/contracts/config"
 	contractsdatabase "github.com/rusmanplatd/goravelframework/contracts/database"
@@ -82,11 +84,26 @@ func BuildQuery(ctx context.Context, config config.Config, connection string, lo
 	}
 
 	pool := driver.Pool()
-	logger := db.NewLogger(config, log).ToGorm()
+	logger := db.NewLogger(config, log, connection).ToGorm()
 	gorm, err := databasedriver.BuildGorm(config, logger, pool, connection)
 	if err != nil {
 		return nil, pool.Writers[0], err
 	}
+	if err := db.RegisterQueryCacheCallbacks(gorm); err != nil {
+		return nil, pool.Writers[0], err
+	}
+	if err := registerCastCallbacks(gorm); err != nil {
+		return nil, pool.Writers[0], err
+	}
+	if err := registerVersionCallbacks(gorm); err != nil {
+		return nil, pool.Writers[0], err
+	}
+	if err := registerTenantCallbacks(gorm); err != nil {
+		return nil, pool.Writers[0], err
+	}
+	if err := registerSoftDeleteCallbacks(gorm); err != nil {
+		return nil, pool.Writers[0], err
+	}
 
 	return NewQuery(ctx, config, pool.Writers[0], gorm, driver.Grammar(), log, modelToObserver, nil), pool.Writers[0], nil
 }
@@ -103,7 +120,9 @@ func (r *Query) Begin() (contractsorm.Query, error) {
 }
 
 func (r *Query) BeginTransaction() (contractsorm.Query, error) {
-	if r.InTransaction() {
+	// The queries of the database tests are run in a transaction, a savepoint is begun on it, see
+	// databasedriver.BeginTransaction.
+	if _, ok := r.instance.Statement.ConnPool.(*databasedriver.Transaction); !ok && r.InTransaction() {
 		return r, nil
 	}
 
@@ -115,8 +134,102 @@ func (r *Query) BeginTransaction() (contractsorm.Query, error) {
 	return r.new(tx), nil
 }
 
+func (r *Query) Chunk(size int, callback func(models any) error) error {
+	if size <= 0 {
+		return errors.OrmQueryInvalidChunkSize.Args(size)
+	}
+
+	modelType, err := r.modelType()
+	if err != nil {
+		return err
+	}
+
+	// The chunks must be retrieved in the same order, otherwise the database may return the rows in any order for
+	// each chunk, then some rows are skipped and others are retrieved twice.
+	query, err := r.orderByPrimaryKey(modelType)
+	if err != nil {
+		return err
+	}
+
+	for offset := 0; ; offset += size {
+		models := reflect.New(reflect.SliceOf(modelType))
+		if err := query.Offset(offset).Limit(size).Find(models.Interface()); err != nil {
+			return err
+		}
+
+		count := models.Elem().Len()
+		if count == 0 {
+			return nil
+		}
+
+		if err := callback(models.Interface()); err != nil {
+			return err
+		}
+
+		if count < size {
+			return nil
+		}
+	}
+}
+
+func (r *Query) ChunkByID(size int, column string, callback func(models any) error) error {
+	if size <= 0 {
+		return errors.OrmQueryInvalidChunkSize.Args(size)
+	}
+
+	modelType, err := r.modelType()
+	if err != nil {
+		return err
+	}
+
+	if column == "" {
+		column = "id"
+	}
+
+	// The rows must be ordered by the column only, otherwise the rows after the last id may be skipped.
+	conditions := r.conditions
+	conditions.order = nil
+	ordered := r.setConditions(conditions).OrderBy(column)
+
+	var lastID any
+	for {
+		query := ordered.Limit(size)
+		if lastID != nil {
+			query = query.Where(fmt.Sprintf("%s > ?", column), lastID)
+		}
+
+		models := reflect.New(reflect.SliceOf(modelType))
+		if err := query.Find(models.Interface()); err != nil {
+			return err
+		}
+
+		count := models.Elem().Len()
+		if count == 0 {
+			return nil
+		}
+
+		// Get the last id before calling the callback, the callback may modify the models.
+		if lastID, err = database.GetColumnValue(models.Elem().Index(count-1), column); err != nil {
+			return err
+		}
+
+		if err := callback(models.Interface()); err != nil {
+			return err
+		}
+
+		if count < size {
+			return nil
+		}
+	}
+}
+
 func (r *Query) Commit() error {
-	return r.instance.Commit().Error
+	transaction := r.instance.Statement.ConnPool
+	if err := r.instance.Commit().Error; err != nil {
+		return err
+	}
+
+	return db.FlushQueryCacheOnCommit(r.ctx, transaction)
 }
 
 func (r *Query) Count() (int64, error) {
@@ -124,7 +237,9 @@ func (r *Query) Count() (int64, error) {
 
 	var count int64
 
-	err := query.instance.Count(&count).Error
+	err := query.remember(&count, func(tx *gormio.DB) *gormio.DB {
+		return tx.Count(&count)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -189,6 +304,37 @@ func (r *Query) Cursor() chan contractsdb.Row {
 	return cursorChan
 }
 
+func (r *Query) CursorPaginate(limit int, cursor string, dest any) (next, prev string, err error) {
+	orders := make([]string, 0, len(r.conditions.order))
+	for _, order := range r.conditions.order {
+		orders = append(orders, cast.ToString(order))
+	}
+
+	primaryKey := "id"
+	model := r.conditions.model
+	if model == nil {
+		model = dest
+	}
+	if field, err := r.primaryKeyField(model); err == nil && field != nil {
+		primaryKey = field.DBName
+	}
+
+	return database.CursorPaginate(orders, primaryKey, limit, cursor, dest, func(orders []string, where string, args []any, limit int) error {
+		conditions := r.conditions
+		conditions.order = nil
+
+		var query contractsorm.Query = r.setConditions(conditions)
+		for _, order := range orders {
+			query = query.OrderByRaw(order)
+		}
+		if where != "" {
+			query = query.Where(where, args...)
+		}
+
+		return query.Limit(limit).Find(dest)
+	})
+}
+
 func (r *Query) DB() (*sql.DB, error) {
 	return r.instance.DB()
 }
@@ -210,9 +356,25 @@ func (r *Query) Delete(dests ...any) (*contractsdb.Result, error) {
 		return nil, err
 	}
 
-	res := query.instance.Delete(dest)
-	if res.Error != nil {
-		return nil, res.Error
+	var res *gormio.DB
+	deleteModels := func(query *Query, models []any) error {
+		res = query.instance.Delete(dest)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		return query.cascadeDelete(models)
+	}
+
+	// The models are force deleted if the query is unscoped, the cascaded relationships are kept.
+	var err error
+	if query.instance.Statement.Unscoped {
+		err = deleteModels(query, nil)
+	} else {
+		err = query.cascadeTransaction(dest, deleteModels)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	if err := query.deleted(dest); err != nil {
@@ -239,6 +401,19 @@ func (r *Query) Driver() string {
 	return r.dbConfig.Driver
 }
 
+func (r *Query) Each(callback func(model any) error) error {
+	return r.Chunk(1000, func(models any) error {
+		values := reflect.ValueOf(models).Elem()
+		for i := 0; i < values.Len(); i++ {
+			if err := callback(values.Index(i).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 func (r *Query) Exec(sql string, values ...any) (*contractsdb.Result, error) {
 	query := r.buildConditions()
 	result := query.instance.Exec(sql, values...)
@@ -266,7 +441,9 @@ func (r *Query) Find(dest any, conds ...any) error {
 	if err := filterFindConditions(conds...); err != nil {
 		return err
 	}
-	if err := query.instance.Find(dest, conds...).Error; err != nil {
+	if err := query.remember(dest, func(tx *gormio.DB) *gormio.DB {
+		return tx.Find(dest, conds...)
+	}); err != nil {
 		return err
 	}
 
@@ -295,13 +472,14 @@ func (r *Query) FindOrFail(dest any, conds ...any) error {
 func (r *Query) First(dest any) error {
 	query := r.dest(dest).addGlobalScopes().buildConditions()
 
-	res := query.instance.First(dest)
-	if res.Error != nil {
-		if errors.Is(res.Error, gormio.ErrRecordNotFound) {
+	if err := query.remember(dest, func(tx *gormio.DB) *gormio.DB {
+		return tx.First(dest)
+	}); err != nil {
+		if errors.Is(err, gormio.ErrRecordNotFound) {
 			return nil
 		}
 
-		return res.Error
+		return err
 	}
 
 	return query.retrieved(dest)
@@ -565,6 +743,13 @@ func (r *Query) Omit(columns ...string) contractsorm.Query {
 	return r.setConditions(conditions)
 }
 
+func (r *Query) OnlyTrashed() contractsorm.Query {
+	conditions := r.conditions
+	conditions.onlyTrashed = true
+
+	return r.setConditions(conditions)
+}
+
 // DEPRECATED: Use OrderByRaw instead
 func (r *Query) Order(value any) contractsorm.Query {
 	return r.OrderByRaw(fmt.Sprintf("%s", value))
@@ -584,6 +769,22 @@ func (r *Query) OrderByDesc(column string) contractsorm.Query {
 	return r.OrderByRaw(fmt.Sprintf("%s DESC", column))
 }
 
+func (r *Query) OrderByFullText(columns []string, value string, options ...contractsdb.FullTextOptions) contractsorm.Query {
+	sql, args, err := db.CompileOrderByFullText(r.grammar, r.dbConfig.Driver, columns, value, options...)
+	if err != nil {
+		query := r.new(r.instance.Session(&gormio.Session{}))
+		_ = query.instance.AddError(err)
+		return query
+	}
+
+	var order any = clause.Expr{SQL: sql, Vars: args}
+
+	conditions := r.conditions
+	conditions.order = deep.Append(r.conditions.order, order)
+
+	return r.setConditions(conditions)
+}
+
 func (r *Query) OrderByRaw(raw string) contractsorm.Query {
 	var rawAny any = raw
 
@@ -607,6 +808,10 @@ func (r *Query) InTransaction() bool {
 	return ok && committer != nil
 }
 
+func (r *Query) InsertOrIgnore(value any) (*contractsdb.Result, error) {
+	return r.onConflictCreate(value, clause.OnConflict{DoNothing: true})
+}
+
 func (r *Query) OrWhere(query any, args ...any) contractsorm.Query {
 	return r.addWhere(contractsdriver.Where{
 		Query: query,
@@ -641,13 +846,27 @@ func (r *Query) Paginate(page, limit int, dest any, total *int64) error {
 func (r *Query) Pluck(column string, dest any) error {
 	query := r.addGlobalScopes().buildConditions()
 
-	return query.instance.Pluck(column, dest).Error
+	return query.remember(dest, func(tx *gormio.DB) *gormio.DB {
+		return tx.Pluck(column, dest)
+	})
 }
 
 func (r *Query) Raw(sql string, values ...any) contractsorm.Query {
 	return r.new(r.instance.Raw(sql, values...))
 }
 
+func (r *Query) Remember(ttl time.Duration, key ...string) contractsorm.Query {
+	conditions := r.conditions
+	conditions.remember = &db.RememberOptions{
+		TTL: ttl,
+	}
+	if len(key) > 0 {
+		conditions.remember.Key = key[0]
+	}
+
+	return r.setConditions(conditions)
+}
+
 func (r *Query) Restore(dests ...any) (*contractsdb.Result, error) {
 	var (
 		dest  any
@@ -661,14 +880,9 @@ func (r *Query) Restore(dests ...any) (*contractsdb.Result, error) {
 		query = r.addGlobalScopes().buildConditions()
 	}
 
-	var (
-		deletedAtColumnName string
-
-		tx = query.instance
-	)
+	var deletedAtColumnName string
 	if dest != nil {
 		deletedAtColumnName = getDeletedAtColumn(dest)
-		tx = query.instance.Model(dest)
 	} else if query.conditions.model != nil {
 		deletedAtColumnName = getDeletedAtColumn(query.conditions.model)
 	}
@@ -680,9 +894,27 @@ func (r *Query) Restore(dests ...any) (*contractsdb.Result, error) {
 		return nil, err
 	}
 
-	res := tx.Update(deletedAtColumnName, nil)
-	if res.Error != nil {
-		return nil, res.Error
+	var res *gormio.DB
+	if err := query.cascadeTransaction(dest, func(query *Query, models []any) error {
+		// The deleted times are got before restoring, the cascaded models deleted at the same time are restored with
+		// the models.
+		deletedAts, err := query.cascadeDeletedAts(models)
+		if err != nil {
+			return err
+		}
+
+		if dest != nil {
+			res = query.instance.Model(dest).Update(deletedAtColumnName, nil)
+		} else {
+			res = query.instance.Update(deletedAtColumnName, nil)
+		}
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		return query.cascadeRestore(models, deletedAts)
+	}); err != nil {
+		return nil, err
 	}
 
 	if err := r.restored(dest); err != nil {
@@ -695,6 +927,8 @@ func (r *Query) Restore(dests ...any) (*contractsdb.Result, error) {
 }
 
 func (r *Query) Rollback() error {
+	db.ForgetQueryCacheFlushes(r.instance.Statement.ConnPool)
+
 	return r.instance.Rollback().Error
 }
 
@@ -919,6 +1153,22 @@ func (r *Query) UpdateOrCreate(dest any, attributes any, values any) error {
 	return r.Create(dest)
 }
 
+func (r *Query) Upsert(value any, uniqueBy []string, update []string) (*contractsdb.Result, error) {
+	if len(uniqueBy) == 0 {
+		return nil, errors.DatabaseUpsertUniqueByIsRequired
+	}
+
+	onConflict := clause.OnConflict{UpdateAll: len(update) == 0}
+	for _, column := range uniqueBy {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: column})
+	}
+	if len(update) > 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(update)
+	}
+
+	return r.onConflictCreate(value, onConflict)
+}
+
 func (r *Query) Where(query any, args ...any) contractsorm.Query {
 	return r.addWhere(contractsdriver.Where{
 		Query: query,
@@ -974,6 +1224,17 @@ func (r *Query) WhereAny(columns []string, args ...any) contractsorm.Query {
 	return r
 }
 
+func (r *Query) WhereFullText(columns []string, value string, options ...contractsdb.FullTextOptions) contractsorm.Query {
+	sql, args, err := db.CompileWhereFullText(r.grammar, r.dbConfig.Driver, r.fullTextTable(), columns, value, options...)
+	if err != nil {
+		query := r.new(r.instance.Session(&gormio.Session{}))
+		_ = query.instance.AddError(err)
+		return query
+	}
+
+	return r.Where(sql, args...)
+}
+
 func (r *Query) WhereIn(column string, values []any) contractsorm.Query {
 	return r.Where(fmt.Sprintf("%s IN ?", column), values)
 }
@@ -1018,6 +1279,17 @@ func (r *Query) WhereJsonLength(column string, length int) contractsorm.Query {
 	})
 }
 
+func (r *Query) OrWhereFullText(columns []string, value string, options ...contractsdb.FullTextOptions) contractsorm.Query {
+	sql, args, err := db.CompileWhereFullText(r.grammar, r.dbConfig.Driver, r.fullTextTable(), columns, value, options...)
+	if err != nil {
+		query := r.new(r.instance.Session(&gormio.Session{}))
+		_ = query.instance.AddError(err)
+		return query
+	}
+
+	return r.OrWhere(sql, args...)
+}
+
 func (r *Query) OrWhereIn(column string, values []any) contractsorm.Query {
 	return r.OrWhere(fmt.Sprintf("%s IN ?", column), values)
 }
@@ -1171,12 +1443,14 @@ func (r *Query) addGlobalScopes() *Query {
 		return r
 	}
 
-	modelWithGlobalScopes, ok := model.(contractsorm.ModelWithGlobalScopes)
-	if !ok {
-		return r
+	var globalScopes []func(contractsorm.Query) contractsorm.Query
+	if scope := tenantScope(r.ctx, model); scope != nil {
+		globalScopes = append(globalScopes, scope)
+	}
+	if modelWithGlobalScopes, ok := model.(contractsorm.ModelWithGlobalScopes); ok {
+		globalScopes = append(globalScopes, modelWithGlobalScopes.GlobalScopes()...)
 	}
 
-	globalScopes := modelWithGlobalScopes.GlobalScopes()
 	if len(globalScopes) == 0 {
 		return r
 	}
@@ -1211,6 +1485,7 @@ func (r *Query) buildConditions() *Query {
 	db = query.buildOrder(db)
 	db = query.buildOffset(db)
 	db = query.buildOmit(db)
+	db = query.buildOnlyTrashed(db)
 	db = query.buildScopes(db)
 	db = query.buildSelectColumns(db)
 	db = query.buildSharedLock(db)
@@ -1325,13 +1600,47 @@ func (r *Query) buildOmit(db *gormio.DB) *gormio.DB {
 	return db
 }
 
+func (r *Query) buildOnlyTrashed(db *gormio.DB) *gormio.DB {
+	if !r.conditions.onlyTrashed {
+		return db
+	}
+
+	// The soft deleted condition is added by the callback, because the model may be unknown until the query is run.
+	db = db.Unscoped().Set(onlyTrashedKey, true)
+	r.conditions.onlyTrashed = false
+
+	return db
+}
+
 func (r *Query) buildOrder(db *gormio.DB) *gormio.DB {
 	if len(r.conditions.order) == 0 {
 		return db
 	}
 
-	for _, order := range r.conditions.order {
-		db = db.Order(order)
+	// The orders are combined into one expression if any of them has bindings, eg: OrderByFullText, because gorm
+	// drops the columns when merging an order expression.
+	if slices.ContainsFunc(r.conditions.order, func(order any) bool {
+		_, ok := order.(clause.Expr)
+		return ok
+	}) {
+		var (
+			sqls []string
+			vars []any
+		)
+		for _, order := range r.conditions.order {
+			if expr, ok := order.(clause.Expr); ok {
+				sqls = append(sqls, expr.SQL)
+				vars = append(vars, expr.Vars...)
+			} else {
+				sqls = append(sqls, cast.ToString(order))
+			}
+		}
+
+		db = db.Order(clause.OrderBy{Expression: clause.Expr{SQL: strings.Join(sqls, ", "), Vars: vars}})
+	} else {
+		for _, order := range r.conditions.order {
+			db = db.Order(order)
+		}
 	}
 
 	r.conditions.order = nil
@@ -1562,6 +1871,21 @@ func (r *Query) create(dest any) error {
 	return nil
 }
 
+// onConflictCreate inserts the value with the conflict clause, the model events are not fired
+// because the affected rows can't be distinguished between inserted, updated and ignored.
+func (r *Query) onConflictCreate(value any, onConflict clause.OnConflict) (*contractsdb.Result, error) {
+	query := r.dest(value).buildConditions()
+
+	res := query.instance.Omit(Associations).Clauses(onConflict).Create(value)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &contractsdb.Result{
+		RowsAffected: res.RowsAffected,
+	}, nil
+}
+
 func (r *Query) created(dest any) error {
 	if isSlice(dest) {
 		return nil
@@ -1638,6 +1962,59 @@ func (r *Query) deleted(dest any) error {
 	return r.event(contractsorm.EventDeleted, r.conditions.model, dest)
 }
 
+// modelType gets the struct type of the model set by Model, it's used to hydrate the chunked models.
+func (r *Query) modelType() (reflect.Type, error) {
+	if r.conditions.model == nil {
+		return nil, errors.OrmQueryModelNotSet
+	}
+
+	modelType := reflect.TypeOf(r.conditions.model)
+	for modelType.Kind() == reflect.Pointer || modelType.Kind() == reflect.Slice {
+		modelType = modelType.Elem()
+	}
+
+	if modelType.Kind() != reflect.Struct {
+		return nil, errors.OrmQueryInvalidModel.Args(modelType.String())
+	}
+
+	return modelType, nil
+}
+
+// orderByPrimaryKey orders the query by the primary key of the model if the query isn't ordered, the query is
+// returned directly if the model has no primary key.
+func (r *Query) orderByPrimaryKey(modelType reflect.Type) (contractsorm.Query, error) {
+	if len(r.conditions.order) > 0 {
+		return r, nil
+	}
+
+	field, err := r.primaryKeyField(reflect.New(modelType).Interface())
+	if err != nil {
+		return nil, err
+	}
+	if field == nil {
+		return r, nil
+	}
+
+	var order any = clause.OrderByColumn{
+		Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName},
+	}
+
+	conditions := r.conditions
+	conditions.order = []any{order}
+
+	return r.setConditions(conditions), nil
+}
+
+// primaryKeyField gets the primary key field of the model, nil is returned if the model has no primary key.
+func (r *Query) primaryKeyField(model any) (*schema.Field, error) {
+	tx := r.instance.Session(&gormio.Session{NewDB: true})
+	if err := tx.Statement.Parse(model); err != nil {
+		return nil, err
+	}
+
+	return tx.Statement.Schema.PrioritizedPrimaryField, nil
+}
+
 func (r *Query) dest(value any) *Query {
 	conditions := r.conditions
 	conditions.dest = value
@@ -1661,6 +2038,24 @@ func (r *Query) forceDeleted(dest any) error {
 	return r.event(contractsorm.EventForceDeleted, r.conditions.model, dest)
 }
 
+// fullTextTable gets the table of the full-text search, it's the table or the table of the model set before the
+// search, see db.CompileWhereFullText.
+func (r *Query) fullTextTable() string {
+	if r.conditions.table != nil {
+		return r.conditions.table.name
+	}
+	if r.conditions.model == nil {
+		return ""
+	}
+
+	tx := r.instance.Session(&gormio.Session{NewDB: true})
+	if err := tx.Statement.Parse(r.conditions.model); err != nil {
+		return ""
+	}
+
+	return tx.Statement.Schema.Table
+}
+
 func (r *Query) getModelConnection() string {
 	var (
 		model any
@@ -1786,6 +2181,25 @@ func (r *Query) restoring(dest any) error {
 	return r.event(contractsorm.EventRestoring, r.conditions.model, dest)
 }
 
+// remember executes the query, the result is cached if Remember is called. The SQL is generated by a dry run
+// to build the cache key.
+func (r *Query) remember(dest any, execute func(tx *gormio.DB) *gormio.DB) error {
+	if r.conditions.remember == nil {
+		return execute(r.instance).Error
+	}
+
+	dryRun := execute(r.instance.Session(&gormio.Session{DryRun: true}))
+	if dryRun.Error != nil {
+		return dryRun.Error
+	}
+
+	statement := dryRun.Statement
+
+	return db.RememberQuery(r.ctx, *r.conditions.remember, db.QueryCacheConnection(r.dbConfig), statement.Table, statement.SQL.String(), statement.Vars, dest, func() error {
+		return execute(r.instance).Error
+	})
+}
+
 func (r *Query) retrieved(dest any) error {
 	if isSlice(dest) {
 		return nil
@@ -1959,6 +2373,15 @@ func getDeletedAtColumn(model any) string {
 	if t.Kind() == reflect.Pointer {
 		t = t.Elem()
 	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+	}
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
 
 	for i := 0; i < t.NumField(); i++ {
 		if !t.Field(i).IsExported() {
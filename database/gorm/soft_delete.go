@@ -0,0 +1,370 @@
+package gorm
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"sync"
+	"time"
+
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/database"
+)
+
+const (
+	onlyTrashedCallback = "goravel:only_trashed"
+	onlyTrashedKey      = "goravel:only_trashed"
+)
+
+var (
+	// softDeleteFields caches the soft delete fields of the parsed schemas, the key is *schema.Schema, the value is
+	// *schema.Field, it's nil if the model can't be soft deleted.
+	softDeleteFields      sync.Map
+	softDeleteCallbackMux sync.Mutex
+)
+
+// registerSoftDeleteCallbacks registers the gorm callbacks that limit the queries to the soft deleted models, see
+// Query.OnlyTrashed.
+func registerSoftDeleteCallbacks(instance *gormio.DB) error {
+	softDeleteCallbackMux.Lock()
+	defer softDeleteCallbackMux.Unlock()
+
+	callbacks := instance.Callback()
+	if callbacks.Query().Get(onlyTrashedCallback) != nil {
+		return nil
+	}
+
+	if err := callbacks.Query().Before("gorm:query").Register(onlyTrashedCallback, onlyTrashed); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register(onlyTrashedCallback, onlyTrashed); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register(onlyTrashedCallback, onlyTrashed); err != nil {
+		return err
+	}
+
+	return callbacks.Delete().Before("gorm:delete").Register(onlyTrashedCallback, onlyTrashed)
+}
+
+// onlyTrashed adds the "deleted_at is not null" condition to the query, the setting is removed once it's applied, so
+// it isn't inherited by the preloaded relationships.
+func onlyTrashed(tx *gormio.DB) {
+	if tx.Error != nil {
+		return
+	}
+	if _, ok := tx.Statement.Settings.Load(onlyTrashedKey); !ok {
+		return
+	}
+	tx.Statement.Settings.Delete(onlyTrashedKey)
+
+	field := softDeleteField(tx.Statement.Schema)
+	if field == nil {
+		_ = tx.AddError(errors.OrmDeletedAtColumnNotFound)
+		return
+	}
+
+	tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Neq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: nil},
+	}})
+}
+
+func softDeleteField(modelSchema *schema.Schema) *schema.Field {
+	if modelSchema == nil {
+		return nil
+	}
+	if field, ok := softDeleteFields.Load(modelSchema); ok {
+		return field.(*schema.Field)
+	}
+
+	var field *schema.Field
+	for _, item := range modelSchema.Fields {
+		if item.DBName != "" && item.FieldType == reflect.TypeOf(gormio.DeletedAt{}) {
+			field = item
+			break
+		}
+	}
+	softDeleteFields.Store(modelSchema, field)
+
+	return field
+}
+
+// cascadeTransaction calls the callback in a transaction with the models whose cascaded relationships are deleted or
+// restored with them, the models are got before the callback, so they can be found by the conditions of the query. The
+// callback is called without the transaction and the models if the model doesn't declare cascaded relationships.
+func (r *Query) cascadeTransaction(dest any, callback func(query *Query, models []any) error) error {
+	modelSchema, err := r.cascadeSchema(dest)
+	if err != nil {
+		return err
+	}
+	if modelSchema == nil {
+		return callback(r, nil)
+	}
+
+	return r.instance.Transaction(func(tx *gormio.DB) error {
+		query := r.new(tx)
+		models, err := query.cascadeModels(dest, modelSchema)
+		if err != nil {
+			return err
+		}
+
+		return callback(query, models)
+	})
+}
+
+// cascadeSchema gets the schema of the model if it can be soft deleted and declares cascaded relationships, the model
+// is the dest or the model of the query.
+func (r *Query) cascadeSchema(dest any) (*schema.Schema, error) {
+	model := dest
+	if model == nil {
+		model = r.conditions.model
+	}
+	if model == nil {
+		return nil, nil
+	}
+
+	tx := r.instance.Session(&gormio.Session{NewDB: true})
+	if err := tx.Statement.Parse(model); err != nil {
+		return nil, err
+	}
+
+	modelSchema := tx.Statement.Schema
+	if softDeleteField(modelSchema) == nil || modelSchema.PrioritizedPrimaryField == nil {
+		return nil, nil
+	}
+
+	modelWithCascadeDeletes, ok := reflect.New(modelSchema.ModelType).Interface().(contractsorm.ModelWithCascadeDeletes)
+	if !ok || len(modelWithCascadeDeletes.CascadeDeletes()) == 0 {
+		return nil, nil
+	}
+
+	return modelSchema, nil
+}
+
+// cascadeModels gets the models matched by the query, they are limited to the primary keys of the model if it has
+// them, a struct or a slice, otherwise the conditions of the query are used only.
+func (r *Query) cascadeModels(dest any, modelSchema *schema.Schema) ([]any, error) {
+	model := dest
+	if model == nil {
+		model = r.conditions.model
+	}
+
+	tx := r.instance.Session(&gormio.Session{})
+	if ids := primaryKeys(r.ctx, model, modelSchema); len(ids) > 0 {
+		tx = tx.Where(clause.IN{Column: clause.Column{Table: clause.CurrentTable, Name: modelSchema.PrioritizedPrimaryField.DBName}, Values: ids})
+	}
+
+	models := reflect.New(reflect.SliceOf(reflect.PointerTo(modelSchema.ModelType)))
+	if err := tx.Find(models.Interface()).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]any, 0, models.Elem().Len())
+	for i := 0; i < models.Elem().Len(); i++ {
+		result = append(result, models.Elem().Index(i).Interface())
+	}
+
+	return result, nil
+}
+
+// cascadeDelete soft deletes the models of the relationships declared by contractsorm.ModelWithCascadeDeletes, they
+// are deleted one by one, so their events are fired and their own relationships are cascaded too. They are deleted
+// with the deleted time of the model, so cascadeRestore can find them.
+func (r *Query) cascadeDelete(models []any) error {
+	for _, model := range models {
+		deletedAt, err := r.deletedAt(model)
+		if err != nil {
+			return err
+		}
+		if deletedAt == nil {
+			continue
+		}
+
+		if err := r.cascade(model, *deletedAt, false, func(query *Query, model any) error {
+			_, err := query.Delete(model)
+
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cascadeRestore restores the models of the relationships declared by contractsorm.ModelWithCascadeDeletes that were
+// deleted with the model, the deleted times are got before restoring. The models deleted at another time are kept
+// deleted, note that they can't be told apart if the column doesn't store the fractional seconds and they are deleted
+// in the same second as the model.
+func (r *Query) cascadeRestore(models []any, deletedAts []*time.Time) error {
+	for i, model := range models {
+		if deletedAts[i] == nil {
+			continue
+		}
+
+		if err := r.cascade(model, *deletedAts[i], true, func(query *Query, model any) error {
+			_, err := query.WithTrashed().Restore(model)
+
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cascadeDeletedAts gets the deleted times of the models, nil is set if a model is not soft deleted.
+func (r *Query) cascadeDeletedAts(models []any) ([]*time.Time, error) {
+	deletedAts := make([]*time.Time, len(models))
+	for i, model := range models {
+		deletedAt, err := r.deletedAt(model)
+		if err != nil {
+			return nil, err
+		}
+
+		deletedAts[i] = deletedAt
+	}
+
+	return deletedAts, nil
+}
+
+// cascade calls the callback with the models of every cascaded relationship of the model, the models soft deleted at
+// deletedAt are used if trashed is true, otherwise the models that are not deleted are used. The callback query
+// deletes the models at deletedAt.
+func (r *Query) cascade(dest any, deletedAt time.Time, trashed bool, callback func(query *Query, model any) error) error {
+	_, relationships, err := r.cascadeRelationships(dest)
+	if err != nil || len(relationships) == 0 {
+		return err
+	}
+
+	for _, relationship := range relationships {
+		field := softDeleteField(relationship.FieldSchema)
+		if field == nil {
+			return errors.OrmDeletedAtColumnNotFound
+		}
+
+		tx := r.instance.Session(&gormio.Session{NewDB: true}).
+			Model(reflect.New(relationship.FieldSchema.ModelType).Interface()).
+			Clauses(clause.Where{Exprs: relationship.ToQueryConditions(r.ctx, reflect.Indirect(reflect.ValueOf(dest)))})
+		if trashed {
+			tx = tx.Unscoped().Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: deletedAt})
+		}
+
+		models := reflect.New(reflect.SliceOf(reflect.PointerTo(relationship.FieldSchema.ModelType)))
+		if err := tx.Find(models.Interface()).Error; err != nil {
+			return err
+		}
+
+		for i := 0; i < models.Elem().Len(); i++ {
+			if err := callback(r.cascadeQuery(deletedAt), models.Elem().Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// cascadeRelationships gets the cascaded relationships of the model, only the has one and has many relationships are
+// supported.
+func (r *Query) cascadeRelationships(dest any) (*schema.Schema, []*schema.Relationship, error) {
+	if dest == nil || !hasID(dest) {
+		return nil, nil, nil
+	}
+
+	modelWithCascadeDeletes, ok := dest.(contractsorm.ModelWithCascadeDeletes)
+	if !ok || len(modelWithCascadeDeletes.CascadeDeletes()) == 0 {
+		return nil, nil, nil
+	}
+
+	tx := r.instance.Session(&gormio.Session{NewDB: true})
+	if err := tx.Statement.Parse(dest); err != nil {
+		return nil, nil, err
+	}
+
+	modelSchema := tx.Statement.Schema
+	relationships := make([]*schema.Relationship, 0, len(modelWithCascadeDeletes.CascadeDeletes()))
+	for _, name := range modelWithCascadeDeletes.CascadeDeletes() {
+		relationship, ok := modelSchema.Relationships.Relations[name]
+		if !ok {
+			return nil, nil, errors.OrmCascadeRelationNotFound.Args(name, modelSchema.Name)
+		}
+		if relationship.Type != schema.HasOne && relationship.Type != schema.HasMany {
+			return nil, nil, errors.OrmCascadeRelationNotSupported.Args(name, modelSchema.Name)
+		}
+
+		relationships = append(relationships, relationship)
+	}
+
+	return modelSchema, relationships, nil
+}
+
+// cascadeQuery gets a query without conditions for the cascaded models, the events are disabled if they are disabled
+// for the model, and the models are soft deleted at deletedAt.
+func (r *Query) cascadeQuery(deletedAt time.Time) *Query {
+	instance := r.instance.Session(&gormio.Session{NewDB: true, NowFunc: func() time.Time {
+		return deletedAt
+	}})
+	query := NewQuery(r.ctx, r.config, r.dbConfig, instance, r.grammar, r.log, r.modelToObserver, nil)
+	query.conditions.withoutEvents = r.conditions.withoutEvents
+
+	return query
+}
+
+// deletedAt gets the deleted time of the model if it has cascaded relationships, nil is returned if the model is not
+// soft deleted.
+func (r *Query) deletedAt(dest any) (*time.Time, error) {
+	modelSchema, relationships, err := r.cascadeRelationships(dest)
+	if err != nil || len(relationships) == 0 {
+		return nil, err
+	}
+
+	field := softDeleteField(modelSchema)
+	if field == nil || modelSchema.PrioritizedPrimaryField == nil {
+		return nil, errors.OrmDeletedAtColumnNotFound
+	}
+
+	var deletedAt gormio.DeletedAt
+	if err := r.instance.Session(&gormio.Session{NewDB: true}).Unscoped().
+		Model(reflect.New(modelSchema.ModelType).Interface()).
+		Select(field.DBName).
+		Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: modelSchema.PrioritizedPrimaryField.DBName}, Value: database.GetID(dest)}).
+		Row().Scan(&deletedAt); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if !deletedAt.Valid {
+		return nil, nil
+	}
+
+	return &deletedAt.Time, nil
+}
+
+// primaryKeys gets the non-zero primary keys of the model, the model can be a struct or a slice.
+func primaryKeys(ctx context.Context, model any, modelSchema *schema.Schema) []any {
+	value := reflect.Indirect(reflect.ValueOf(model))
+	if value.Kind() == reflect.Struct {
+		if id, zero := modelSchema.PrioritizedPrimaryField.ValueOf(ctx, value); !zero {
+			return []any{id}
+		}
+
+		return nil
+	}
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil
+	}
+
+	var ids []any
+	for i := 0; i < value.Len(); i++ {
+		if id, zero := modelSchema.PrioritizedPrimaryField.ValueOf(ctx, reflect.Indirect(value.Index(i))); !zero {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,66 @@
+package gorm
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+type SoftDeletePost struct {
+	ID        uint
+	Title     string
+	DeletedAt gormio.DeletedAt
+}
+
+func TestSoftDeleteField(t *testing.T) {
+	assert.Nil(t, softDeleteField(nil))
+
+	postSchema, err := schema.Parse(&SoftDeletePost{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	field := softDeleteField(postSchema)
+	assert.NotNil(t, field)
+	assert.Equal(t, "deleted_at", field.DBName)
+
+	userSchema, err := schema.Parse(&CastUser{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	assert.Nil(t, softDeleteField(userSchema))
+}
+
+func TestOnlyTrashed(t *testing.T) {
+	newTx := func(model any) *gormio.DB {
+		modelSchema, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+		assert.NoError(t, err)
+
+		tx := &gormio.DB{Config: &gormio.Config{}}
+		tx.Statement = &gormio.Statement{DB: tx, Schema: modelSchema, Clauses: map[string]clause.Clause{}}
+
+		return tx
+	}
+
+	// The setting is not set
+	tx := newTx(&SoftDeletePost{})
+	onlyTrashed(tx)
+	assert.Empty(t, tx.Statement.Clauses)
+
+	tx = newTx(&SoftDeletePost{})
+	tx.Statement.Settings.Store(onlyTrashedKey, true)
+	onlyTrashed(tx)
+	assert.NoError(t, tx.Error)
+	assert.Equal(t, clause.Where{Exprs: []clause.Expression{
+		clause.Neq{Column: clause.Column{Table: clause.CurrentTable, Name: "deleted_at"}, Value: nil},
+	}}, tx.Statement.Clauses["WHERE"].Expression)
+	_, ok := tx.Statement.Settings.Load(onlyTrashedKey)
+	assert.False(t, ok)
+
+	// The model can't be soft deleted
+	tx = newTx(&CastUser{})
+	tx.Statement.Settings.Store(onlyTrashedKey, true)
+	onlyTrashed(tx)
+	assert.ErrorIs(t, tx.Error, errors.OrmDeletedAtColumnNotFound)
+}
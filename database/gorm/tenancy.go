@@ -0,0 +1,140 @@
+package gorm
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/tenancy"
+)
+
+const (
+	tenantCallback      = "goravel:tenancy"
+	tenantCheckCallback = "goravel:tenancy_check"
+)
+
+var (
+	// tenantFields caches the tenant fields of the parsed schemas, the key is *schema.Schema, the value is
+	// *schema.Field, it's nil if the model doesn't belong to a tenant.
+	tenantFields      sync.Map
+	tenantCallbackMux sync.Mutex
+)
+
+// registerTenantCallbacks registers the gorm callbacks that fill the tenant column of the created models, and fail
+// the queries of the models that belong to a tenant if the query has no tenant.
+func registerTenantCallbacks(instance *gormio.DB) error {
+	tenantCallbackMux.Lock()
+	defer tenantCallbackMux.Unlock()
+
+	callbacks := instance.Callback()
+	if callbacks.Create().Get(tenantCallback) != nil {
+		return nil
+	}
+
+	if err := callbacks.Query().Before("gorm:query").Register(tenantCheckCallback, checkTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register(tenantCheckCallback, checkTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register(tenantCheckCallback, checkTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register(tenantCheckCallback, checkTenant); err != nil {
+		return err
+	}
+
+	return callbacks.Create().Before("gorm:create").Register(tenantCallback, fillTenant)
+}
+
+// checkTenant fails the query of the model that belongs to a tenant if the query has no tenant, the tenant scope is
+// only applied by Orm().WithContext(ctx), so a query without it would read or write the data of all the tenants.
+func checkTenant(tx *gormio.DB) {
+	if tx.Error != nil || tenantField(tx.Statement.Schema) == nil || !tenantRequired(tx.Statement.Context) {
+		return
+	}
+
+	_ = tx.AddError(errors.OrmTenantNotFound.Args(tx.Statement.Schema.Name))
+}
+
+// fillTenant sets the tenant of the context to the created models, the tenant column that has been set is kept, the
+// creation fails if the tenant column isn't set and the query has no tenant.
+func fillTenant(tx *gormio.DB) {
+	if tx.Error != nil {
+		return
+	}
+
+	field := tenantField(tx.Statement.Schema)
+	if field == nil {
+		return
+	}
+
+	tenant := tenancy.FromContext(tx.Statement.Context)
+	required := tenantRequired(tx.Statement.Context)
+	fill := func(value reflect.Value) {
+		if _, zero := field.ValueOf(tx.Statement.Context, value); zero {
+			if required {
+				_ = tx.AddError(errors.OrmTenantNotFound.Args(tx.Statement.Schema.Name))
+				return
+			}
+			if tenant != "" {
+				_ = tx.AddError(field.Set(tx.Statement.Context, value, tenant))
+			}
+		}
+	}
+
+	switch tx.Statement.ReflectValue.Kind() {
+	case reflect.Struct:
+		fill(tx.Statement.ReflectValue)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < tx.Statement.ReflectValue.Len(); i++ {
+			fill(reflect.Indirect(tx.Statement.ReflectValue.Index(i)))
+		}
+	}
+}
+
+// tenantScope gets the global scope that limits the queries of the model to the tenant of the context, nil is
+// returned if the context doesn't carry a tenant or the model doesn't belong to a tenant.
+func tenantScope(ctx context.Context, model any) func(contractsorm.Query) contractsorm.Query {
+	tenant := tenancy.FromContext(ctx)
+	if tenant == "" {
+		return nil
+	}
+
+	modelWithTenant, ok := model.(contractsorm.ModelWithTenant)
+	if !ok || modelWithTenant.TenantColumn() == "" {
+		return nil
+	}
+
+	return func(query contractsorm.Query) contractsorm.Query {
+		return query.Where(modelWithTenant.TenantColumn(), tenant)
+	}
+}
+
+// tenantRequired determines if the query of the models that belong to a tenant has no tenant, it's not required if
+// the query is for all the tenants, see tenancy.WithoutTenant.
+func tenantRequired(ctx context.Context) bool {
+	return tenancy.FromContext(ctx) == "" && !tenancy.IsWithoutTenant(ctx)
+}
+
+func tenantField(modelSchema *schema.Schema) *schema.Field {
+	if modelSchema == nil {
+		return nil
+	}
+	if field, ok := tenantFields.Load(modelSchema); ok {
+		return field.(*schema.Field)
+	}
+
+	var field *schema.Field
+	if model, ok := reflect.New(modelSchema.ModelType).Interface().(contractsorm.ModelWithTenant); ok {
+		field = modelSchema.LookUpField(model.TenantColumn())
+	}
+	tenantFields.Store(modelSchema, field)
+
+	return field
+}
@@ -0,0 +1,118 @@
+package gorm
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+	"github.com/rusmanplatd/goravelframework/tenancy"
+)
+
+type TenantPost struct {
+	ID       uint
+	TenantID string
+}
+
+func (r *TenantPost) TenantColumn() string {
+	return "tenant_id"
+}
+
+func TestTenantScope(t *testing.T) {
+	ctx := tenancy.WithTenant(context.Background(), "acme")
+
+	assert.Nil(t, tenantScope(context.Background(), &TenantPost{}))
+	assert.Nil(t, tenantScope(ctx, &CastUser{}))
+
+	scope := tenantScope(ctx, &TenantPost{})
+	assert.NotNil(t, scope)
+
+	mockQuery := mocksorm.NewQuery(t)
+	mockQuery.EXPECT().Where("tenant_id", "acme").Return(mockQuery).Once()
+	assert.Equal(t, mockQuery, scope(mockQuery))
+}
+
+func TestTenantField(t *testing.T) {
+	assert.Nil(t, tenantField(nil))
+
+	postSchema, err := schema.Parse(&TenantPost{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	field := tenantField(postSchema)
+	assert.NotNil(t, field)
+	assert.Equal(t, "tenant_id", field.DBName)
+
+	userSchema, err := schema.Parse(&CastUser{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	assert.Nil(t, tenantField(userSchema))
+}
+
+func TestCheckTenant(t *testing.T) {
+	// The query has a tenant
+	tx := newTenantTx(t, tenancy.WithTenant(context.Background(), "acme"), &TenantPost{})
+	checkTenant(tx)
+	assert.NoError(t, tx.Error)
+
+	// The query is for all the tenants
+	tx = newTenantTx(t, tenancy.WithoutTenant(context.Background()), &TenantPost{})
+	checkTenant(tx)
+	assert.NoError(t, tx.Error)
+
+	// The model doesn't belong to a tenant
+	tx = newTenantTx(t, context.Background(), &CastUser{})
+	checkTenant(tx)
+	assert.NoError(t, tx.Error)
+
+	// The query has no tenant
+	tx = newTenantTx(t, context.Background(), &TenantPost{})
+	checkTenant(tx)
+	assert.ErrorIs(t, tx.Error, errors.OrmTenantNotFound)
+}
+
+func TestFillTenant(t *testing.T) {
+	post := &TenantPost{}
+	tx := newTenantTx(t, tenancy.WithTenant(context.Background(), "acme"), post)
+	fillTenant(tx)
+	assert.NoError(t, tx.Error)
+	assert.Equal(t, "acme", post.TenantID)
+
+	// The tenant that has been set is kept
+	post = &TenantPost{TenantID: "globex"}
+	tx = newTenantTx(t, tenancy.WithTenant(context.Background(), "acme"), post)
+	fillTenant(tx)
+	assert.NoError(t, tx.Error)
+	assert.Equal(t, "globex", post.TenantID)
+
+	post = &TenantPost{TenantID: "globex"}
+	tx = newTenantTx(t, context.Background(), post)
+	fillTenant(tx)
+	assert.NoError(t, tx.Error)
+
+	// The query has no tenant
+	posts := &[]TenantPost{{TenantID: "globex"}, {}}
+	tx = newTenantTx(t, context.Background(), posts)
+	fillTenant(tx)
+	assert.ErrorIs(t, tx.Error, errors.OrmTenantNotFound)
+
+	// The query is for all the tenants
+	post = &TenantPost{}
+	tx = newTenantTx(t, tenancy.WithoutTenant(context.Background()), post)
+	fillTenant(tx)
+	assert.NoError(t, tx.Error)
+	assert.Empty(t, post.TenantID)
+}
+
+func newTenantTx(t *testing.T, ctx context.Context, model any) *gormio.DB {
+	modelSchema, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+
+	tx := &gormio.DB{Config: &gormio.Config{}}
+	tx.Statement = &gormio.Statement{DB: tx, Context: ctx, Schema: modelSchema, ReflectValue: reflect.ValueOf(model).Elem()}
+
+	return tx
+}
@@ -0,0 +1,126 @@
+package gorm
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/spf13/cast"
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+const (
+	versionCallback      = "goravel:optimistic_lock"
+	versionCheckCallback = "goravel:optimistic_lock_check"
+	versionInstanceKey   = "goravel:current_version"
+)
+
+var (
+	// versionFields caches the version fields of the parsed schemas, the key is *schema.Schema, the value is
+	// *schema.Field, it's nil if the model is not versioned.
+	versionFields      sync.Map
+	versionCallbackMux sync.Mutex
+)
+
+// registerVersionCallbacks registers the gorm callbacks of the optimistic locking, the version of the model is
+// initialized when it's created, checked and incremented when it's updated.
+func registerVersionCallbacks(instance *gormio.DB) error {
+	versionCallbackMux.Lock()
+	defer versionCallbackMux.Unlock()
+
+	callbacks := instance.Callback()
+	if callbacks.Create().Get(versionCallback) != nil {
+		return nil
+	}
+
+	if err := callbacks.Create().Before("gorm:create").Register(versionCallback, initVersion); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register(versionCallback, incrementVersion); err != nil {
+		return err
+	}
+
+	return callbacks.Update().After("gorm:update").Register(versionCheckCallback, checkVersion)
+}
+
+func initVersion(tx *gormio.DB) {
+	field := versionField(tx.Statement.Schema)
+	if tx.Error != nil || field == nil {
+		return
+	}
+
+	initialize := func(value reflect.Value) {
+		if _, zero := field.ValueOf(tx.Statement.Context, value); zero {
+			_ = tx.AddError(field.Set(tx.Statement.Context, value, 1))
+		}
+	}
+
+	switch tx.Statement.ReflectValue.Kind() {
+	case reflect.Struct:
+		initialize(tx.Statement.ReflectValue)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < tx.Statement.ReflectValue.Len(); i++ {
+			initialize(reflect.Indirect(tx.Statement.ReflectValue.Index(i)))
+		}
+	}
+}
+
+// incrementVersion adds the WHERE condition of the current version and increments the version, it's skipped
+// if the model isn't retrieved, eg: query.Model(&Order{}).Where("status", "pending").Update("status", "paid").
+func incrementVersion(tx *gormio.DB) {
+	field := versionField(tx.Statement.Schema)
+	if tx.Error != nil || field == nil || tx.Statement.ReflectValue.Kind() != reflect.Struct {
+		return
+	}
+
+	current, zero := field.ValueOf(tx.Statement.Context, tx.Statement.ReflectValue)
+	if zero {
+		return
+	}
+
+	tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: current},
+	}})
+	if len(tx.Statement.Selects) > 0 && !slices.Contains(tx.Statement.Selects, "*") {
+		tx.Statement.Selects = append(tx.Statement.Selects, field.DBName)
+	}
+
+	tx.Statement.SetColumn(field.DBName, cast.ToInt64(current)+1, true)
+	tx.InstanceSet(versionInstanceKey, current)
+}
+
+// checkVersion returns errors.OrmStaleModel and restores the version of the model if no rows are updated.
+func checkVersion(tx *gormio.DB) {
+	current, ok := tx.InstanceGet(versionInstanceKey)
+	if !ok || tx.Error != nil || tx.DryRun || tx.RowsAffected > 0 {
+		return
+	}
+
+	if field := versionField(tx.Statement.Schema); field != nil && tx.Statement.ReflectValue.CanAddr() {
+		_ = tx.AddError(field.Set(tx.Statement.Context, tx.Statement.ReflectValue, current))
+	}
+
+	_ = tx.AddError(errors.OrmStaleModel)
+}
+
+func versionField(modelSchema *schema.Schema) *schema.Field {
+	if modelSchema == nil {
+		return nil
+	}
+	if field, ok := versionFields.Load(modelSchema); ok {
+		return field.(*schema.Field)
+	}
+
+	var field *schema.Field
+	if model, ok := reflect.New(modelSchema.ModelType).Interface().(contractsorm.ModelWithVersion); ok {
+		field = modelSchema.LookUpField(model.VersionColumn())
+	}
+	versionFields.Store(modelSchema, field)
+
+	return field
+}
@@ -0,0 +1,32 @@
+package gorm
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/schema"
+)
+
+type VersionedOrder struct {
+	ID       uint
+	Revision uint
+}
+
+func (r *VersionedOrder) VersionColumn() string {
+	return "revision"
+}
+
+func TestVersionField(t *testing.T) {
+	assert.Nil(t, versionField(nil))
+
+	orderSchema, err := schema.Parse(&VersionedOrder{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	field := versionField(orderSchema)
+	assert.NotNil(t, field)
+	assert.Equal(t, "revision", field.DBName)
+
+	userSchema, err := schema.Parse(&CastUser{}, &sync.Map{}, schema.NamingStrategy{})
+	assert.NoError(t, err)
+	assert.Nil(t, versionField(userSchema))
+}
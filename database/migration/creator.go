@@ -40,6 +40,16 @@ func (r *Creator) PopulateStub(stub, signature, table string) string {
 	return stub
 }
 
+// PopulateViewStub Populate the place-holders in the view migration stub.
+func (r *Creator) PopulateViewStub(signature, view string, materialized bool) string {
+	stub := Stubs{}.View()
+	if materialized {
+		stub = Stubs{}.MaterializedView()
+	}
+
+	return strings.ReplaceAll(r.PopulateStub(stub, signature, ""), "DummyView", view)
+}
+
 // GetPath Get the full path to the migration.
 func (r *Creator) GetPath(name string) string {
 	pwd, _ := os.Getwd()
@@ -51,3 +61,10 @@ func (r *Creator) GetPath(name string) string {
 func (r *Creator) GetFileName(name string) string {
 	return fmt.Sprintf("%s_%s", carbon.Now().ToShortDateTimeString(), name)
 }
+
+// SchemaDumpPath Get the path of the schema dump of the connection.
+func SchemaDumpPath(connection string) string {
+	pwd, _ := os.Getwd()
+
+	return filepath.Join(pwd, filepath.Dir(support.Config.Paths.Migration), "schema", connection+"-schema.sql")
+}
@@ -0,0 +1,394 @@
+package migration
+
+import (
+	"fmt"
+	"go/format"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	contractsschema "github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/support/collect"
+)
+
+type Differ struct {
+	schema   contractsschema.Schema
+	driver   string
+	prefix   string
+	drop     bool
+	warnings []string
+}
+
+// NewDiffer creates a differ, the columns that aren't defined by the models are dropped only if drop is true,
+// because they may be added by the migrations for the raw queries.
+func NewDiffer(schema contractsschema.Schema, driver, prefix string, drop bool) *Differ {
+	return &Differ{
+		schema: schema,
+		driver: driver,
+		prefix: prefix,
+		drop:   drop,
+	}
+}
+
+// Warnings gets the changes that are found by Diff but aren't generated, eg: a column can't be changed on SQLite.
+func (r *Differ) Warnings() []string {
+	return r.warnings
+}
+
+// Diff compares the models with the tables of the database and returns the statements of the Up and Down methods,
+// they are empty if the database is up to date.
+func (r *Differ) Diff(tables []*ModelTable) (string, string, error) {
+	var ups, downs []string
+	for _, table := range tables {
+		var (
+			up, down string
+			err      error
+		)
+		if r.schema.HasTable(table.Name) {
+			up, down, err = r.diffTable(table)
+		} else {
+			up, down = r.createTable(table)
+		}
+		if err != nil {
+			return "", "", err
+		}
+
+		if up != "" {
+			ups = append(ups, up)
+			// The tables are dropped in reverse order, to avoid dropping a referenced table first.
+			downs = append([]string{down}, downs...)
+		}
+	}
+
+	return strings.Join(ups, "\n"), strings.Join(downs, "\n"), nil
+}
+
+func (r *Differ) createTable(table *ModelTable) (string, string) {
+	var lines []string
+	for _, column := range table.Columns {
+		lines = append(lines, renderColumn(column))
+	}
+	for _, index := range table.Indexes {
+		lines = append(lines, renderIndex(index))
+	}
+	for _, foreignKey := range table.ForeignKeys {
+		lines = append(lines, renderForeignKey(foreignKey))
+	}
+
+	up := fmt.Sprintf("if err := facades.Schema().Create(%s, func(table schema.Blueprint) {\n%s\n}); err != nil {\nreturn err\n}\n",
+		strconv.Quote(table.Name), strings.Join(lines, "\n"))
+	down := fmt.Sprintf("if err := facades.Schema().DropIfExists(%s); err != nil {\nreturn err\n}\n", strconv.Quote(table.Name))
+
+	return up, down
+}
+
+func (r *Differ) diffTable(table *ModelTable) (string, string, error) {
+	columns, err := r.schema.GetColumns(table.Name)
+	if err != nil {
+		return "", "", err
+	}
+
+	indexes, err := r.schema.GetIndexes(table.Name)
+	if err != nil {
+		return "", "", err
+	}
+
+	foreignKeys, err := r.schema.GetForeignKeys(table.Name)
+	if err != nil {
+		return "", "", err
+	}
+
+	var ups, downs []string
+	for _, column := range table.Columns {
+		dbColumn, ok := findColumn(columns, column.Name)
+		if !ok {
+			ups = append(ups, renderColumn(column))
+			downs = append(downs, fmt.Sprintf("table.DropColumn(%s)", strconv.Quote(column.Name)))
+			continue
+		}
+
+		if column.Primary || !r.columnChanged(column, dbColumn) {
+			continue
+		}
+
+		// SQLite can't change a column in place.
+		if r.driver == "sqlite" {
+			r.warnings = append(r.warnings, fmt.Sprintf("The column %s.%s is changed, but SQLite can't change a column in place, change it manually", table.Name, column.Name))
+			continue
+		}
+
+		ups = append(ups, renderColumn(column)+".Change()")
+		downs = append(downs, renderDBColumn(dbColumn)+".Change()")
+	}
+
+	for _, column := range columns {
+		if slices.ContainsFunc(table.Columns, func(item *ModelColumn) bool { return item.Name == column.Name }) {
+			continue
+		}
+		if !r.drop {
+			r.warnings = append(r.warnings, fmt.Sprintf("The column %s.%s isn't defined by the models, it's dropped only with the --drop option", table.Name, column.Name))
+			continue
+		}
+
+		ups = append(ups, fmt.Sprintf("table.DropColumn(%s)", strconv.Quote(column.Name)))
+		downs = append(downs, renderDBColumn(column))
+	}
+
+	for _, index := range table.Indexes {
+		if slices.ContainsFunc(indexes, func(item driver.Index) bool {
+			return !item.Primary && item.Unique == index.Unique && slices.Equal(item.Columns, index.Columns)
+		}) {
+			continue
+		}
+
+		ups = append(ups, renderIndex(index))
+		downs = append(downs, renderDropIndex(index))
+	}
+
+	for _, index := range indexes {
+		if index.Primary || strings.HasPrefix(index.Name, "sqlite_autoindex") {
+			continue
+		}
+		// Some drivers create an index for the foreign key automatically, eg: MySQL.
+		if !index.Unique && slices.ContainsFunc(foreignKeys, func(item driver.ForeignKey) bool {
+			return slices.Equal(item.Columns, index.Columns)
+		}) {
+			continue
+		}
+		if slices.ContainsFunc(table.Indexes, func(item *ModelIndex) bool {
+			return item.Unique == index.Unique && slices.Equal(item.Columns, index.Columns)
+		}) {
+			continue
+		}
+
+		model := &ModelIndex{Name: index.Name, Columns: index.Columns, Unique: index.Unique}
+		ups = append(ups, renderDropIndex(model))
+		downs = append(downs, renderIndex(model))
+	}
+
+	// The foreign keys are only added but never dropped, because a model can only describe a foreign key
+	// with the constraint tag, the foreign keys that are defined in the migrations directly are kept.
+	for _, foreignKey := range table.ForeignKeys {
+		if slices.ContainsFunc(foreignKeys, func(item driver.ForeignKey) bool {
+			return slices.Equal(item.Columns, foreignKey.Columns) && item.ForeignTable == r.prefix+foreignKey.On
+		}) {
+			continue
+		}
+
+		ups = append(ups, renderForeignKey(foreignKey))
+		downs = append(downs, fmt.Sprintf("table.DropForeign(%s)", quoteColumns(foreignKey.Columns)))
+	}
+
+	if len(ups) == 0 {
+		return "", "", nil
+	}
+
+	slices.Reverse(downs)
+
+	up := fmt.Sprintf("if err := facades.Schema().Table(%s, func(table schema.Blueprint) {\n%s\n}); err != nil {\nreturn err\n}\n",
+		strconv.Quote(table.Name), strings.Join(ups, "\n"))
+	down := fmt.Sprintf("if err := facades.Schema().Table(%s, func(table schema.Blueprint) {\n%s\n}); err != nil {\nreturn err\n}\n",
+		strconv.Quote(table.Name), strings.Join(downs, "\n"))
+
+	return up, down, nil
+}
+
+func (r *Differ) columnChanged(column *ModelColumn, dbColumn driver.Column) bool {
+	if column.Nullable != dbColumn.Nullable {
+		return true
+	}
+
+	family := columnFamily(dbColumn.TypeName, dbColumn.Type)
+	if family == column.Family || family == "" || column.Family == "" {
+		return false
+	}
+
+	compatible := [][]string{
+		// Some drivers don't have a boolean type, eg: MySQL uses tinyint(1).
+		{"boolean", "integer"},
+		// Some drivers store json as text, eg: SQLite, SQL Server.
+		{"json", "string"},
+		{"datetime", "date"},
+		{"decimal", "float"},
+	}
+	for _, families := range compatible {
+		if slices.Contains(families, family) && slices.Contains(families, column.Family) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RenderDiff renders the migration file with the statements of the Up and Down methods, the migration runs on
+// the default connection if the connection is empty.
+func RenderDiff(signature, connection, up, down string) (string, error) {
+	stub := Stubs{}.Diff()
+	if connection != "" {
+		stub += Stubs{}.Connection()
+	}
+
+	stub = NewCreator().PopulateStub(stub, signature, "")
+	stub = strings.ReplaceAll(stub, "DummyUp", up)
+	stub = strings.ReplaceAll(stub, "DummyDown", down)
+	stub = strings.ReplaceAll(stub, "DummyConnection", connection)
+
+	content, err := format.Source([]byte(stub))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func findColumn(columns []driver.Column, name string) (driver.Column, bool) {
+	for _, column := range columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+
+	return driver.Column{}, false
+}
+
+func renderColumn(column *ModelColumn) string {
+	args := []string{strconv.Quote(column.Name)}
+	if (column.Method == "ID" && column.Name == "id") || (column.Method == "SoftDeletes" && column.Name == "deleted_at") {
+		args = nil
+	}
+	args = append(args, column.Args...)
+
+	statement := fmt.Sprintf("table.%s(%s)", column.Method, strings.Join(args, ", "))
+	for _, modifier := range column.Modifiers {
+		statement += "." + modifier
+	}
+	if column.Nullable && column.Method != "SoftDeletes" {
+		statement += ".Nullable()"
+	}
+	if column.Default != "" {
+		statement += fmt.Sprintf(".Default(%s)", renderDefault(column.Default))
+	}
+	if column.Comment != "" {
+		statement += fmt.Sprintf(".Comment(%s)", strconv.Quote(column.Comment))
+	}
+
+	return statement
+}
+
+func renderDBColumn(column driver.Column) string {
+	statement := fmt.Sprintf("table.Column(%s, %s)", strconv.Quote(column.Name), strconv.Quote(column.Type))
+	if column.Nullable {
+		statement += ".Nullable()"
+	}
+
+	return statement
+}
+
+func renderDefault(value string) string {
+	if value == "true" || value == "false" {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+
+	return strconv.Quote(strings.Trim(value, "'\""))
+}
+
+func renderIndex(index *ModelIndex) string {
+	method := "Index"
+	if index.Unique {
+		method = "Unique"
+	}
+
+	statement := fmt.Sprintf("table.%s(%s)", method, quoteColumns(index.Columns))
+	if index.Name != "" {
+		statement += fmt.Sprintf(".Name(%s)", strconv.Quote(index.Name))
+	}
+
+	return statement
+}
+
+func renderDropIndex(index *ModelIndex) string {
+	method := "DropIndex"
+	if index.Unique {
+		method = "DropUnique"
+	}
+	if index.Name != "" {
+		return fmt.Sprintf("table.%sByName(%s)", method, strconv.Quote(index.Name))
+	}
+
+	return fmt.Sprintf("table.%s(%s)", method, quoteColumns(index.Columns))
+}
+
+func renderForeignKey(foreignKey *ModelForeignKey) string {
+	statement := fmt.Sprintf("table.Foreign(%s).References(%s).On(%s)",
+		quoteColumns(foreignKey.Columns), quoteColumns(foreignKey.References), strconv.Quote(foreignKey.On))
+
+	switch foreignKey.OnDelete {
+	case "cascade":
+		statement += ".CascadeOnDelete()"
+	case "set null":
+		statement += ".NullOnDelete()"
+	case "restrict":
+		statement += ".RestrictOnDelete()"
+	case "no action":
+		statement += ".NoActionOnDelete()"
+	}
+
+	switch foreignKey.OnUpdate {
+	case "cascade":
+		statement += ".CascadeOnUpdate()"
+	case "restrict":
+		statement += ".RestrictOnUpdate()"
+	case "no action":
+		statement += ".NoActionOnUpdate()"
+	}
+
+	return statement
+}
+
+func quoteColumns(columns []string) string {
+	return strings.Join(collect.Map(columns, func(column string, _ int) string {
+		return strconv.Quote(column)
+	}), ", ")
+}
+
+// columnFamily gets the type family of the database column, it's used to compare the column with the model field
+// across the drivers.
+func columnFamily(typeName, ttype string) string {
+	name := strings.ToLower(strings.TrimSpace(typeName))
+	if name == "" {
+		name = strings.ToLower(strings.TrimSpace(ttype))
+	}
+	name, _, _ = strings.Cut(name, "(")
+	name = strings.TrimSpace(strings.TrimSuffix(name, " unsigned"))
+
+	switch name {
+	case "int", "integer", "int2", "int4", "int8", "bigint", "smallint", "mediumint", "tinyint", "serial", "bigserial", "smallserial":
+		return "integer"
+	case "varchar", "char", "character", "character varying", "bpchar", "nvarchar", "nchar", "text", "ntext", "tinytext",
+		"mediumtext", "longtext", "uuid", "uniqueidentifier", "enum", "citext":
+		return "string"
+	case "bool", "boolean", "bit":
+		return "boolean"
+	case "float", "float4", "float8", "double", "double precision", "real":
+		return "float"
+	case "decimal", "numeric", "money":
+		return "decimal"
+	case "date":
+		return "date"
+	case "time", "timetz", "time without time zone", "time with time zone":
+		return "time"
+	case "datetime", "datetime2", "datetimeoffset", "timestamp", "timestamptz", "timestamp without time zone",
+		"timestamp with time zone", "smalldatetime":
+		return "datetime"
+	case "json", "jsonb":
+		return "json"
+	case "blob", "tinyblob", "mediumblob", "longblob", "bytea", "binary", "varbinary":
+		return "binary"
+	}
+
+	return ""
+}
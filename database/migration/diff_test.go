@@ -0,0 +1,275 @@
+package migration
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	gormschema "gorm.io/gorm/schema"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	mocksschema "github.com/rusmanplatd/goravelframework/mocks/database/schema"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+const testModels = `package models
+
+import (
+	"github.com/rusmanplatd/goravelframework/database/orm"
+)
+
+type User struct {
+	orm.Model
+	Name   string  ` + "`gorm:\"size:100;index\"`" + `
+	Email  string  ` + "`gorm:\"uniqueIndex:idx_users_email\"`" + `
+	Age    *int
+	Active bool    ` + "`gorm:\"default:true\"`" + `
+	Avatar string  ` + "`gorm:\"-\"`" + `
+	Books  []*Book ` + "`gorm:\"constraint:OnDelete:CASCADE\"`" + `
+	orm.SoftDeletes
+}
+
+type Book struct {
+	ID     uint
+	UserID uint
+	Title  string ` + "`gorm:\"type:text;comment:The title\"`" + `
+	Price  float64 ` + "`gorm:\"type:decimal(10,2)\"`" + `
+}
+
+type Profile struct {
+	Bio string
+}
+
+func (r *Profile) TableName() string {
+	return "user_profiles"
+}
+
+type notModel struct {
+	ID uint
+}
+`
+
+type DiffTestSuite struct {
+	suite.Suite
+	dir        string
+	mockSchema *mocksschema.Schema
+}
+
+func TestDiffTestSuite(t *testing.T) {
+	suite.Run(t, &DiffTestSuite{})
+}
+
+func (s *DiffTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.mockSchema = mocksschema.NewSchema(s.T())
+	s.NoError(file.PutContent(filepath.Join(s.dir, "models.go"), testModels))
+}
+
+func (s *DiffTestSuite) TestParseModels() {
+	tables, err := ParseModels(s.dir, gormschema.NamingStrategy{})
+	s.NoError(err)
+	s.Len(tables, 3)
+
+	s.Equal("User", tables[0].Model)
+	s.Equal("users", tables[0].Name)
+	s.Equal([]string{"id", "created_at", "updated_at", "name", "email", "age", "active", "deleted_at"}, columnNames(tables[0].Columns))
+	s.Equal([]string{"100"}, tables[0].Columns[3].Args)
+	s.True(tables[0].Columns[5].Nullable)
+	s.Equal("true", tables[0].Columns[6].Default)
+	s.Equal([]*ModelIndex{
+		{Columns: []string{"name"}},
+		{Name: "idx_users_email", Columns: []string{"email"}, Unique: true},
+	}, tables[0].Indexes)
+
+	s.Equal("books", tables[1].Name)
+	s.Equal("ID", tables[1].Columns[0].Method)
+	s.Equal("Text", tables[1].Columns[2].Method)
+	s.Equal("The title", tables[1].Columns[2].Comment)
+	s.Equal([]string{"Total(10)", "Places(2)"}, tables[1].Columns[3].Modifiers)
+	s.Equal([]*ModelForeignKey{
+		{Columns: []string{"user_id"}, On: "users", References: []string{"id"}, OnDelete: "cascade"},
+	}, tables[1].ForeignKeys)
+
+	s.Equal("user_profiles", tables[2].Name)
+
+	tables, err = ParseModels(s.dir, gormschema.NamingStrategy{TablePrefix: "goravel_", SingularTable: true})
+	s.NoError(err)
+	s.Equal("user", tables[0].Name)
+
+	_, err = ParseModels(filepath.Join(s.dir, "missing"), gormschema.NamingStrategy{})
+	s.Error(err)
+}
+
+func (s *DiffTestSuite) TestDiff() {
+	tables, err := ParseModels(s.dir, gormschema.NamingStrategy{})
+	s.NoError(err)
+
+	s.mockSchema.EXPECT().HasTable("users").Return(true).Once()
+	s.mockSchema.EXPECT().GetColumns("users").Return([]driver.Column{
+		{Name: "id", Type: "bigint", TypeName: "int8", Autoincrement: true},
+		{Name: "created_at", Type: "timestamp", TypeName: "timestamp", Nullable: true},
+		{Name: "updated_at", Type: "timestamp", TypeName: "timestamp", Nullable: true},
+		{Name: "name", Type: "varchar(100)", TypeName: "varchar"},
+		{Name: "email", Type: "varchar(255)", TypeName: "varchar"},
+		{Name: "age", Type: "integer", TypeName: "int4"},
+		{Name: "nickname", Type: "varchar(255)", TypeName: "varchar", Nullable: true},
+		{Name: "deleted_at", Type: "timestamp", TypeName: "timestamp", Nullable: true},
+	}, nil).Once()
+	s.mockSchema.EXPECT().GetIndexes("users").Return([]driver.Index{
+		{Name: "users_pkey", Columns: []string{"id"}, Primary: true, Unique: true},
+		{Name: "users_name_index", Columns: []string{"name"}},
+		{Name: "users_nickname_index", Columns: []string{"nickname"}},
+	}, nil).Once()
+	s.mockSchema.EXPECT().GetForeignKeys("users").Return(nil, nil).Once()
+	s.mockSchema.EXPECT().HasTable("books").Return(false).Once()
+	s.mockSchema.EXPECT().HasTable("user_profiles").Return(true).Once()
+	s.mockSchema.EXPECT().GetColumns("user_profiles").Return([]driver.Column{
+		{Name: "bio", Type: "text", TypeName: "text"},
+	}, nil).Once()
+	s.mockSchema.EXPECT().GetIndexes("user_profiles").Return(nil, nil).Once()
+	s.mockSchema.EXPECT().GetForeignKeys("user_profiles").Return(nil, nil).Once()
+
+	up, down, err := NewDiffer(s.mockSchema, "postgres", "", true).Diff(tables)
+	s.NoError(err)
+
+	content, err := RenderDiff("20240817214501_diff_models", "", up, down)
+	s.NoError(err)
+	s.Equal(`package migrations
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/facades"
+)
+
+type M20240817214501DiffModels struct{}
+
+// Signature The unique signature for the migration.
+func (r *M20240817214501DiffModels) Signature() string {
+	return "20240817214501_diff_models"
+}
+
+// Up Run the migrations.
+func (r *M20240817214501DiffModels) Up() error {
+	if err := facades.Schema().Table("users", func(table schema.Blueprint) {
+		table.Integer("age").Nullable().Change()
+		table.Boolean("active").Default(true)
+		table.DropColumn("nickname")
+		table.Unique("email").Name("idx_users_email")
+		table.DropIndexByName("users_nickname_index")
+	}); err != nil {
+		return err
+	}
+
+	if err := facades.Schema().Create("books", func(table schema.Blueprint) {
+		table.ID()
+		table.UnsignedBigInteger("user_id")
+		table.Text("title").Comment("The title")
+		table.Decimal("price").Total(10).Places(2)
+		table.Foreign("user_id").References("id").On("users").CascadeOnDelete()
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Down Reverse the migrations.
+func (r *M20240817214501DiffModels) Down() error {
+	if err := facades.Schema().DropIfExists("books"); err != nil {
+		return err
+	}
+
+	if err := facades.Schema().Table("users", func(table schema.Blueprint) {
+		table.Index("nickname").Name("users_nickname_index")
+		table.DropUniqueByName("idx_users_email")
+		table.Column("nickname", "varchar(255)").Nullable()
+		table.DropColumn("active")
+		table.Column("age", "integer").Change()
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+`, content)
+}
+
+func (s *DiffTestSuite) TestDiff_UpToDate() {
+	tables, err := ParseModels(s.dir, gormschema.NamingStrategy{})
+	s.NoError(err)
+
+	s.mockSchema.EXPECT().HasTable("user_profiles").Return(true).Once()
+	s.mockSchema.EXPECT().GetColumns("user_profiles").Return([]driver.Column{
+		{Name: "bio", Type: "varchar(255)", TypeName: "varchar"},
+	}, nil).Once()
+	s.mockSchema.EXPECT().GetIndexes("user_profiles").Return(nil, nil).Once()
+	s.mockSchema.EXPECT().GetForeignKeys("user_profiles").Return(nil, nil).Once()
+
+	up, down, err := NewDiffer(s.mockSchema, "postgres", "", false).Diff(tables[2:])
+	s.NoError(err)
+	s.Empty(up)
+	s.Empty(down)
+}
+
+func (s *DiffTestSuite) TestDiff_Warnings() {
+	tables, err := ParseModels(s.dir, gormschema.NamingStrategy{})
+	s.NoError(err)
+
+	// The unmapped columns aren't dropped without the drop option, and SQLite can't change the columns.
+	s.mockSchema.EXPECT().HasTable("user_profiles").Return(true).Once()
+	s.mockSchema.EXPECT().GetColumns("user_profiles").Return([]driver.Column{
+		{Name: "bio", Type: "varchar(255)", TypeName: "varchar", Nullable: true},
+		{Name: "nickname", Type: "varchar(255)", TypeName: "varchar"},
+	}, nil).Once()
+	s.mockSchema.EXPECT().GetIndexes("user_profiles").Return(nil, nil).Once()
+	s.mockSchema.EXPECT().GetForeignKeys("user_profiles").Return(nil, nil).Once()
+
+	differ := NewDiffer(s.mockSchema, "sqlite", "", false)
+	up, down, err := differ.Diff(tables[2:])
+	s.NoError(err)
+	s.Empty(up)
+	s.Empty(down)
+	s.Equal([]string{
+		"The column user_profiles.bio is changed, but SQLite can't change a column in place, change it manually",
+		"The column user_profiles.nickname isn't defined by the models, it's dropped only with the --drop option",
+	}, differ.Warnings())
+}
+
+func (s *DiffTestSuite) TestDiff_Failed() {
+	tables, err := ParseModels(s.dir, gormschema.NamingStrategy{})
+	s.NoError(err)
+
+	s.mockSchema.EXPECT().HasTable("user_profiles").Return(true).Once()
+	s.mockSchema.EXPECT().GetColumns("user_profiles").Return(nil, assert.AnError).Once()
+
+	_, _, err = NewDiffer(s.mockSchema, "postgres", "", false).Diff(tables[2:])
+	s.Equal(assert.AnError, err)
+}
+
+func TestRenderDiffWithConnection(t *testing.T) {
+	content, err := RenderDiff("20240817214501_diff_models", "postgres", "", "")
+	assert.NoError(t, err)
+	assert.Contains(t, content, `// Connection The database connection that should be used by the migration.
+func (r *M20240817214501DiffModels) Connection() string {
+	return "postgres"
+}`)
+}
+
+func TestColumnFamily(t *testing.T) {
+	assert.Equal(t, "integer", columnFamily("int8", "bigint"))
+	assert.Equal(t, "integer", columnFamily("", "int(10) unsigned"))
+	assert.Equal(t, "string", columnFamily("character varying", "character varying(255)"))
+	assert.Equal(t, "datetime", columnFamily("timestamptz", "timestamp with time zone"))
+	assert.Equal(t, "json", columnFamily("jsonb", "jsonb"))
+	assert.Equal(t, "", columnFamily("geometry", "geometry"))
+}
+
+func columnNames(columns []*ModelColumn) []string {
+	var names []string
+	for _, column := range columns {
+		names = append(names, column.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,338 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"time"
+
+	contractsmigration "github.com/rusmanplatd/goravelframework/contracts/database/migration"
+	contractsschema "github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/str"
+)
+
+const (
+	lockRetryInterval = 500 * time.Millisecond
+	// lockExpiration is the time after which the row of the lock table is regarded as stale, the row is refreshed
+	// periodically by the process holding the lock, so it expires only if the process is killed.
+	lockExpiration = time.Minute
+)
+
+var _ contractsmigration.Lock = (*Lock)(nil)
+
+// Lock is a database-level lock of the migrations, the advisory lock is used for PostgreSQL, MySQL and SQL Server, and
+// a lock table is used for the other drivers.
+type Lock struct {
+	schema  contractsschema.Schema
+	table   string
+	timeout time.Duration
+	// owner is the owner of the row of the lock table while the lock is held, it's empty for the advisory locks.
+	owner string
+}
+
+func NewLock(schema contractsschema.Schema, table string, timeout time.Duration) *Lock {
+	return &Lock{
+		schema:  schema,
+		table:   table,
+		timeout: timeout,
+	}
+}
+
+func (r *Lock) Acquire(wait bool) (func() error, error) {
+	switch r.schema.Orm().Config().Driver {
+	case "postgres":
+		return r.acquirePostgres(wait)
+	case "mysql":
+		return r.acquireMysql(wait)
+	case "sqlserver":
+		return r.acquireSqlserver(wait)
+	default:
+		return r.acquireTable(wait)
+	}
+}
+
+// acquireMysql acquires the lock via GET_LOCK, the lock is bound to the connection, so a dedicated connection is
+// held until the lock is released.
+func (r *Lock) acquireMysql(wait bool) (func() error, error) {
+	ctx := context.Background()
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.ReplaceAll(r.name(), "'", "''")
+	timeout := 0
+	if wait {
+		timeout = int(math.Ceil(r.timeout.Seconds()))
+	}
+
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("select get_lock('%s', %d)", name, timeout)).Scan(&acquired); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if acquired.Int64 != 1 {
+		_ = conn.Close()
+		if !wait {
+			return nil, errors.MigrationLocked
+		}
+
+		return nil, errors.MigrationLockTimeout.Args(r.timeout)
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("select release_lock('%s')", name))
+
+		return err
+	}, nil
+}
+
+// acquirePostgres acquires the lock via pg_try_advisory_lock, the lock is bound to the session, so a dedicated
+// connection is held until the lock is released.
+func (r *Lock) acquirePostgres(wait bool) (func() error, error) {
+	ctx := context.Background()
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(r.name()))
+	key := int64(hash.Sum64())
+
+	if err := r.poll(wait, func() (bool, error) {
+		var acquired bool
+		err := conn.QueryRowContext(ctx, fmt.Sprintf("select pg_try_advisory_lock(%d)", key)).Scan(&acquired)
+
+		return acquired, err
+	}); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("select pg_advisory_unlock(%d)", key))
+
+		return err
+	}, nil
+}
+
+// acquireSqlserver acquires the lock via sp_getapplock, the lock is owned by the session, so a dedicated connection
+// is held until the lock is released.
+func (r *Lock) acquireSqlserver(wait bool) (func() error, error) {
+	ctx := context.Background()
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.ReplaceAll(r.name(), "'", "''")
+	timeout := int64(0)
+	if wait {
+		timeout = r.timeout.Milliseconds()
+	}
+
+	// sp_getapplock returns 0 or 1 if the lock is acquired, -1 if the timeout is reached.
+	var result int
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("declare @result int; exec @result = sp_getapplock @Resource = '%s', @LockMode = 'Exclusive', @LockOwner = 'Session', @LockTimeout = %d; select @result", name, timeout)).Scan(&result); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if result < 0 {
+		_ = conn.Close()
+		if !wait {
+			return nil, errors.MigrationLocked
+		}
+
+		return nil, errors.MigrationLockTimeout.Args(r.timeout)
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("exec sp_releaseapplock @Resource = '%s', @LockOwner = 'Session'", name))
+
+		return err
+	}, nil
+}
+
+// acquireTable acquires the lock by inserting a row into the lock table, the row is deleted when the lock is
+// released. The row is refreshed periodically while the lock is held, it expires after lockExpiration if the process
+// holding the lock is killed.
+func (r *Lock) acquireTable(wait bool) (func() error, error) {
+	if err := r.createTable(); err != nil {
+		return nil, err
+	}
+
+	owner := str.Random(32)
+	if err := r.poll(wait, func() (bool, error) {
+		return r.insert(owner)
+	}); err != nil {
+		return nil, err
+	}
+
+	r.owner = owner
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(lockExpiration / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				_ = r.refresh(owner)
+			}
+		}
+	}()
+
+	return func() error {
+		close(stop)
+		<-done
+		r.owner = ""
+
+		_, err := r.schema.Orm().Query().Table(r.lockTable()).Where("name", r.table).Where("owner", owner).Delete()
+
+		return err
+	}, nil
+}
+
+func (r *Lock) createTable() error {
+	table := r.lockTable()
+	if r.schema.HasTable(table) {
+		return nil
+	}
+
+	if err := r.schema.Create(table, func(table contractsschema.Blueprint) {
+		table.String("name")
+		table.String("owner")
+		table.BigInteger("acquired_at")
+		table.Primary("name")
+	}); err != nil && !r.schema.HasTable(table) {
+		// The table may be created by another process at the same time.
+		return err
+	}
+
+	return nil
+}
+
+// insert tries to insert the row of the lock, the stale row is deleted first. false is returned if the row is held by
+// another process, the insert fails with a duplicate key error in this case.
+func (r *Lock) insert(owner string) (bool, error) {
+	orm := r.schema.Orm()
+	expiredAt := time.Now().Add(-lockExpiration).Unix()
+	if _, err := orm.Query().Table(r.lockTable()).Where("name", r.table).Where("acquired_at < ?", expiredAt).Delete(); err != nil {
+		return false, err
+	}
+
+	if err := orm.Query().Table(r.lockTable()).Create(map[string]any{
+		"name":        r.table,
+		"owner":       owner,
+		"acquired_at": time.Now().Unix(),
+	}); err != nil {
+		exists, existsErr := orm.Query().Table(r.lockTable()).Where("name", r.table).Exists()
+		if existsErr == nil && exists {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Lock) lockTable() string {
+	return r.table + "_lock"
+}
+
+// refresh updates the acquired time of the row of the lock, the row is recreated if it has been dropped.
+func (r *Lock) refresh(owner string) error {
+	result, err := r.schema.Orm().Query().Table(r.lockTable()).Where("name", r.table).Where("owner", owner).Update("acquired_at", time.Now().Unix())
+	if err == nil && result.RowsAffected > 0 {
+		return nil
+	}
+	if err != nil && r.schema.HasTable(r.lockTable()) {
+		return err
+	}
+
+	if err := r.createTable(); err != nil {
+		return err
+	}
+
+	acquired, err := r.insert(owner)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return errors.MigrationLocked
+	}
+
+	return nil
+}
+
+// Refresh recreates the row of the lock table if it has been dropped while the lock is held, eg: by db:wipe, the
+// advisory locks are not affected by dropping tables.
+func (r *Lock) Refresh() error {
+	if r.owner == "" {
+		return nil
+	}
+
+	return r.refresh(r.owner)
+}
+
+func (r *Lock) conn(ctx context.Context) (*sql.Conn, error) {
+	db, err := r.schema.Orm().DB()
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Conn(ctx)
+}
+
+// name gets the name of the lock, the database name is included because the MySQL locks are server-wide.
+func (r *Lock) name() string {
+	orm := r.schema.Orm()
+
+	return fmt.Sprintf("%s.%s%s", orm.DatabaseName(), orm.Config().Prefix, r.table)
+}
+
+// poll calls try until the lock is acquired or the timeout is reached, it doesn't retry if wait is false.
+func (r *Lock) poll(wait bool, try func() (bool, error)) error {
+	deadline := time.Now().Add(r.timeout)
+	for {
+		acquired, err := try()
+		if err != nil {
+			return err
+		}
+		if acquired {
+			return nil
+		}
+		if !wait {
+			return errors.MigrationLocked
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return errors.MigrationLockTimeout.Args(r.timeout)
+		}
+
+		time.Sleep(min(remaining, lockRetryInterval))
+	}
+}
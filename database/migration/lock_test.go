@@ -0,0 +1,245 @@
+package migration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database"
+	"github.com/rusmanplatd/goravelframework/contracts/database/db"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+	mocksschema "github.com/rusmanplatd/goravelframework/mocks/database/schema"
+)
+
+type LockSuite struct {
+	suite.Suite
+	mockOrm    *mocksorm.Orm
+	mockQuery  *mocksorm.Query
+	mockSchema *mocksschema.Schema
+	lock       *Lock
+}
+
+func TestLockSuite(t *testing.T) {
+	suite.Run(t, &LockSuite{})
+}
+
+func (s *LockSuite) SetupTest() {
+	s.mockOrm = mocksorm.NewOrm(s.T())
+	s.mockQuery = mocksorm.NewQuery(s.T())
+	s.mockSchema = mocksschema.NewSchema(s.T())
+	s.lock = NewLock(s.mockSchema, "migrations", time.Second)
+}
+
+func (s *LockSuite) TestAcquire_Table() {
+	tests := []struct {
+		name      string
+		wait      bool
+		setup     func()
+		expectErr error
+	}{
+		{
+			name: "happy path",
+			wait: true,
+			setup: func() {
+				s.mockSchema.EXPECT().HasTable("migrations_lock").Return(true).Once()
+				s.mockDeleteStale(nil)
+				s.mockInsert(nil)
+				s.mockRelease()
+			},
+		},
+		{
+			name: "happy path - create the lock table",
+			wait: true,
+			setup: func() {
+				s.mockSchema.EXPECT().HasTable("migrations_lock").Return(false).Once()
+				s.mockSchema.EXPECT().Create("migrations_lock", mock.Anything).Return(nil).Once()
+				s.mockDeleteStale(nil)
+				s.mockInsert(nil)
+				s.mockRelease()
+			},
+		},
+		{
+			name: "sad path - failed to create the lock table",
+			wait: true,
+			setup: func() {
+				s.mockSchema.EXPECT().HasTable("migrations_lock").Return(false).Twice()
+				s.mockSchema.EXPECT().Create("migrations_lock", mock.Anything).Return(assert.AnError).Once()
+			},
+			expectErr: assert.AnError,
+		},
+		{
+			name: "sad path - failed to delete the stale lock",
+			wait: true,
+			setup: func() {
+				s.mockSchema.EXPECT().HasTable("migrations_lock").Return(true).Once()
+				s.mockDeleteStale(assert.AnError)
+			},
+			expectErr: assert.AnError,
+		},
+		{
+			name: "sad path - failed to insert",
+			wait: true,
+			setup: func() {
+				s.mockSchema.EXPECT().HasTable("migrations_lock").Return(true).Once()
+				s.mockDeleteStale(nil)
+				s.mockInsert(assert.AnError)
+				s.mockExists(false)
+			},
+			expectErr: assert.AnError,
+		},
+		{
+			name: "sad path - locked by another process",
+			wait: false,
+			setup: func() {
+				s.mockSchema.EXPECT().HasTable("migrations_lock").Return(true).Once()
+				s.mockDeleteStale(nil)
+				s.mockInsert(assert.AnError)
+				s.mockExists(true)
+			},
+			expectErr: errors.MigrationLocked,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.mockSchema.EXPECT().Orm().Return(s.mockOrm)
+			s.mockOrm.EXPECT().Config().Return(database.Config{Driver: "sqlite"}).Once()
+			test.setup()
+
+			release, err := s.lock.Acquire(test.wait)
+			if test.expectErr != nil {
+				s.Equal(test.expectErr, err)
+				s.Nil(release)
+				s.Empty(s.lock.owner)
+
+				return
+			}
+
+			s.NoError(err)
+			s.Len(s.lock.owner, 32)
+			s.NoError(release())
+			s.Empty(s.lock.owner)
+		})
+	}
+}
+
+func (s *LockSuite) TestRefresh() {
+	// The lock is not held or it's an advisory lock
+	s.NoError(s.lock.Refresh())
+
+	s.lock.owner = "owner"
+	s.mockSchema.EXPECT().Orm().Return(s.mockOrm)
+
+	// The row of the lock exists
+	s.mockUpdate(1, nil)
+	s.NoError(s.lock.Refresh())
+
+	// The row of the lock is recreated after the lock table is dropped
+	s.mockUpdate(0, assert.AnError)
+	s.mockSchema.EXPECT().HasTable("migrations_lock").Return(false).Twice()
+	s.mockSchema.EXPECT().Create("migrations_lock", mock.Anything).Return(nil).Once()
+	s.mockDeleteStale(nil)
+	s.mockInsert(nil)
+	s.NoError(s.lock.Refresh())
+
+	// The lock is acquired by another process
+	s.mockUpdate(0, nil)
+	s.mockSchema.EXPECT().HasTable("migrations_lock").Return(true).Once()
+	s.mockDeleteStale(nil)
+	s.mockInsert(assert.AnError)
+	s.mockExists(true)
+	s.Equal(errors.MigrationLocked, s.lock.Refresh())
+}
+
+func (s *LockSuite) TestName() {
+	s.mockSchema.EXPECT().Orm().Return(s.mockOrm).Once()
+	s.mockOrm.EXPECT().DatabaseName().Return("goravel").Once()
+	s.mockOrm.EXPECT().Config().Return(database.Config{Prefix: "goravel_"}).Once()
+
+	s.Equal("goravel.goravel_migrations", s.lock.name())
+}
+
+func (s *LockSuite) TestPoll() {
+	s.lock.timeout = 0
+
+	// Acquired directly
+	s.NoError(s.lock.poll(true, func() (bool, error) {
+		return true, nil
+	}))
+
+	// Failed to try
+	s.Equal(assert.AnError, s.lock.poll(true, func() (bool, error) {
+		return false, assert.AnError
+	}))
+
+	// Don't wait
+	times := 0
+	s.Equal(errors.MigrationLocked, s.lock.poll(false, func() (bool, error) {
+		times++
+		return false, nil
+	}))
+	s.Equal(1, times)
+
+	// Timeout
+	s.ErrorIs(s.lock.poll(true, func() (bool, error) {
+		return false, nil
+	}), errors.MigrationLockTimeout)
+
+	// Acquired after retrying
+	s.lock.timeout = time.Second
+	times = 0
+	s.NoError(s.lock.poll(true, func() (bool, error) {
+		times++
+		return times == 2, nil
+	}))
+	s.Equal(2, times)
+}
+
+func (s *LockSuite) mockDeleteStale(err error) {
+	mockQuery := mocksorm.NewQuery(s.T())
+	s.mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Table("migrations_lock").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("name", "migrations").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("acquired_at < ?", mock.AnythingOfType("int64")).Return(mockQuery).Once()
+	mockQuery.EXPECT().Delete().Return(&db.Result{}, err).Once()
+}
+
+func (s *LockSuite) mockExists(exists bool) {
+	mockQuery := mocksorm.NewQuery(s.T())
+	s.mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Table("migrations_lock").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("name", "migrations").Return(mockQuery).Once()
+	mockQuery.EXPECT().Exists().Return(exists, nil).Once()
+}
+
+func (s *LockSuite) mockInsert(err error) {
+	mockQuery := mocksorm.NewQuery(s.T())
+	s.mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Table("migrations_lock").Return(mockQuery).Once()
+	mockQuery.EXPECT().Create(mock.MatchedBy(func(values map[string]any) bool {
+		return values["name"] == "migrations" && values["owner"] != "" && values["acquired_at"].(int64) > 0
+	})).Return(err).Once()
+}
+
+func (s *LockSuite) mockRelease() {
+	mockQuery := mocksorm.NewQuery(s.T())
+	s.mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Table("migrations_lock").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("name", "migrations").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("owner", mock.AnythingOfType("string")).Return(mockQuery).Once()
+	mockQuery.EXPECT().Delete().Return(&db.Result{RowsAffected: 1}, nil).Once()
+}
+
+func (s *LockSuite) mockUpdate(rowsAffected int64, err error) {
+	mockQuery := mocksorm.NewQuery(s.T())
+	s.mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Table("migrations_lock").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("name", "migrations").Return(mockQuery).Once()
+	mockQuery.EXPECT().Where("owner", "owner").Return(mockQuery).Once()
+	mockQuery.EXPECT().Update("acquired_at", mock.AnythingOfType("int64")).Return(&db.Result{RowsAffected: rowsAffected}, err).Once()
+}
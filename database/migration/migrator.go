@@ -2,30 +2,44 @@ package migration
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/rusmanplatd/goravelframework/contracts/console"
 	contractsmigration "github.com/rusmanplatd/goravelframework/contracts/database/migration"
 	"github.com/rusmanplatd/goravelframework/contracts/database/orm"
 	contractsschema "github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	databasedriver "github.com/rusmanplatd/goravelframework/database/driver"
 	"github.com/rusmanplatd/goravelframework/support/collect"
 	"github.com/rusmanplatd/goravelframework/support/color"
 	supportfile "github.com/rusmanplatd/goravelframework/support/file"
 )
 
+var dumpedMigrationsRegexp = regexp.MustCompile(`\('((?:[^']|'')*)', \d+\)`)
+
 type Migrator struct {
-	artisan    console.Artisan
-	creator    *Creator
-	repository contractsmigration.Repository
-	schema     contractsschema.Schema
+	artisan console.Artisan
+	// beginTransaction begins a transaction on the connection that all its ORM and DB queries are run in, it's used
+	// by the pretend mode, see databasedriver.BeginTransaction.
+	beginTransaction func(connection string) (rollback func() error, err error)
+	creator          *Creator
+	lock             contractsmigration.Lock
+	repository       contractsmigration.Repository
+	schema           contractsschema.Schema
 }
 
-func NewMigrator(artisan console.Artisan, schema contractsschema.Schema, table string) *Migrator {
+// NewMigrator creates a migrator, the migrations are run in the lock to avoid being run by multiple processes at
+// the same time, lockTimeout is the max time to wait for the lock.
+func NewMigrator(artisan console.Artisan, schema contractsschema.Schema, table string, lockTimeout time.Duration) *Migrator {
 	return &Migrator{
-		artisan:    artisan,
-		creator:    NewCreator(),
-		repository: NewRepository(schema, table),
-		schema:     schema,
+		artisan:          artisan,
+		beginTransaction: databasedriver.BeginTransaction,
+		creator:          NewCreator(),
+		lock:             NewLock(schema, table, lockTimeout),
+		repository:       NewRepository(schema, table),
+		schema:           schema,
 	}
 }
 
@@ -45,18 +59,139 @@ func (r *Migrator) Create(name string) (string, error) {
 	return fileName, nil
 }
 
-func (r *Migrator) Fresh() error {
-	if err := r.artisan.Call("db:wipe --force"); err != nil {
-		return err
+func (r *Migrator) Fresh(options ...contractsmigration.FreshOptions) error {
+	command := "db:wipe --force"
+	if len(options) > 0 {
+		if options[0].DropViews {
+			command += " --drop-views"
+		}
+		if options[0].DropTypes {
+			command += " --drop-types"
+		}
 	}
-	if err := r.artisan.Call("migrate"); err != nil {
-		return err
+
+	return r.withLock(true, func() error {
+		if err := r.artisan.Call(command); err != nil {
+			return err
+		}
+
+		// The lock table is dropped by db:wipe, it's recreated to keep the lock held.
+		if err := r.lock.Refresh(); err != nil {
+			return err
+		}
+
+		return r.run()
+	})
+}
+
+func (r *Migrator) PretendFresh(options ...contractsmigration.FreshOptions) ([]contractsmigration.Pretended, error) {
+	var option contractsmigration.FreshOptions
+	if len(options) > 0 {
+		option = options[0]
 	}
 
-	return nil
+	var pretended []contractsmigration.Pretended
+	// The wipe and the migrations are pretended in the same pretend mode, so the dropped tables are treated as dropped
+	// by the migrations, eg: HasTable returns false, see schema.Schema.Pretend.
+	_, err := r.schema.Pretend(func() error {
+		// The same as db:wipe.
+		sqls, err := r.pretend(func() error {
+			if option.DropViews {
+				if err := r.schema.DropAllViews(); err != nil {
+					return err
+				}
+			}
+			if err := r.schema.DropAllTables(); err != nil {
+				return err
+			}
+			if option.DropTypes {
+				if err := r.schema.DropAllTypes(); err != nil {
+					return err
+				}
+			}
+
+			return r.schema.Prune()
+		})
+		if err != nil {
+			return err
+		}
+
+		// The migration table is dropped, so the migrations are run on an empty database, see prepareDatabase.
+		migrations, err := r.pretendPending(nil, false)
+		if err != nil {
+			return err
+		}
+
+		pretended = append([]contractsmigration.Pretended{{Migration: "db:wipe", Sqls: sqls}}, migrations...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pretended, nil
+}
+
+func (r *Migrator) PretendRollback(step, batch int) ([]contractsmigration.Pretended, error) {
+	if !r.repository.RepositoryExists() {
+		color.Warningln("Migration table not found")
+
+		return nil, nil
+	}
+
+	files, err := r.getFilesForRollback(step, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []contractsschema.Migration
+	for _, file := range files {
+		migration := r.getMigrationViaFile(file)
+		if migration == nil {
+			color.Warningf("Migration not found: %s\n", file.Migration)
+
+			continue
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	return r.pretendMigrations(migrations, false)
+}
+
+func (r *Migrator) PretendRun() ([]contractsmigration.Pretended, error) {
+	if !r.repository.RepositoryExists() {
+		return r.pretendPending(nil, false)
+	}
+
+	ran, err := r.repository.GetRan()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.pretendPending(ran, true)
 }
 
 func (r *Migrator) Reset() error {
+	return r.withLock(true, r.reset)
+}
+
+func (r *Migrator) Rollback(step, batch int) error {
+	return r.withLock(true, func() error {
+		return r.rollback(step, batch)
+	})
+}
+
+func (r *Migrator) Run() error {
+	return r.withLock(true, r.run)
+}
+
+func (r *Migrator) RunIsolated() error {
+	return r.withLock(false, r.run)
+}
+
+func (r *Migrator) reset() error {
 	if !r.repository.RepositoryExists() {
 		color.Warningln("Migration table not found")
 
@@ -68,10 +203,10 @@ func (r *Migrator) Reset() error {
 		return err
 	}
 
-	return r.Rollback(len(ran), 0)
+	return r.rollback(len(ran), 0)
 }
 
-func (r *Migrator) Rollback(step, batch int) error {
+func (r *Migrator) rollback(step, batch int) error {
 	if !r.repository.RepositoryExists() {
 		color.Warningln("Migration table not found")
 
@@ -108,7 +243,7 @@ func (r *Migrator) Rollback(step, batch int) error {
 	return nil
 }
 
-func (r *Migrator) Run() error {
+func (r *Migrator) run() error {
 	if err := r.prepareDatabase(); err != nil {
 		return err
 	}
@@ -123,6 +258,23 @@ func (r *Migrator) Run() error {
 	return r.runPending(pendingMigrations)
 }
 
+// withLock runs the callback in the migration lock, waits for the lock if wait is true, otherwise returns
+// errors.MigrationLocked directly if the lock is held by another process.
+func (r *Migrator) withLock(wait bool, callback func() error) (err error) {
+	release, err := r.lock.Acquire(wait)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if releaseErr := release(); releaseErr != nil && err == nil {
+			err = releaseErr
+		}
+	}()
+
+	return callback()
+}
+
 func (r *Migrator) Status() ([]contractsmigration.Status, error) {
 	if !r.repository.RepositoryExists() {
 		color.Warningln("Migration table not found")
@@ -212,9 +364,122 @@ func (r *Migrator) prepareDatabase() error {
 		return nil
 	}
 
+	// Load the schema dump first when migrating an empty database, the migration table is included in the dump,
+	// so only the migrations created after the dump will be run.
+	path, err := r.schemaDumpPath()
+	if err != nil {
+		return err
+	}
+	if path != "" {
+		color.Infoln("Loading stored database schema:", path)
+
+		return r.schema.Load(path)
+	}
+
 	return r.repository.CreateRepository()
 }
 
+// pretend records the SQL of the schema changes made in the callback, the other queries of the connection are run in
+// a transaction that is always rolled back, so nothing is written to the database.
+func (r *Migrator) pretend(callback func() error) (sqls []string, err error) {
+	rollback, err := r.beginTransaction(r.schema.GetConnection())
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if rollbackErr := rollback(); rollbackErr != nil && err == nil {
+			sqls, err = nil, rollbackErr
+		}
+	}()
+
+	return r.schema.Pretend(callback)
+}
+
+// pretendPending gets the SQL of the pending migrations, the schema dump is pretended to be loaded first if the
+// migration table doesn't exist, the same as prepareDatabase, and the migrations in the dump are skipped.
+func (r *Migrator) pretendPending(ran []string, repositoryExists bool) ([]contractsmigration.Pretended, error) {
+	var pretended []contractsmigration.Pretended
+	if !repositoryExists {
+		path, err := r.schemaDumpPath()
+		if err != nil {
+			return nil, err
+		}
+		if path != "" {
+			sqls, err := r.pretend(func() error {
+				return r.schema.Load(path)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			pretended = append(pretended, contractsmigration.Pretended{Migration: path, Sqls: sqls})
+			ran = dumpedMigrations(sqls)
+		}
+	}
+
+	migrations, err := r.pretendMigrations(r.pendingMigrations(ran), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(pretended, migrations...), nil
+}
+
+func (r *Migrator) pretendMigrations(migrations []contractsschema.Migration, up bool) ([]contractsmigration.Pretended, error) {
+	var pretended []contractsmigration.Pretended
+	for _, migration := range migrations {
+		sqls, err := r.pretendMigration(migration, up)
+		if err != nil {
+			return nil, err
+		}
+
+		pretended = append(pretended, contractsmigration.Pretended{
+			Migration: migration.Signature(),
+			Sqls:      sqls,
+		})
+	}
+
+	return pretended, nil
+}
+
+func (r *Migrator) pretendMigration(migration contractsschema.Migration, up bool) ([]string, error) {
+	defaultConnection := r.schema.GetConnection()
+	if connectionMigration, ok := migration.(contractsschema.Connection); ok {
+		r.schema.SetConnection(connectionMigration.Connection())
+	}
+
+	defer r.schema.SetConnection(defaultConnection)
+
+	if up {
+		return r.pretend(migration.Up)
+	}
+
+	return r.pretend(migration.Down)
+}
+
+// schemaDumpPath gets the path of the schema dump of the connection, it's empty if the dump doesn't exist or the
+// database has tables, the dump is only loaded to an empty database.
+func (r *Migrator) schemaDumpPath() (string, error) {
+	path := SchemaDumpPath(r.schema.GetConnection())
+	if !supportfile.Exists(path) {
+		return "", nil
+	}
+
+	tables, err := r.schema.GetTables()
+	if err != nil {
+		return "", err
+	}
+	for _, table := range tables {
+		// The internal tables of SQLite are ignored.
+		if !strings.HasPrefix(table.Name, "sqlite_") {
+			return "", nil
+		}
+	}
+
+	return path, nil
+}
+
 func (r *Migrator) printTitle(maxNameLength int) {
 	color.Default().Print(fmt.Sprintf("%-*s", maxNameLength, "Migration name"))
 	color.Default().Println(" | Batch / Status")
@@ -306,3 +571,18 @@ func (r *Migrator) runUp(migration contractsschema.Migration, batch int) error {
 
 	return r.repository.Log(migration.Signature(), batch)
 }
+
+// dumpedMigrations gets the migrations recorded in the schema dump, they are inserted by the last statement of the
+// dump, see schema.Dump.
+func dumpedMigrations(statements []string) []string {
+	if len(statements) == 0 || !strings.HasPrefix(strings.ToLower(statements[len(statements)-1]), "insert into") {
+		return nil
+	}
+
+	var migrations []string
+	for _, match := range dumpedMigrationsRegexp.FindAllStringSubmatch(statements[len(statements)-1], -1) {
+		migrations = append(migrations, strings.ReplaceAll(match[1], "''", "'"))
+	}
+
+	return migrations
+}
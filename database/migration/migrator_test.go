@@ -1,7 +1,6 @@
 package migration
 
 import (
-	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -11,9 +10,11 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
 	"github.com/rusmanplatd/goravelframework/contracts/database/migration"
 	"github.com/rusmanplatd/goravelframework/contracts/database/orm"
 	contractsschema "github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/errors"
 	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
 	mocksmigration "github.com/rusmanplatd/goravelframework/mocks/database/migration"
 	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
@@ -25,7 +26,10 @@ import (
 
 type MigratorSuite struct {
 	suite.Suite
+	// transactions records the connections that the pretend transactions are begun on and rolled back.
+	transactions   []string
 	mockArtisan    *mocksconsole.Artisan
+	mockLock       *mocksmigration.Lock
 	mockRepository *mocksmigration.Repository
 	mockSchema     *mocksschema.Schema
 	migrator       *Migrator
@@ -37,12 +41,24 @@ func TestMigratorSuite(t *testing.T) {
 
 func (s *MigratorSuite) SetupTest() {
 	s.mockArtisan = mocksconsole.NewArtisan(s.T())
+	s.mockLock = mocksmigration.NewLock(s.T())
 	s.mockRepository = mocksmigration.NewRepository(s.T())
 	s.mockSchema = mocksschema.NewSchema(s.T())
 
+	s.transactions = nil
 	s.migrator = &Migrator{
-		artisan:    s.mockArtisan,
+		artisan: s.mockArtisan,
+		beginTransaction: func(connection string) (func() error, error) {
+			s.transactions = append(s.transactions, "begin "+connection)
+
+			return func() error {
+				s.transactions = append(s.transactions, "rollback "+connection)
+
+				return nil
+			}, nil
+		},
 		creator:    NewCreator(),
+		lock:       s.mockLock,
 		repository: s.mockRepository,
 		schema:     s.mockSchema,
 	}
@@ -73,21 +89,43 @@ func (s *MigratorSuite) TestCreate() {
 
 func (s *MigratorSuite) TestFresh() {
 	// Success
+	released := false
+	s.mockLock.EXPECT().Acquire(true).Return(func() error {
+		released = true
+		return nil
+	}, nil).Once()
 	s.mockArtisan.EXPECT().Call("db:wipe --force").Return(nil).Once()
-	s.mockArtisan.EXPECT().Call("migrate").Return(nil).Once()
+	s.mockLock.EXPECT().Refresh().Return(nil).Once()
+	s.mockRepository.EXPECT().RepositoryExists().Return(true).Once()
+	s.mockRepository.EXPECT().GetRan().Return(nil, nil).Once()
+	s.mockSchema.EXPECT().Migrations().Return(nil).Once()
 
 	s.NoError(s.migrator.Fresh())
+	s.True(released)
 
 	// db:wipe returns error
+	s.mockLock.EXPECT().Acquire(true).Return(func() error { return nil }, nil).Once()
 	s.mockArtisan.EXPECT().Call("db:wipe --force").Return(assert.AnError).Once()
 
 	s.EqualError(s.migrator.Fresh(), assert.AnError.Error())
 
-	// migrate returns error
+	// Refresh returns error
+	s.mockLock.EXPECT().Acquire(true).Return(func() error { return nil }, nil).Once()
 	s.mockArtisan.EXPECT().Call("db:wipe --force").Return(nil).Once()
-	s.mockArtisan.EXPECT().Call("migrate").Return(assert.AnError).Once()
+	s.mockLock.EXPECT().Refresh().Return(errors.MigrationLocked).Once()
 
-	s.EqualError(s.migrator.Fresh(), assert.AnError.Error())
+	s.Equal(errors.MigrationLocked, s.migrator.Fresh())
+
+	// Drop the views and types
+	s.mockLock.EXPECT().Acquire(true).Return(func() error { return nil }, nil).Once()
+	s.mockArtisan.EXPECT().Call("db:wipe --force --drop-views --drop-types").Return(assert.AnError).Once()
+
+	s.EqualError(s.migrator.Fresh(migration.FreshOptions{DropTypes: true, DropViews: true}), assert.AnError.Error())
+
+	// Failed to acquire the lock
+	s.mockLock.EXPECT().Acquire(true).Return(nil, errors.MigrationLockTimeout).Once()
+
+	s.Equal(errors.MigrationLockTimeout, s.migrator.Fresh())
 }
 
 func (s *MigratorSuite) TestGetFilesForRollback() {
@@ -197,8 +235,222 @@ func (s *MigratorSuite) TestPrepareDatabase() {
 	s.NoError(s.migrator.prepareDatabase())
 
 	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Once()
 	s.mockRepository.EXPECT().CreateRepository().Return(nil).Once()
 	s.NoError(s.migrator.prepareDatabase())
+
+	// Load the schema dump
+	path := SchemaDumpPath("postgres")
+	s.NoError(file.PutContent(path, "create table users (id int);"))
+	defer func() {
+		s.NoError(file.Remove("database"))
+	}()
+
+	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Once()
+	s.mockSchema.EXPECT().GetTables().Return([]driver.Table{{Name: "sqlite_sequence"}}, nil).Once()
+	s.mockSchema.EXPECT().Load(path).Return(nil).Once()
+	s.NoError(s.migrator.prepareDatabase())
+
+	// The schema dump is not loaded if the database has tables
+	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Once()
+	s.mockSchema.EXPECT().GetTables().Return([]driver.Table{{Name: "users"}}, nil).Once()
+	s.mockRepository.EXPECT().CreateRepository().Return(nil).Once()
+	s.NoError(s.migrator.prepareDatabase())
+
+	// GetTables returns error
+	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Once()
+	s.mockSchema.EXPECT().GetTables().Return(nil, assert.AnError).Once()
+	s.Equal(assert.AnError, s.migrator.prepareDatabase())
+}
+
+func (s *MigratorSuite) TestPretendFresh() {
+	testMigration := NewTestMigration(s.mockSchema)
+
+	s.mockSchema.EXPECT().GetConnection().Return("postgres")
+	// The wipe and the migrations are pretended in the same pretend mode.
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		return nil, callback()
+	}).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		s.mockSchema.EXPECT().DropAllViews().Return(nil).Once()
+		s.mockSchema.EXPECT().DropAllTables().Return(nil).Once()
+		s.mockSchema.EXPECT().DropAllTypes().Return(nil).Once()
+		s.mockSchema.EXPECT().Prune().Return(nil).Once()
+
+		return []string{"drop view \"active_users\"", "drop table \"users\"", "drop type \"status\""}, callback()
+	}).Once()
+	s.mockSchema.EXPECT().Migrations().Return([]contractsschema.Migration{testMigration}).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).Return([]string{"create table \"users\""}, nil).Once()
+	s.mockSchema.EXPECT().SetConnection("postgres").Once()
+
+	pretended, err := s.migrator.PretendFresh(migration.FreshOptions{DropTypes: true, DropViews: true})
+	s.NoError(err)
+	s.Equal([]migration.Pretended{
+		{Migration: "db:wipe", Sqls: []string{"drop view \"active_users\"", "drop table \"users\"", "drop type \"status\""}},
+		{Migration: testMigration.Signature(), Sqls: []string{"create table \"users\""}},
+	}, pretended)
+	s.Equal([]string{"begin postgres", "rollback postgres", "begin postgres", "rollback postgres"}, s.transactions)
+
+	// The schema dump is loaded after wiping the database
+	path := SchemaDumpPath("postgres")
+	s.NoError(file.PutContent(path, "create table users (id int);"))
+	defer func() {
+		s.NoError(file.Remove("database"))
+	}()
+
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		return nil, callback()
+	}).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).Return([]string{"drop table \"users\""}, nil).Once()
+	// The tables are treated as dropped after the pretended wipe.
+	s.mockSchema.EXPECT().GetTables().Return(nil, nil).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		s.mockSchema.EXPECT().Load(path).Return(nil).Once()
+
+		return []string{"create table users (id int)", `insert into "migrations" ("migration", "batch") values
+  ('` + testMigration.Signature() + `', 1)`}, callback()
+	}).Once()
+	s.mockSchema.EXPECT().Migrations().Return([]contractsschema.Migration{testMigration}).Once()
+
+	pretended, err = s.migrator.PretendFresh()
+	s.NoError(err)
+	s.Equal([]migration.Pretended{
+		{Migration: "db:wipe", Sqls: []string{"drop table \"users\""}},
+		{Migration: path, Sqls: []string{"create table users (id int)", `insert into "migrations" ("migration", "batch") values
+  ('` + testMigration.Signature() + `', 1)`}},
+	}, pretended)
+
+	// wipe returns error
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		return nil, callback()
+	}).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).Return(nil, assert.AnError).Once()
+
+	pretended, err = s.migrator.PretendFresh()
+	s.Equal(assert.AnError, err)
+	s.Nil(pretended)
+
+	// Failed to begin the transaction
+	s.migrator.beginTransaction = func(connection string) (func() error, error) {
+		return nil, assert.AnError
+	}
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		return nil, callback()
+	}).Once()
+
+	pretended, err = s.migrator.PretendFresh()
+	s.Equal(assert.AnError, err)
+	s.Nil(pretended)
+}
+
+func (s *MigratorSuite) TestPretendRollback() {
+	testMigration := NewTestMigration(s.mockSchema)
+	testConnectionMigration := NewTestConnectionMigration(s.mockSchema)
+
+	// Migration table not found
+	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+
+	pretended, err := s.migrator.PretendRollback(1, 0)
+	s.NoError(err)
+	s.Nil(pretended)
+
+	// Happy path
+	s.mockRepository.EXPECT().RepositoryExists().Return(true).Once()
+	s.mockRepository.EXPECT().GetMigrationsByStep(2).Return([]migration.File{
+		{Migration: testConnectionMigration.Signature()},
+		{Migration: testMigration.Signature()},
+	}, nil).Once()
+	s.mockSchema.EXPECT().Migrations().Return([]contractsschema.Migration{testMigration, testConnectionMigration}).Twice()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Once()
+	s.mockSchema.EXPECT().GetConnection().Return("sqlite").Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Twice()
+	s.mockSchema.EXPECT().SetConnection("sqlite").Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).Return([]string{"drop table if exists \"agents\""}, nil).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).Return([]string{"drop table if exists \"users\""}, nil).Once()
+	s.mockSchema.EXPECT().SetConnection("postgres").Twice()
+
+	pretended, err = s.migrator.PretendRollback(2, 0)
+	s.NoError(err)
+	s.Equal([]migration.Pretended{
+		{Migration: testConnectionMigration.Signature(), Sqls: []string{"drop table if exists \"agents\""}},
+		{Migration: testMigration.Signature(), Sqls: []string{"drop table if exists \"users\""}},
+	}, pretended)
+	s.Equal([]string{"begin sqlite", "rollback sqlite", "begin postgres", "rollback postgres"}, s.transactions)
+}
+
+func (s *MigratorSuite) TestPretendRun() {
+	testMigration := NewTestMigration(s.mockSchema)
+	testConnectionMigration := NewTestConnectionMigration(s.mockSchema)
+
+	// The migration table doesn't exist, all the migrations are pending and the table is not created.
+	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Times(3)
+	s.mockSchema.EXPECT().Migrations().Return([]contractsschema.Migration{testMigration}).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		s.mockSchema.EXPECT().Create("users", mock.Anything).Return(nil).Once()
+
+		return []string{"create table \"users\""}, callback()
+	}).Once()
+	s.mockSchema.EXPECT().SetConnection("postgres").Once()
+
+	pretended, err := s.migrator.PretendRun()
+	s.NoError(err)
+	s.Equal([]migration.Pretended{
+		{Migration: testMigration.Signature(), Sqls: []string{"create table \"users\""}},
+	}, pretended)
+	s.Equal([]string{"begin postgres", "rollback postgres"}, s.transactions)
+
+	// The schema dump is loaded first if the migration table doesn't exist, the migrations in it are skipped.
+	path := SchemaDumpPath("postgres")
+	s.NoError(file.PutContent(path, "create table users (id int);"))
+
+	s.mockRepository.EXPECT().RepositoryExists().Return(false).Once()
+	s.mockSchema.EXPECT().GetConnection().Return("postgres").Twice()
+	s.mockSchema.EXPECT().GetTables().Return(nil, nil).Once()
+	s.mockSchema.EXPECT().Pretend(mock.Anything).RunAndReturn(func(callback func() error) ([]string, error) {
+		s.mockSchema.EXPECT().Load(path).Return(nil).Once()
+
+		return []string{"create table users (id int)", "insert into `migrations` (`migration`, `batch`) values\n  ('" + testMigration.Signature() + "', 1)"}, callback()
+	}).Once()
+	s.mockSchema.EXPECT().Migrations().Return([]contractsschema.Migration{testMigration}).Once()
+
+	pretended, err = s.migrator.PretendRun()
+	s.NoError(err)
+	s.Equal([]migration.Pretended{
+		{Migration: path, Sqls: []string{"create table users (id int)", "insert into `migrations` (`migration`, `batch`) values\n  ('" + testMigration.Signature() + "', 1)"}},
+	}, pretended)
+	s.NoError(file.Remove("database"))
+
+	// The ran migrations are skipped
+	s.mockRepository.EXPECT().RepositoryExists().Return(true).Once()
+	s.mockRepository.EXPECT().GetRan().Return([]string{testMigration.Signature(), testConnectionMigration.Signature()}, nil).Once()
+	s.mockSchema.EXPECT().Migrations().Return([]contractsschema.Migration{testMigration, testConnectionMigration}).Once()
+
+	pretended, err = s.migrator.PretendRun()
+	s.NoError(err)
+	s.Nil(pretended)
+
+	// GetRan returns error
+	s.mockRepository.EXPECT().RepositoryExists().Return(true).Once()
+	s.mockRepository.EXPECT().GetRan().Return(nil, assert.AnError).Once()
+
+	pretended, err = s.migrator.PretendRun()
+	s.Equal(assert.AnError, err)
+	s.Nil(pretended)
+}
+
+func TestDumpedMigrations(t *testing.T) {
+	assert.Nil(t, dumpedMigrations(nil))
+	assert.Nil(t, dumpedMigrations([]string{"create table users (id int)"}))
+	assert.Equal(t, []string{"20240817214501_create_users_table", "20240817214502_create_user's_table"}, dumpedMigrations([]string{
+		"create table users (id int)",
+		`insert into "migrations" ("migration", "batch") values
+  ('20240817214501_create_users_table', 1),
+  ('20240817214502_create_user''s_table', 2)`,
+	}))
 }
 
 func (s *MigratorSuite) TestPrintTitle() {
@@ -249,6 +501,7 @@ func (s *MigratorSuite) TestReset() {
 
 	for _, test := range tests {
 		s.Run(test.name, func() {
+			s.mockLock.EXPECT().Acquire(true).Return(func() error { return nil }, nil).Once()
 			test.setup()
 
 			err := s.migrator.Reset()
@@ -335,6 +588,7 @@ func (s *MigratorSuite) TestRollback() {
 
 	for _, test := range tests {
 		s.Run(test.name, func() {
+			s.mockLock.EXPECT().Acquire(true).Return(func() error { return nil }, nil).Once()
 			test.setup()
 
 			err := s.migrator.Rollback(1, 0)
@@ -417,6 +671,7 @@ func (s *MigratorSuite) TestRun() {
 
 	for _, test := range tests {
 		s.Run(test.name, func() {
+			s.mockLock.EXPECT().Acquire(true).Return(func() error { return nil }, nil).Once()
 			test.setup()
 
 			err := s.migrator.Run()
@@ -429,6 +684,46 @@ func (s *MigratorSuite) TestRun() {
 	}
 }
 
+func (s *MigratorSuite) TestRunIsolated() {
+	// Locked by another process
+	s.mockLock.EXPECT().Acquire(false).Return(nil, errors.MigrationLocked).Once()
+
+	s.Equal(errors.MigrationLocked, s.migrator.RunIsolated())
+
+	// Success
+	released := false
+	s.mockLock.EXPECT().Acquire(false).Return(func() error {
+		released = true
+		return nil
+	}, nil).Once()
+	s.mockRepository.EXPECT().RepositoryExists().Return(true).Once()
+	s.mockRepository.EXPECT().GetRan().Return(nil, nil).Once()
+	s.mockSchema.EXPECT().Migrations().Return(nil).Once()
+
+	s.NoError(s.migrator.RunIsolated())
+	s.True(released)
+}
+
+func (s *MigratorSuite) TestWithLock() {
+	// Failed to acquire the lock
+	s.mockLock.EXPECT().Acquire(true).Return(nil, errors.MigrationLockTimeout).Once()
+
+	s.Equal(errors.MigrationLockTimeout, s.migrator.withLock(true, func() error {
+		s.Fail("the callback should not be called")
+		return nil
+	}))
+
+	// The callback error is returned prior to the release error
+	s.mockLock.EXPECT().Acquire(true).Return(func() error { return io.EOF }, nil).Once()
+
+	s.Equal(assert.AnError, s.migrator.withLock(true, func() error { return assert.AnError }))
+
+	// The release error is returned
+	s.mockLock.EXPECT().Acquire(true).Return(func() error { return io.EOF }, nil).Once()
+
+	s.Equal(io.EOF, s.migrator.withLock(true, func() error { return nil }))
+}
+
 func (s *MigratorSuite) TestRunDown() {
 	var (
 		previousConnection      = "postgres"
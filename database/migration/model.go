@@ -0,0 +1,608 @@
+package migration
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	gormschema "gorm.io/gorm/schema"
+)
+
+// ModelTable is the table structure that an ORM model expects.
+type ModelTable struct {
+	Model       string
+	Name        string
+	Columns     []*ModelColumn
+	Indexes     []*ModelIndex
+	ForeignKeys []*ModelForeignKey
+}
+
+type ModelColumn struct {
+	Name string
+	// Method is the Blueprint method that creates the column, eg: String.
+	Method string
+	// Args are the extra arguments of the Blueprint method, eg: the length of String.
+	Args []string
+	// Modifiers are the extra modifiers of the column, eg: Total(10).
+	Modifiers []string
+	// Family is the type family used to compare the column with the database, eg: string, integer.
+	Family   string
+	Default  string
+	Comment  string
+	Nullable bool
+	Primary  bool
+}
+
+type ModelIndex struct {
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+type ModelForeignKey struct {
+	Columns    []string
+	On         string
+	References []string
+	OnDelete   string
+	OnUpdate   string
+}
+
+type modelParser struct {
+	naming     gormschema.NamingStrategy
+	structs    map[string]*ast.StructType
+	types      map[string]ast.Expr
+	tableNames map[string]string
+	tables     map[string]*ModelTable
+}
+
+// ParseModels parses the ORM models in the given directory, a struct is treated as a model if it embeds orm.Model,
+// defines the TableName method or has an ID field.
+func ParseModels(dir string, naming gormschema.NamingStrategy) ([]*ModelTable, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &modelParser{
+		naming:     naming,
+		structs:    make(map[string]*ast.StructType),
+		types:      make(map[string]ast.Expr),
+		tableNames: make(map[string]string),
+		tables:     make(map[string]*ModelTable),
+	}
+
+	var names []string
+	fileSet := token.NewFileSet()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, entry.Name()), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						p.structs[typeSpec.Name.Name] = structType
+						names = append(names, typeSpec.Name.Name)
+					} else {
+						p.types[typeSpec.Name.Name] = typeSpec.Type
+					}
+				}
+			case *ast.FuncDecl:
+				if receiver, table, ok := parseTableNameMethod(decl); ok {
+					p.tableNames[receiver] = table
+				}
+			}
+		}
+	}
+
+	var models []string
+	for _, name := range names {
+		if ast.IsExported(name) && p.isModel(name) {
+			models = append(models, name)
+			p.tables[name] = &ModelTable{Model: name, Name: p.tableName(name)}
+		}
+	}
+
+	var tables []*ModelTable
+	for _, name := range models {
+		p.parseFields(p.tables[name], name, p.structs[name], "")
+		tables = append(tables, p.tables[name])
+	}
+
+	return tables, nil
+}
+
+func (r *modelParser) isModel(name string) bool {
+	if _, ok := r.tableNames[name]; ok {
+		return true
+	}
+
+	for _, field := range r.structs[name].Fields.List {
+		if len(field.Names) == 0 && selectorName(field.Type) == "orm.Model" {
+			return true
+		}
+		for _, fieldName := range field.Names {
+			if fieldName.Name == "ID" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r *modelParser) tableName(model string) string {
+	if table, ok := r.tableNames[model]; ok {
+		return table
+	}
+
+	// The prefix is added by the schema, so it's removed here.
+	return strings.TrimPrefix(r.naming.TableName(model), r.naming.TablePrefix)
+}
+
+func (r *modelParser) parseFields(table *ModelTable, model string, structType *ast.StructType, prefix string) {
+	for _, field := range structType.Fields.List {
+		settings := parseGormTag(field.Tag)
+		if _, ok := settings["-"]; ok {
+			continue
+		}
+
+		if len(field.Names) == 0 {
+			r.parseEmbedded(table, model, field.Type, prefix)
+			continue
+		}
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			if _, ok := settings["EMBEDDED"]; ok {
+				if embedded, ok := r.structs[identName(field.Type)]; ok {
+					r.parseFields(table, model, embedded, prefix+settings["EMBEDDEDPREFIX"])
+				}
+				continue
+			}
+
+			if relation := r.relation(field.Type); relation != "" {
+				r.parseRelation(table, model, structType, name.Name, relation, settings)
+				continue
+			}
+
+			column := r.column(field.Type)
+			if column == nil {
+				continue
+			}
+
+			column.Name = prefix + r.naming.ColumnName("", name.Name)
+			if value, ok := settings["COLUMN"]; ok {
+				column.Name = prefix + value
+			}
+			if name.Name == "ID" && column.Family == "integer" {
+				column.Primary = true
+			}
+
+			r.applySettings(table, column, settings)
+			table.Columns = append(table.Columns, column)
+		}
+	}
+}
+
+func (r *modelParser) parseEmbedded(table *ModelTable, model string, expr ast.Expr, prefix string) {
+	switch selectorName(expr) {
+	case "orm.Model":
+		table.Columns = append(table.Columns, &ModelColumn{Name: "id", Method: "ID", Family: "integer", Primary: true})
+		r.parseEmbedded(table, model, &ast.SelectorExpr{X: ast.NewIdent("orm"), Sel: ast.NewIdent("Timestamps")}, prefix)
+	case "orm.Timestamps":
+		table.Columns = append(table.Columns,
+			&ModelColumn{Name: "created_at", Method: "Timestamp", Family: "datetime", Nullable: true},
+			&ModelColumn{Name: "updated_at", Method: "Timestamp", Family: "datetime", Nullable: true},
+		)
+	case "orm.SoftDeletes":
+		table.Columns = append(table.Columns, &ModelColumn{Name: "deleted_at", Method: "SoftDeletes", Family: "datetime", Nullable: true})
+	default:
+		if embedded, ok := r.structs[identName(expr)]; ok {
+			r.parseFields(table, model, embedded, prefix)
+		}
+	}
+}
+
+// parseRelation parses the foreign key of a relationship, the foreign keys are only created for the relationships
+// with the constraint tag, the same as the ORM, because the foreign key constraints are disabled when migrating.
+func (r *modelParser) parseRelation(table *ModelTable, model string, structType *ast.StructType, field, relation string, settings map[string]string) {
+	constraint, ok := settings["CONSTRAINT"]
+	if !ok {
+		return
+	}
+
+	foreignField := settings["FOREIGNKEY"]
+	onUpdate, onDelete := parseConstraint(constraint)
+
+	// Belongs to: the foreign key is defined in the current model.
+	if foreignField == "" && hasField(structType, field+"ID") {
+		foreignField = field + "ID"
+	}
+	if foreignField != "" && hasField(structType, foreignField) {
+		references := "id"
+		if value, ok := settings["REFERENCES"]; ok {
+			references = r.naming.ColumnName("", value)
+		}
+
+		table.ForeignKeys = append(table.ForeignKeys, &ModelForeignKey{
+			Columns:    []string{r.naming.ColumnName("", foreignField)},
+			On:         r.tables[relation].Name,
+			References: []string{references},
+			OnDelete:   onDelete,
+			OnUpdate:   onUpdate,
+		})
+
+		return
+	}
+
+	// Has one and has many: the foreign key is defined in the related model.
+	if foreignField == "" {
+		foreignField = model + "ID"
+	}
+	related, ok := r.tables[relation]
+	if !ok || !hasField(r.structs[relation], foreignField) {
+		return
+	}
+
+	references := "id"
+	if value, ok := settings["REFERENCES"]; ok {
+		references = r.naming.ColumnName("", value)
+	}
+
+	related.ForeignKeys = append(related.ForeignKeys, &ModelForeignKey{
+		Columns:    []string{r.naming.ColumnName("", foreignField)},
+		On:         table.Name,
+		References: []string{references},
+		OnDelete:   onDelete,
+		OnUpdate:   onUpdate,
+	})
+}
+
+// relation returns the related model of the field type, it's empty if the field is not a relationship.
+func (r *modelParser) relation(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return r.relation(expr.X)
+	case *ast.ArrayType:
+		return r.relation(expr.Elt)
+	case *ast.Ident:
+		if _, ok := r.tables[expr.Name]; ok {
+			return expr.Name
+		}
+	}
+
+	return ""
+}
+
+func (r *modelParser) column(expr ast.Expr) *ModelColumn {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		column := r.column(expr.X)
+		if column != nil {
+			column.Nullable = true
+		}
+
+		return column
+	case *ast.Ident:
+		if column := identColumn(expr.Name); column != nil {
+			return column
+		}
+		if underlying, ok := r.types[expr.Name]; ok {
+			return r.column(underlying)
+		}
+	case *ast.SelectorExpr:
+		return selectorColumn(selectorName(expr))
+	case *ast.IndexExpr:
+		if column := selectorColumn(selectorName(expr.X)); column != nil {
+			return column
+		}
+	case *ast.ArrayType:
+		if identName(expr.Elt) == "byte" {
+			return &ModelColumn{Method: "Column", Args: []string{strconv.Quote("binary")}, Family: "binary"}
+		}
+
+		return &ModelColumn{Method: "Json", Family: "json"}
+	case *ast.MapType:
+		return &ModelColumn{Method: "Json", Family: "json"}
+	}
+
+	return nil
+}
+
+func (r *modelParser) applySettings(table *ModelTable, column *ModelColumn, settings map[string]string) {
+	if value, ok := settings["TYPE"]; ok {
+		if typed := typeColumn(value); typed != nil {
+			column.Method, column.Args, column.Modifiers, column.Family = typed.Method, typed.Args, typed.Modifiers, typed.Family
+		}
+	}
+	if value, ok := settings["SIZE"]; ok && (column.Method == "String" || column.Method == "Char") {
+		column.Args = []string{value}
+	}
+	if _, ok := settings["PRIMARYKEY"]; ok {
+		column.Primary = true
+	}
+	if _, ok := settings["NOT NULL"]; ok {
+		column.Nullable = false
+	}
+	if value, ok := settings["DEFAULT"]; ok {
+		column.Default = value
+	}
+	if value, ok := settings["COMMENT"]; ok {
+		column.Comment = value
+	}
+	if column.Primary && column.Family == "integer" {
+		column.Method, column.Args = "ID", nil
+	}
+	if _, ok := settings["UNIQUE"]; ok {
+		table.Indexes = append(table.Indexes, &ModelIndex{Columns: []string{column.Name}, Unique: true})
+	}
+
+	for _, key := range []string{"INDEX", "UNIQUEINDEX"} {
+		value, ok := settings[key]
+		if !ok {
+			continue
+		}
+
+		// The value of the tag without the options is the key itself, eg: index.
+		if value == key {
+			value = ""
+		}
+
+		var name string
+		unique := key == "UNIQUEINDEX"
+		for i, option := range strings.Split(value, ",") {
+			option = strings.TrimSpace(option)
+			switch {
+			case i == 0 && option != "" && !strings.Contains(option, ":") && !strings.EqualFold(option, "unique"):
+				name = option
+			case strings.EqualFold(option, "unique"):
+				unique = true
+			}
+		}
+
+		index := findIndex(table.Indexes, name)
+		if name == "" || index == nil {
+			table.Indexes = append(table.Indexes, &ModelIndex{Name: name, Columns: []string{column.Name}, Unique: unique})
+		} else {
+			index.Columns = append(index.Columns, column.Name)
+		}
+	}
+}
+
+func findIndex(indexes []*ModelIndex, name string) *ModelIndex {
+	for _, index := range indexes {
+		if index.Name == name {
+			return index
+		}
+	}
+
+	return nil
+}
+
+func hasField(structType *ast.StructType, name string) bool {
+	for _, field := range structType.Fields.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func identColumn(name string) *ModelColumn {
+	switch name {
+	case "string":
+		return &ModelColumn{Method: "String", Family: "string"}
+	case "bool":
+		return &ModelColumn{Method: "Boolean", Family: "boolean"}
+	case "int", "int32":
+		return &ModelColumn{Method: "Integer", Family: "integer"}
+	case "int64":
+		return &ModelColumn{Method: "BigInteger", Family: "integer"}
+	case "int16":
+		return &ModelColumn{Method: "SmallInteger", Family: "integer"}
+	case "int8":
+		return &ModelColumn{Method: "TinyInteger", Family: "integer"}
+	case "uint", "uint64":
+		return &ModelColumn{Method: "UnsignedBigInteger", Family: "integer"}
+	case "uint32":
+		return &ModelColumn{Method: "UnsignedInteger", Family: "integer"}
+	case "uint16":
+		return &ModelColumn{Method: "UnsignedSmallInteger", Family: "integer"}
+	case "uint8", "byte":
+		return &ModelColumn{Method: "UnsignedTinyInteger", Family: "integer"}
+	case "float32":
+		return &ModelColumn{Method: "Float", Family: "float"}
+	case "float64":
+		return &ModelColumn{Method: "Double", Family: "float"}
+	}
+
+	return nil
+}
+
+func selectorColumn(name string) *ModelColumn {
+	switch name {
+	case "time.Time", "carbon.Carbon", "carbon.DateTime", "carbon.DateTimeMilli", "carbon.DateTimeMicro", "carbon.DateTimeNano",
+		"carbon.Timestamp", "carbon.TimestampMilli", "carbon.TimestampMicro", "carbon.TimestampNano":
+		return &ModelColumn{Method: "Timestamp", Family: "datetime"}
+	case "carbon.Date", "carbon.DateMilli", "carbon.DateMicro", "carbon.DateNano", "datatypes.Date":
+		return &ModelColumn{Method: "Date", Family: "date"}
+	case "carbon.Time", "carbon.TimeMilli", "carbon.TimeMicro", "carbon.TimeNano", "datatypes.Time":
+		return &ModelColumn{Method: "Time", Family: "time"}
+	case "gorm.DeletedAt":
+		return &ModelColumn{Method: "SoftDeletes", Family: "datetime", Nullable: true}
+	case "sql.NullString":
+		return &ModelColumn{Method: "String", Family: "string", Nullable: true}
+	case "sql.NullBool":
+		return &ModelColumn{Method: "Boolean", Family: "boolean", Nullable: true}
+	case "sql.NullInt64":
+		return &ModelColumn{Method: "BigInteger", Family: "integer", Nullable: true}
+	case "sql.NullInt32":
+		return &ModelColumn{Method: "Integer", Family: "integer", Nullable: true}
+	case "sql.NullInt16":
+		return &ModelColumn{Method: "SmallInteger", Family: "integer", Nullable: true}
+	case "sql.NullByte":
+		return &ModelColumn{Method: "UnsignedTinyInteger", Family: "integer", Nullable: true}
+	case "sql.NullFloat64":
+		return &ModelColumn{Method: "Double", Family: "float", Nullable: true}
+	case "sql.NullTime":
+		return &ModelColumn{Method: "Timestamp", Family: "datetime", Nullable: true}
+	case "datatypes.JSON", "datatypes.JSONMap", "datatypes.JSONSlice", "datatypes.JSONType", "json.RawMessage":
+		return &ModelColumn{Method: "Json", Family: "json"}
+	case "datatypes.UUID", "uuid.UUID":
+		return &ModelColumn{Method: "Uuid", Family: "string"}
+	case "decimal.Decimal":
+		return &ModelColumn{Method: "Decimal", Family: "decimal"}
+	}
+
+	return nil
+}
+
+// typeColumn parses the type tag, eg: varchar(100), decimal(10,2), text.
+func typeColumn(value string) *ModelColumn {
+	name, params, _ := strings.Cut(strings.ToLower(strings.TrimSpace(value)), "(")
+	params = strings.TrimSuffix(params, ")")
+	var args []string
+	if params != "" {
+		args = strings.Split(strings.ReplaceAll(params, " ", ""), ",")
+	}
+
+	switch name {
+	case "varchar", "character varying", "nvarchar":
+		return &ModelColumn{Method: "String", Args: args, Family: "string"}
+	case "char", "character", "nchar":
+		return &ModelColumn{Method: "Char", Args: args, Family: "string"}
+	case "text":
+		return &ModelColumn{Method: "Text", Family: "string"}
+	case "tinytext":
+		return &ModelColumn{Method: "TinyText", Family: "string"}
+	case "mediumtext":
+		return &ModelColumn{Method: "MediumText", Family: "string"}
+	case "longtext":
+		return &ModelColumn{Method: "LongText", Family: "string"}
+	case "uuid":
+		return &ModelColumn{Method: "Uuid", Family: "string"}
+	case "json":
+		return &ModelColumn{Method: "Json", Family: "json"}
+	case "jsonb":
+		return &ModelColumn{Method: "Jsonb", Family: "json"}
+	case "date":
+		return &ModelColumn{Method: "Date", Family: "date"}
+	case "time":
+		return &ModelColumn{Method: "Time", Family: "time"}
+	case "datetime":
+		return &ModelColumn{Method: "DateTime", Family: "datetime"}
+	case "timestamp":
+		return &ModelColumn{Method: "Timestamp", Family: "datetime"}
+	case "timestamptz":
+		return &ModelColumn{Method: "TimestampTz", Family: "datetime"}
+	case "decimal", "numeric":
+		column := &ModelColumn{Method: "Decimal", Family: "decimal"}
+		if len(args) > 0 {
+			column.Modifiers = append(column.Modifiers, "Total("+args[0]+")")
+		}
+		if len(args) > 1 {
+			column.Modifiers = append(column.Modifiers, "Places("+args[1]+")")
+		}
+
+		return column
+	}
+
+	return &ModelColumn{Method: "Column", Args: []string{strconv.Quote(value)}, Family: columnFamily(value, value)}
+}
+
+func parseConstraint(constraint string) (onUpdate, onDelete string) {
+	for _, option := range strings.Split(constraint, ",") {
+		key, value, _ := strings.Cut(option, ":")
+		switch strings.ToUpper(strings.TrimSpace(key)) {
+		case "ONUPDATE":
+			onUpdate = strings.ToLower(strings.TrimSpace(value))
+		case "ONDELETE":
+			onDelete = strings.ToLower(strings.TrimSpace(value))
+		}
+	}
+
+	return
+}
+
+func parseGormTag(tag *ast.BasicLit) map[string]string {
+	if tag == nil {
+		return map[string]string{}
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return map[string]string{}
+	}
+
+	return gormschema.ParseTagSetting(reflect.StructTag(value).Get("gorm"), ";")
+}
+
+func parseTableNameMethod(decl *ast.FuncDecl) (string, string, bool) {
+	if decl.Name.Name != "TableName" || decl.Recv == nil || len(decl.Recv.List) == 0 || decl.Body == nil || len(decl.Body.List) != 1 {
+		return "", "", false
+	}
+
+	returnStmt, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(returnStmt.Results) != 1 {
+		return "", "", false
+	}
+
+	literal, ok := returnStmt.Results[0].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", "", false
+	}
+
+	table, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return "", "", false
+	}
+
+	return identName(decl.Recv.List[0].Type), table, true
+}
+
+func identName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return identName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+
+	return ""
+}
+
+func selectorName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return selectorName(expr.X)
+	case *ast.SelectorExpr:
+		if pkg, ok := expr.X.(*ast.Ident); ok {
+			return pkg.Name + "." + expr.Sel.Name
+		}
+	}
+
+	return ""
+}
@@ -87,3 +87,93 @@ func (r *DummyMigration) Down() error {
 }
 `
 }
+
+func (receiver Stubs) Diff() string {
+	return `package migrations
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/facades"
+)
+
+type DummyMigration struct{}
+
+// Signature The unique signature for the migration.
+func (r *DummyMigration) Signature() string {
+	return "DummySignature"
+}
+
+// Up Run the migrations.
+func (r *DummyMigration) Up() error {
+DummyUp
+	return nil
+}
+
+// Down Reverse the migrations.
+func (r *DummyMigration) Down() error {
+DummyDown
+	return nil
+}
+`
+}
+
+func (receiver Stubs) Connection() string {
+	return `
+// Connection The database connection that should be used by the migration.
+func (r *DummyMigration) Connection() string {
+	return "DummyConnection"
+}
+`
+}
+
+func (receiver Stubs) View() string {
+	return `package migrations
+
+import (
+	"github.com/rusmanplatd/goravelframework/facades"
+)
+
+type DummyMigration struct{}
+
+// Signature The unique signature for the migration.
+func (r *DummyMigration) Signature() string {
+	return "DummySignature"
+}
+
+// Up Run the migrations.
+func (r *DummyMigration) Up() error {
+	return facades.Schema().CreateView("DummyView", "select 1", true)
+}
+
+// Down Reverse the migrations.
+func (r *DummyMigration) Down() error {
+	return facades.Schema().DropView("DummyView")
+}
+`
+}
+
+func (receiver Stubs) MaterializedView() string {
+	return `package migrations
+
+import (
+	"github.com/rusmanplatd/goravelframework/facades"
+)
+
+type DummyMigration struct{}
+
+// Signature The unique signature for the migration.
+func (r *DummyMigration) Signature() string {
+	return "DummySignature"
+}
+
+// Up Run the migrations.
+func (r *DummyMigration) Up() error {
+	return facades.Schema().CreateMaterializedView("DummyView", "select 1")
+}
+
+// Down Reverse the migrations.
+func (r *DummyMigration) Down() error {
+	return facades.Schema().DropMaterializedView("DummyView")
+}
+`
+}
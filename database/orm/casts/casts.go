@@ -0,0 +1,71 @@
+package casts
+
+import (
+	"reflect"
+	"sync"
+
+	contractscrypt "github.com/rusmanplatd/goravelframework/contracts/crypt"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+var (
+	cryptResolver func() contractscrypt.Crypt
+	cryptMu       sync.RWMutex
+)
+
+// SetCrypt sets the resolver of the crypt that is used by the encrypted casts.
+func SetCrypt(resolver func() contractscrypt.Crypt) {
+	cryptMu.Lock()
+	defer cryptMu.Unlock()
+
+	cryptResolver = resolver
+}
+
+func getCrypt() (contractscrypt.Crypt, error) {
+	cryptMu.RLock()
+	resolver := cryptResolver
+	cryptMu.RUnlock()
+
+	if resolver == nil {
+		return nil, errors.OrmCastCryptNotSet
+	}
+
+	crypt := resolver()
+	if crypt == nil {
+		return nil, errors.OrmCastCryptNotSet
+	}
+
+	return crypt, nil
+}
+
+// indirect gets the underlying value of the pointers, nil is returned for the nil pointers.
+func indirect(value any) any {
+	reflectValue := reflect.ValueOf(value)
+	for reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return nil
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	if !reflectValue.IsValid() {
+		return nil
+	}
+
+	return reflectValue.Interface()
+}
+
+// toString converts the value of a text column or a string field to string, the custom string types are supported.
+func toString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	}
+
+	if reflectValue := reflect.ValueOf(value); reflectValue.Kind() == reflect.String {
+		return reflectValue.String(), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,138 @@
+package casts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractscrypt "github.com/rusmanplatd/goravelframework/contracts/crypt"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mockscrypt "github.com/rusmanplatd/goravelframework/mocks/crypt"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+type Address struct {
+	City string `json:"city"`
+}
+
+type Status string
+
+func TestEncrypted(t *testing.T) {
+	SetCrypt(nil)
+	_, err := Encrypted{}.Set("secret")
+	assert.Equal(t, errors.OrmCastCryptNotSet, err)
+
+	mockCrypt := mockscrypt.NewCrypt(t)
+	SetCrypt(func() contractscrypt.Crypt {
+		return mockCrypt
+	})
+	t.Cleanup(func() {
+		SetCrypt(nil)
+	})
+
+	mockCrypt.EXPECT().EncryptString("secret").Return("encrypted", nil).Once()
+	value, err := Encrypted{}.Set("secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "encrypted", value)
+
+	mockCrypt.EXPECT().DecryptString("encrypted").Return("secret", nil).Once()
+	value, err = Encrypted{}.Get([]byte("encrypted"))
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", value)
+
+	value, err = Encrypted{}.Set((*string)(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = Encrypted{}.Set(1)
+	assert.Equal(t, errors.OrmCastInvalidValue.Args(1, "encrypted"), err)
+
+	mockCrypt.EXPECT().EncryptString(`{"city":"Paris"}`).Return("encrypted", nil).Once()
+	value, err = EncryptedJson[Address]{}.Set(Address{City: "Paris"})
+	assert.NoError(t, err)
+	assert.Equal(t, "encrypted", value)
+
+	mockCrypt.EXPECT().DecryptString("encrypted").Return(`{"city":"Paris"}`, nil).Once()
+	value, err = EncryptedJson[Address]{}.Get("encrypted")
+	assert.NoError(t, err)
+	assert.Equal(t, Address{City: "Paris"}, value)
+}
+
+func TestJson(t *testing.T) {
+	value, err := Json[[]Address]{}.Set([]Address{{City: "Paris"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"city":"Paris"}]`, value)
+
+	value, err = Json[[]Address]{}.Get([]byte(`[{"city":"Paris"}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{{City: "Paris"}}, value)
+
+	value, err = Json[*Address]{}.Get("")
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = Json[Address]{}.Set(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = Json[Address]{}.Get(`{"city":`)
+	assert.Error(t, err)
+}
+
+func TestCommaSeparated(t *testing.T) {
+	value, err := CommaSeparated{}.Set([]string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a,b", value)
+
+	value, err = CommaSeparated{}.Get("a,b")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, value)
+
+	value, err = CommaSeparated{}.Get("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, value)
+
+	_, err = CommaSeparated{}.Set([]int{1})
+	assert.Equal(t, errors.OrmCastInvalidValue.Args([]int{1}, "comma separated"), err)
+
+	_, err = CommaSeparated{}.Set([]string{"a", "b,c"})
+	assert.Equal(t, errors.OrmCastInvalidValue.Args("b,c", "comma separated"), err)
+}
+
+func TestEnum(t *testing.T) {
+	enum := Enum{Values: []string{"active", "inactive"}}
+
+	value, err := enum.Set(Status("active"))
+	assert.NoError(t, err)
+	assert.Equal(t, "active", value)
+
+	value, err = enum.Get([]byte("inactive"))
+	assert.NoError(t, err)
+	assert.Equal(t, "inactive", value)
+
+	_, err = enum.Set("deleted")
+	assert.Equal(t, errors.OrmCastInvalidEnumValue.Args("deleted", "active, inactive"), err)
+}
+
+func TestDateTime(t *testing.T) {
+	stdTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	value, err := DateTime{Timezone: carbon.Tokyo}.Get(stdTime)
+	assert.NoError(t, err)
+	dateTime, ok := value.(*carbon.DateTime)
+	assert.True(t, ok)
+	assert.Equal(t, "2025-01-02 12:04:05", dateTime.ToDateTimeString())
+	assert.Equal(t, carbon.Tokyo, dateTime.Timezone())
+
+	value, err = DateTime{}.Set(dateTime)
+	assert.NoError(t, err)
+	assert.True(t, stdTime.Equal(value.(time.Time)))
+
+	value, err = DateTime{}.Set((*carbon.DateTime)(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = DateTime{}.Get(1)
+	assert.Equal(t, errors.OrmCastInvalidValue.Args(1, "datetime"), err)
+}
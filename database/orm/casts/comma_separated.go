@@ -0,0 +1,52 @@
+package casts
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+// CommaSeparated joins the []string field with commas when the model is saved and splits it when the model is
+// retrieved, so the items can't contain commas. The field should set the column type by the gorm tag,
+// eg: `gorm:"type:text"`.
+type CommaSeparated struct{}
+
+func (r CommaSeparated) Get(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, ok := toString(value)
+	if !ok {
+		return nil, errors.OrmCastInvalidValue.Args(value, "comma separated")
+	}
+	if data == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(data, ","), nil
+}
+
+func (r CommaSeparated) Set(value any) (any, error) {
+	value = indirect(value)
+	if value == nil {
+		return nil, nil
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice || reflectValue.Type().Elem().Kind() != reflect.String {
+		return nil, errors.OrmCastInvalidValue.Args(value, "comma separated")
+	}
+
+	items := make([]string, reflectValue.Len())
+	for i := range items {
+		items[i] = reflectValue.Index(i).String()
+		// The item would be split into multiple items when the model is retrieved.
+		if strings.Contains(items[i], ",") {
+			return nil, errors.OrmCastInvalidValue.Args(items[i], "comma separated")
+		}
+	}
+
+	return strings.Join(items, ","), nil
+}
@@ -0,0 +1,70 @@
+package casts
+
+import (
+	"time"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+// DateTime converts the *carbon.DateTime field to the timezone when the model is retrieved, the default timezone
+// of carbon is used if the timezone is empty. The time is saved with its own timezone.
+type DateTime struct {
+	Timezone string
+}
+
+func (r DateTime) Get(value any) (any, error) {
+	var c *carbon.Carbon
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		c = carbon.FromStdTime(v)
+	case string:
+		c = carbon.Parse(v)
+	case []byte:
+		c = carbon.Parse(string(v))
+	default:
+		return nil, errors.OrmCastInvalidValue.Args(value, "datetime")
+	}
+
+	if c.HasError() {
+		return nil, c.Error
+	}
+
+	return carbon.NewDateTime(c.SetTimezone(r.timezone())), nil
+}
+
+func (r DateTime) Set(value any) (any, error) {
+	var c *carbon.Carbon
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return v, nil
+	case *carbon.Carbon:
+		c = v
+	case *carbon.DateTime:
+		if v != nil {
+			c = v.Carbon
+		}
+	case carbon.DateTime:
+		c = v.Carbon
+	default:
+		return nil, errors.OrmCastInvalidValue.Args(value, "datetime")
+	}
+
+	if c == nil || c.IsZero() {
+		return nil, nil
+	}
+
+	return c.StdTime(), nil
+}
+
+func (r DateTime) timezone() string {
+	if r.Timezone != "" {
+		return r.Timezone
+	}
+
+	return carbon.DefaultTimezone()
+}
@@ -0,0 +1,74 @@
+package casts
+
+import (
+	"encoding/json"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+// Encrypted encrypts the string field when the model is saved and decrypts it when the model is retrieved.
+type Encrypted struct{}
+
+func (r Encrypted) Get(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	payload, ok := toString(value)
+	if !ok {
+		return nil, errors.OrmCastInvalidValue.Args(value, "encrypted")
+	}
+
+	crypt, err := getCrypt()
+	if err != nil {
+		return nil, err
+	}
+
+	return crypt.DecryptString(payload)
+}
+
+func (r Encrypted) Set(value any) (any, error) {
+	value = indirect(value)
+	if value == nil {
+		return nil, nil
+	}
+
+	str, ok := toString(value)
+	if !ok {
+		return nil, errors.OrmCastInvalidValue.Args(value, "encrypted")
+	}
+
+	crypt, err := getCrypt()
+	if err != nil {
+		return nil, err
+	}
+
+	return crypt.EncryptString(str)
+}
+
+// EncryptedJson encodes the field to JSON and encrypts it when the model is saved, and decrypts and decodes it
+// to T when the model is retrieved. The struct and slice fields should set the column type by the gorm tag,
+// eg: `gorm:"type:text"`.
+type EncryptedJson[T any] struct{}
+
+func (r EncryptedJson[T]) Get(value any) (any, error) {
+	decrypted, err := Encrypted{}.Get(value)
+	if err != nil || decrypted == nil {
+		return nil, err
+	}
+
+	return Json[T]{}.Get(decrypted)
+}
+
+func (r EncryptedJson[T]) Set(value any) (any, error) {
+	if indirect(value) == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return Encrypted{}.Set(string(data))
+}
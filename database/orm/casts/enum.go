@@ -0,0 +1,42 @@
+package casts
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+// Enum validates the string field against the values when the model is saved and retrieved.
+type Enum struct {
+	Values []string
+}
+
+func (r Enum) Get(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return r.validate(value)
+}
+
+func (r Enum) Set(value any) (any, error) {
+	value = indirect(value)
+	if value == nil {
+		return nil, nil
+	}
+
+	return r.validate(value)
+}
+
+func (r Enum) validate(value any) (string, error) {
+	str, ok := toString(value)
+	if !ok {
+		return "", errors.OrmCastInvalidValue.Args(value, "enum")
+	}
+	if !slices.Contains(r.Values, str) {
+		return "", errors.OrmCastInvalidEnumValue.Args(str, strings.Join(r.Values, ", "))
+	}
+
+	return str, nil
+}
@@ -0,0 +1,45 @@
+package casts
+
+import (
+	"encoding/json"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+// Json encodes the field to JSON when the model is saved and decodes it to T when the model is retrieved.
+// The struct and slice fields should set the column type by the gorm tag, eg: `gorm:"type:json"`.
+type Json[T any] struct{}
+
+func (r Json[T]) Get(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, ok := toString(value)
+	if !ok {
+		return nil, errors.OrmCastInvalidValue.Args(value, "json")
+	}
+
+	var dest T
+	if data == "" {
+		return dest, nil
+	}
+	if err := json.Unmarshal([]byte(data), &dest); err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
+
+func (r Json[T]) Set(value any) (any, error) {
+	if indirect(value) == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,38 @@
+package orm
+
+import (
+	"errors"
+	"iter"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+)
+
+// errLazyStopped stops retrieving the chunks when the loop is broken.
+var errLazyStopped = errors.New("lazy stopped")
+
+// Lazy retrieves the models of the query in chunks of the given size and yields them one by one, so only
+// one chunk is kept in memory. The chunks are retrieved by Query.Chunk, so the query is ordered by the primary
+// key if it isn't ordered. The eager loads set by With are loaded for each chunk, eg:
+//
+//	for user, err := range orm.Lazy[models.User](facades.Orm().Query().With("Roles"), 1000) {
+//		if err != nil {
+//			return err
+//		}
+//	}
+func Lazy[T any](query contractsorm.Query, size int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var model T
+		err := query.Model(&model).Chunk(size, func(models any) error {
+			for _, model := range *models.(*[]T) {
+				if !yield(model, nil) {
+					return errLazyStopped
+				}
+			}
+
+			return nil
+		})
+		if err != nil && !errors.Is(err, errLazyStopped) {
+			yield(model, err)
+		}
+	}
+}
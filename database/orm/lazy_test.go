@@ -0,0 +1,65 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+)
+
+type LazyUser struct {
+	ID uint
+}
+
+func TestLazy(t *testing.T) {
+	mockQuery := mocksorm.NewQuery(t)
+	expectChunks := func(chunks [][]LazyUser, err error) {
+		mockQuery.EXPECT().Model(&LazyUser{}).Return(mockQuery).Once()
+		mockQuery.EXPECT().Chunk(2, mock.Anything).RunAndReturn(func(size int, callback func(models any) error) error {
+			for _, users := range chunks {
+				if err := callback(&users); err != nil {
+					return err
+				}
+			}
+
+			return err
+		}).Once()
+	}
+
+	t.Run("yields all models", func(t *testing.T) {
+		expectChunks([][]LazyUser{{{ID: 1}, {ID: 2}}, {{ID: 3}}}, nil)
+
+		var ids []uint
+		for user, err := range Lazy[LazyUser](mockQuery, 2) {
+			assert.NoError(t, err)
+			ids = append(ids, user.ID)
+		}
+
+		assert.Equal(t, []uint{1, 2, 3}, ids)
+	})
+
+	t.Run("stops when breaking the loop", func(t *testing.T) {
+		expectChunks([][]LazyUser{{{ID: 1}, {ID: 2}}, {{ID: 3}}}, nil)
+
+		var ids []uint
+		for user := range Lazy[LazyUser](mockQuery, 2) {
+			ids = append(ids, user.ID)
+			break
+		}
+
+		assert.Equal(t, []uint{1}, ids)
+	})
+
+	t.Run("yields the error", func(t *testing.T) {
+		expectChunks(nil, assert.AnError)
+
+		var errs []error
+		for _, err := range Lazy[LazyUser](mockQuery, 2) {
+			errs = append(errs, err)
+		}
+
+		assert.Equal(t, []error{assert.AnError}, errs)
+	})
+}
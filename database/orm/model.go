@@ -4,11 +4,15 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 )
 
 const Associations = clause.Associations
 
+// ErrStaleModel is returned when a versioned model has been modified since it was retrieved.
+var ErrStaleModel = errors.OrmStaleModel
+
 // Model is the base model for all models in the application.
 type Model struct {
 	Timestamps
@@ -25,3 +29,14 @@ type Timestamps struct {
 	CreatedAt *carbon.DateTime `gorm:"autoCreateTime;column:created_at" json:"created_at"`
 	UpdatedAt *carbon.DateTime `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
 }
+
+// Versions is used to add optimistic locking to a model, the version is checked and incremented when the model is
+// updated.
+type Versions struct {
+	Version uint `gorm:"column:version" json:"version"`
+}
+
+// VersionColumn gets the column of the version.
+func (r Versions) VersionColumn() string {
+	return "version"
+}
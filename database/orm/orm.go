@@ -14,6 +14,7 @@ import (
 	"github.com/rusmanplatd/goravelframework/contracts/log"
 	"github.com/rusmanplatd/goravelframework/database/factory"
 	"github.com/rusmanplatd/goravelframework/database/gorm"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type Orm struct {
@@ -171,5 +172,10 @@ func (r *Orm) Transaction(txFunc func(tx contractsorm.Query) error) (err error)
 }
 
 func (r *Orm) WithContext(ctx context.Context) contractsorm.Orm {
+	// Switch to the connection of the tenant if the per-tenant connections are used, see tenancy.SetConnectionResolver.
+	if connection := tenancy.Connection(tenancy.FromContext(ctx)); connection != "" && connection != r.connection {
+		return r.Connection(connection).WithContext(ctx)
+	}
+
 	return NewOrm(ctx, r.config, r.connection, r.dbConfig, r.query, r.queries, r.log, r.modelToObserver, r.fresh)
 }
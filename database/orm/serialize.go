@@ -0,0 +1,196 @@
+package orm
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+)
+
+var marshalerType = reflect.TypeFor[json.Marshaler]()
+
+// ToMap converts the model to a map by the JSON keys of its fields, the Hidden, Visible and Appends of the model
+// and its loaded relations are honored. Nil is returned if the model is not a struct.
+func ToMap(model any) map[string]any {
+	result, _ := serialize(reflect.ValueOf(model)).(map[string]any)
+
+	return result
+}
+
+// ToJson converts the model, the slice of models or the paginated models to JSON, the Hidden, Visible and Appends
+// of the models are honored.
+func ToJson(value any) ([]byte, error) {
+	return json.Marshal(Serialize(value))
+}
+
+// Serialize converts the models in the value to maps like ToMap, the other values are returned as they are.
+func Serialize(value any) any {
+	return serialize(reflect.ValueOf(value))
+}
+
+func serialize(value reflect.Value) any {
+	if !value.IsValid() {
+		return nil
+	}
+
+	if value.Type().Implements(marshalerType) {
+		if value.Kind() == reflect.Pointer && value.IsNil() {
+			return nil
+		}
+
+		return value.Interface()
+	}
+	if value.Kind() != reflect.Pointer && reflect.PointerTo(value.Type()).Implements(marshalerType) {
+		return addressable(value).Addr().Interface()
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+
+		return serialize(value.Elem())
+	case reflect.Struct:
+		return serializeStruct(addressable(value))
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return value.Interface()
+		}
+
+		fallthrough
+	case reflect.Array:
+		result := make([]any, value.Len())
+		for i := range result {
+			result[i] = serialize(value.Index(i))
+		}
+
+		return result
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Key().Kind() != reflect.String {
+			return value.Interface()
+		}
+
+		result := make(map[string]any, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			result[iter.Key().String()] = serialize(iter.Value())
+		}
+
+		return result
+	default:
+		return value.Interface()
+	}
+}
+
+func serializeStruct(value reflect.Value) map[string]any {
+	result := make(map[string]any)
+	// fieldNames maps the field names to the JSON keys, it's used to match the Hidden and Visible attributes.
+	fieldNames := make(map[string]string)
+	collectFields(value, result, fieldNames, false)
+
+	model := value.Addr().Interface()
+	if visible, ok := model.(contractsorm.ModelWithVisible); ok {
+		if attributes := visible.Visible(); len(attributes) > 0 {
+			for name, key := range fieldNames {
+				if !slices.Contains(attributes, name) && !slices.Contains(attributes, key) {
+					delete(result, key)
+				}
+			}
+		}
+	}
+	if hidden, ok := model.(contractsorm.ModelWithHidden); ok {
+		for _, attribute := range hidden.Hidden() {
+			if key, exist := fieldNames[attribute]; exist {
+				delete(result, key)
+			}
+			delete(result, attribute)
+		}
+	}
+	if appends, ok := model.(contractsorm.ModelWithAppends); ok {
+		for key, attribute := range appends.Appends() {
+			result[key] = serialize(reflect.ValueOf(attribute))
+		}
+	}
+
+	return result
+}
+
+// collectFields collects the fields by the rules of encoding/json, the fields of the embedded structs are flattened
+// and they don't override the fields of the outer struct.
+func collectFields(value reflect.Value, result map[string]any, fieldNames map[string]string, embedded bool) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		fieldValue := value.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			if fieldValue.Kind() == reflect.Pointer {
+				if fieldValue.IsNil() {
+					continue
+				}
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() == reflect.Struct && !fieldValue.Type().Implements(marshalerType) &&
+				!reflect.PointerTo(fieldValue.Type()).Implements(marshalerType) {
+				collectFields(addressable(fieldValue), result, fieldNames, true)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		if _, exist := result[name]; exist && embedded {
+			continue
+		}
+		if slices.Contains(strings.Split(options, ","), "omitempty") && isEmptyValue(fieldValue) {
+			delete(result, name)
+			continue
+		}
+
+		result[name] = serialize(fieldValue)
+		fieldNames[field.Name] = name
+	}
+}
+
+func addressable(value reflect.Value) reflect.Value {
+	if value.CanAddr() {
+		return value
+	}
+
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+
+	return copied
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return value.IsZero()
+	}
+}
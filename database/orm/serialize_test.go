@@ -0,0 +1,67 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+type SerializeUser struct {
+	Model
+	Name     string           `json:"name"`
+	Password string           `json:"password"`
+	Bio      *string          `json:"bio,omitempty"`
+	Books    []*SerializeBook `json:"books"`
+	Ignored  string           `json:"-"`
+	internal string
+}
+
+func (r *SerializeUser) Hidden() []string {
+	return []string{"Password"}
+}
+
+func (r *SerializeUser) Appends() map[string]any {
+	return map[string]any{
+		"is_admin": r.Name == "admin",
+	}
+}
+
+type SerializeBook struct {
+	ID     uint   `json:"id"`
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+func (r *SerializeBook) Visible() []string {
+	return []string{"id", "name"}
+}
+
+func TestToMap(t *testing.T) {
+	createdAt := carbon.NewDateTime(carbon.Parse("2025-01-02 03:04:05"))
+	user := SerializeUser{
+		Model:    Model{ID: 1, Timestamps: Timestamps{CreatedAt: createdAt}},
+		Name:     "admin",
+		Password: "hash",
+		Books:    []*SerializeBook{{ID: 1, Name: "Goravel", Secret: "secret"}},
+		Ignored:  "ignored",
+		internal: "internal",
+	}
+
+	assert.Equal(t, map[string]any{
+		"id":         uint(1),
+		"created_at": createdAt,
+		"updated_at": nil,
+		"name":       "admin",
+		"books":      []any{map[string]any{"id": uint(1), "name": "Goravel"}},
+		"is_admin":   true,
+	}, ToMap(&user))
+	assert.Equal(t, ToMap(&user), ToMap(user))
+	assert.Nil(t, ToMap([]SerializeUser{user}))
+	assert.Nil(t, ToMap(nil))
+
+	json, err := ToJson([]SerializeUser{user})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"id":1,"created_at":"2025-01-02 03:04:05","updated_at":null,"name":"admin","books":[{"id":1,"name":"Goravel"}],"is_admin":true}]`, string(json))
+}
@@ -2,20 +2,24 @@ package schema
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
 	"github.com/rusmanplatd/goravelframework/contracts/database/orm"
 	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/convert"
 )
 
 const (
 	CommandAdd          = "add"
+	CommandCheck        = "check"
 	CommandComment      = "comment"
 	CommandCreate       = "create"
 	CommandDefault      = "default"
 	CommandDrop         = "drop"
+	CommandDropCheck    = "dropCheck"
 	CommandDropColumn   = "dropColumn"
 	CommandDropForeign  = "dropForeign"
 	CommandDropFullText = "dropFullText"
@@ -34,6 +38,10 @@ const (
 	CommandUnique       = "unique"
 	DefaultStringLength = 255
 	DefaultUlidLength   = 26
+	ModifierCharset     = "charset"
+	ModifierCollation   = "collation"
+	ModifierStoredAs    = "storedAs"
+	ModifierVirtualAs   = "virtualAs"
 )
 
 type Blueprint struct {
@@ -91,6 +99,14 @@ func (r *Blueprint) Char(column string, length ...int) driver.ColumnDefinition {
 	return columnImpl
 }
 
+func (r *Blueprint) Check(name, expression string) {
+	r.addCommand(&driver.Command{
+		Expression: expression,
+		Index:      name,
+		Name:       CommandCheck,
+	})
+}
+
 func (r *Blueprint) Column(column, ttype string) driver.ColumnDefinition {
 	return r.createAndAddColumn(ttype, column)
 }
@@ -148,6 +164,13 @@ func (r *Blueprint) Drop() {
 	})
 }
 
+func (r *Blueprint) DropCheck(name string) {
+	r.addCommand(&driver.Command{
+		Index: name,
+		Name:  CommandDropCheck,
+	})
+}
+
 func (r *Blueprint) DropColumn(column ...string) {
 	r.addCommand(&driver.Command{
 		Name:    CommandDropColumn,
@@ -533,6 +556,10 @@ func (r *Blueprint) TinyText(column string) driver.ColumnDefinition {
 }
 
 func (r *Blueprint) ToSql(grammar driver.Grammar) ([]string, error) {
+	if err := r.checkColumnModifiers(grammar); err != nil {
+		return nil, err
+	}
+
 	r.addImpliedCommands(grammar)
 
 	var statements []string
@@ -550,6 +577,14 @@ func (r *Blueprint) ToSql(grammar driver.Grammar) ([]string, error) {
 				continue
 			}
 			statements = append(statements, grammar.CompileAdd(r, command))
+		case CommandCheck, CommandDropCheck:
+			statement, err := r.compileCheck(grammar, command)
+			if err != nil {
+				return statements, err
+			}
+			if statement != "" {
+				statements = append(statements, statement)
+			}
 		case CommandComment:
 			if statement := grammar.CompileComment(r, command); statement != "" {
 				statements = append(statements, statement)
@@ -693,6 +728,38 @@ func (r *Blueprint) addImpliedCommands(grammar driver.Grammar) {
 	r.addAttributeCommands(grammar)
 }
 
+// checkColumnModifiers returns an error if a column uses an optional modifier that the grammar doesn't compile, so the
+// modifier isn't dropped silently.
+func (r *Blueprint) checkColumnModifiers(grammar driver.Grammar) error {
+	var modifiers []string
+	if modifierGrammar, ok := grammar.(driver.ColumnModifierGrammar); ok {
+		modifiers = modifierGrammar.GetColumnModifiers()
+	}
+
+	for _, column := range r.columns {
+		for _, modifier := range column.getModifiers() {
+			if !slices.Contains(modifiers, modifier) {
+				return errors.SchemaColumnModifierNotSupported.Args(modifier, column.GetName())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Blueprint) compileCheck(grammar driver.Grammar, command *driver.Command) (string, error) {
+	checkGrammar, ok := grammar.(driver.CompileCheckGrammar)
+	if !ok {
+		return "", errors.SchemaCheckNotSupported
+	}
+
+	if command.Name == CommandDropCheck {
+		return checkGrammar.CompileDropCheck(r, command), nil
+	}
+
+	return checkGrammar.CompileCheck(r, command), nil
+}
+
 func (r *Blueprint) createAndAddColumn(ttype, name string) *ColumnDefinition {
 	columnImpl := &ColumnDefinition{
 		name:  &name,
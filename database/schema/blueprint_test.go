@@ -8,12 +8,23 @@ import (
 
 	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
 	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/errors"
 	mocksdriver "github.com/rusmanplatd/goravelframework/mocks/database/driver"
 	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
 	mocksschema "github.com/rusmanplatd/goravelframework/mocks/database/schema"
 	"github.com/rusmanplatd/goravelframework/support/convert"
 )
 
+type checkGrammar struct {
+	*mocksdriver.Grammar
+	*mocksdriver.CompileCheckGrammar
+}
+
+type columnModifierGrammar struct {
+	*mocksdriver.Grammar
+	*mocksdriver.ColumnModifierGrammar
+}
+
 type BlueprintTestSuite struct {
 	suite.Suite
 	mockSchema *mocksschema.Schema
@@ -205,6 +216,47 @@ func (s *BlueprintTestSuite) TestBuild() {
 	}
 }
 
+func (s *BlueprintTestSuite) TestCheck() {
+	s.blueprint.Check("users_age_check", "age >= 18")
+	s.blueprint.DropCheck("users_name_check")
+	s.Equal([]*driver.Command{
+		{
+			Expression: "age >= 18",
+			Index:      "users_age_check",
+			Name:       CommandCheck,
+		},
+		{
+			Index: "users_name_check",
+			Name:  CommandDropCheck,
+		},
+	}, s.blueprint.GetCommands())
+
+	s.Run("the grammar compiles the check constraints", func() {
+		grammar := &checkGrammar{Grammar: mocksdriver.NewGrammar(s.T()), CompileCheckGrammar: mocksdriver.NewCompileCheckGrammar(s.T())}
+		grammar.Grammar.EXPECT().GetAttributeCommands().Return([]string{}).Once()
+		grammar.CompileCheckGrammar.EXPECT().CompileCheck(s.blueprint, s.blueprint.commands[0]).
+			Return("ALTER TABLE users ADD CONSTRAINT users_age_check CHECK (age >= 18)").Once()
+		grammar.CompileCheckGrammar.EXPECT().CompileDropCheck(s.blueprint, s.blueprint.commands[1]).
+			Return("ALTER TABLE users DROP CONSTRAINT users_name_check").Once()
+
+		statements, err := s.blueprint.ToSql(grammar)
+		s.NoError(err)
+		s.Equal([]string{
+			"ALTER TABLE users ADD CONSTRAINT users_age_check CHECK (age >= 18)",
+			"ALTER TABLE users DROP CONSTRAINT users_name_check",
+		}, statements)
+	})
+
+	s.Run("the grammar doesn't support the check constraints", func() {
+		mockGrammar := mocksdriver.NewGrammar(s.T())
+		mockGrammar.EXPECT().GetAttributeCommands().Return([]string{}).Once()
+
+		statements, err := s.blueprint.ToSql(mockGrammar)
+		s.Equal(errors.SchemaCheckNotSupported, err)
+		s.Empty(statements)
+	})
+}
+
 func (s *BlueprintTestSuite) TestChar() {
 	column := "name"
 	customLength := 100
@@ -225,6 +277,49 @@ func (s *BlueprintTestSuite) TestChar() {
 	})
 }
 
+func (s *BlueprintTestSuite) TestColumnModifiers() {
+	s.Run("the grammar doesn't declare the modifiers", func() {
+		s.SetupTest()
+		s.blueprint.String("name").Collation("utf8mb4_unicode_ci")
+
+		statements, err := s.blueprint.ToSql(mocksdriver.NewGrammar(s.T()))
+		s.Equal(errors.SchemaColumnModifierNotSupported.Args(ModifierCollation, "name"), err)
+		s.Empty(statements)
+	})
+
+	s.Run("the grammar doesn't declare one of the modifiers", func() {
+		s.SetupTest()
+		s.blueprint.String("name").Charset("utf8mb4").Collation("utf8mb4_unicode_ci")
+		s.blueprint.Decimal("total").StoredAs("price * quantity")
+
+		grammar := &columnModifierGrammar{Grammar: mocksdriver.NewGrammar(s.T()), ColumnModifierGrammar: mocksdriver.NewColumnModifierGrammar(s.T())}
+		grammar.ColumnModifierGrammar.EXPECT().GetColumnModifiers().Return([]string{ModifierCharset, ModifierCollation, ModifierVirtualAs}).Once()
+
+		statements, err := s.blueprint.ToSql(grammar)
+		s.Equal(errors.SchemaColumnModifierNotSupported.Args(ModifierStoredAs, "total"), err)
+		s.Empty(statements)
+	})
+
+	s.Run("the grammar declares the modifiers", func() {
+		s.SetupTest()
+		s.blueprint.String("name").Charset("utf8mb4").Collation("utf8mb4_unicode_ci")
+		s.blueprint.String("full_name").VirtualAs("concat(first_name, last_name)")
+
+		grammar := &columnModifierGrammar{Grammar: mocksdriver.NewGrammar(s.T()), ColumnModifierGrammar: mocksdriver.NewColumnModifierGrammar(s.T())}
+		grammar.ColumnModifierGrammar.EXPECT().GetColumnModifiers().Return([]string{ModifierCharset, ModifierCollation, ModifierVirtualAs}).Once()
+		grammar.Grammar.EXPECT().GetAttributeCommands().Return([]string{}).Once()
+		grammar.Grammar.EXPECT().CompileAdd(s.blueprint, s.blueprint.commands[0]).Return("alter table users add name varchar(255) character set utf8mb4 collate utf8mb4_unicode_ci").Once()
+		grammar.Grammar.EXPECT().CompileAdd(s.blueprint, s.blueprint.commands[1]).Return("alter table users add full_name varchar(255) as (concat(first_name, last_name))").Once()
+
+		statements, err := s.blueprint.ToSql(grammar)
+		s.NoError(err)
+		s.Equal([]string{
+			"alter table users add name varchar(255) character set utf8mb4 collate utf8mb4_unicode_ci",
+			"alter table users add full_name varchar(255) as (concat(first_name, last_name))",
+		}, statements)
+	})
+}
+
 func (s *BlueprintTestSuite) TestCreateIndexName() {
 	name := s.blueprint.createIndexName("index", []string{"id", "name-1", "name.2"})
 	s.Equal("goravel_users_id_name_1_name_2_index", name)
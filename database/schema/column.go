@@ -9,6 +9,8 @@ type ColumnDefinition struct {
 	def                any
 	onUpdate           any
 	autoIncrement      *bool
+	charset            *string
+	collation          *string
 	comment            *string
 	generatedAs        *string
 	length             *int
@@ -16,11 +18,13 @@ type ColumnDefinition struct {
 	nullable           *bool
 	places             *int
 	precision          *int
+	storedAs           *string
 	total              *int
 	ttype              *string
 	unsigned           *bool
 	useCurrent         *bool
 	useCurrentOnUpdate *bool
+	virtualAs          *string
 	after              string
 	allowed            []any
 	always             bool
@@ -59,6 +63,18 @@ func (r *ColumnDefinition) Change() driver.ColumnDefinition {
 	return r
 }
 
+func (r *ColumnDefinition) Charset(charset string) driver.ColumnDefinition {
+	r.charset = &charset
+
+	return r
+}
+
+func (r *ColumnDefinition) Collation(collation string) driver.ColumnDefinition {
+	r.collation = &collation
+
+	return r
+}
+
 func (r *ColumnDefinition) Comment(comment string) driver.ColumnDefinition {
 	r.comment = &comment
 
@@ -100,6 +116,22 @@ func (r *ColumnDefinition) GetAutoIncrement() bool {
 	return false
 }
 
+func (r *ColumnDefinition) GetCharset() string {
+	if r.charset != nil {
+		return *r.charset
+	}
+
+	return ""
+}
+
+func (r *ColumnDefinition) GetCollation() string {
+	if r.collation != nil {
+		return *r.collation
+	}
+
+	return ""
+}
+
 func (r *ColumnDefinition) GetComment() string {
 	if r.comment != nil {
 		return *r.comment
@@ -164,6 +196,14 @@ func (r *ColumnDefinition) GetPrecision() int {
 	return 0
 }
 
+func (r *ColumnDefinition) GetStoredAs() string {
+	if r.storedAs != nil {
+		return *r.storedAs
+	}
+
+	return ""
+}
+
 func (r *ColumnDefinition) GetTotal() int {
 	if r.total != nil {
 		return *r.total
@@ -204,6 +244,14 @@ func (r *ColumnDefinition) GetUseCurrentOnUpdate() bool {
 	return false
 }
 
+func (r *ColumnDefinition) GetVirtualAs() string {
+	if r.virtualAs != nil {
+		return *r.virtualAs
+	}
+
+	return ""
+}
+
 func (r *ColumnDefinition) IsAlways() bool {
 	return r.always
 }
@@ -242,6 +290,12 @@ func (r *ColumnDefinition) Places(places int) driver.ColumnDefinition {
 	return r
 }
 
+func (r *ColumnDefinition) StoredAs(expression string) driver.ColumnDefinition {
+	r.storedAs = &expression
+
+	return r
+}
+
 func (r *ColumnDefinition) Total(total int) driver.ColumnDefinition {
 	r.total = convert.Pointer(total)
 
@@ -265,3 +319,29 @@ func (r *ColumnDefinition) UseCurrentOnUpdate() driver.ColumnDefinition {
 
 	return r
 }
+
+func (r *ColumnDefinition) VirtualAs(expression string) driver.ColumnDefinition {
+	r.virtualAs = &expression
+
+	return r
+}
+
+// getModifiers gets the optional modifiers used by the column, the grammar must declare them, see
+// driver.ColumnModifierGrammar.
+func (r *ColumnDefinition) getModifiers() []string {
+	var modifiers []string
+	if r.charset != nil {
+		modifiers = append(modifiers, ModifierCharset)
+	}
+	if r.collation != nil {
+		modifiers = append(modifiers, ModifierCollation)
+	}
+	if r.storedAs != nil {
+		modifiers = append(modifiers, ModifierStoredAs)
+	}
+	if r.virtualAs != nil {
+		modifiers = append(modifiers, ModifierVirtualAs)
+	}
+
+	return modifiers
+}
@@ -74,3 +74,23 @@ func (s *ColumnDefinitionTestSuite) Unsigned() {
 	s.columnDefinition.Unsigned()
 	s.True(*s.columnDefinition.unsigned)
 }
+
+func (s *ColumnDefinitionTestSuite) TestCharsetAndCollation() {
+	s.Empty(s.columnDefinition.GetCharset())
+	s.Empty(s.columnDefinition.GetCollation())
+
+	s.columnDefinition.Charset("utf8mb4").Collation("utf8mb4_unicode_ci")
+	s.Equal("utf8mb4", s.columnDefinition.GetCharset())
+	s.Equal("utf8mb4_unicode_ci", s.columnDefinition.GetCollation())
+}
+
+func (s *ColumnDefinitionTestSuite) TestGeneratedColumns() {
+	s.Empty(s.columnDefinition.GetStoredAs())
+	s.Empty(s.columnDefinition.GetVirtualAs())
+
+	s.columnDefinition.StoredAs("price * quantity")
+	s.Equal("price * quantity", s.columnDefinition.GetStoredAs())
+
+	s.columnDefinition.VirtualAs("concat(first_name, ' ', last_name)")
+	s.Equal("concat(first_name, ' ', last_name)", s.columnDefinition.GetVirtualAs())
+}
@@ -0,0 +1,331 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/collect"
+	supportfile "github.com/rusmanplatd/goravelframework/support/file"
+)
+
+var (
+	mysqlDefaultKeywordRegex = regexp.MustCompile(`(?i)^(null|current_timestamp|now|localtime|localtimestamp)(\(\d*\))?$`)
+	mysqlDefaultNumberRegex  = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+type dumpedMigration struct {
+	Migration string
+	Batch     int
+}
+
+func (r *Schema) Dump(path, migrationTable string) error {
+	var structure string
+	if dumper, ok := r.driver.(driver.SchemaDumper); ok {
+		if err := dumper.DumpSchema(path); err != nil {
+			return errors.SchemaFailedToDump.Args(err)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return errors.SchemaFailedToDump.Args(err)
+		}
+
+		structure = string(content)
+	} else {
+		var err error
+		if structure, err = r.dumpStructure(); err != nil {
+			return errors.SchemaFailedToDump.Args(err)
+		}
+	}
+
+	migrations, err := r.dumpMigrations(migrationTable)
+	if err != nil {
+		return errors.SchemaFailedToDump.Args(err)
+	}
+
+	return supportfile.PutContent(path, strings.TrimRight(structure, "\n")+"\n\n"+migrations)
+}
+
+func (r *Schema) Load(path string) error {
+	// The statements of the dump are recorded in the pretend mode, even if the driver loads the dump itself.
+	if dumper, ok := r.driver.(driver.SchemaDumper); ok && r.pretending == nil {
+		if err := dumper.LoadSchema(path); err != nil {
+			return errors.SchemaFailedToLoad.Args(path, err)
+		}
+
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return errors.SchemaFailedToLoad.Args(path, err)
+	}
+
+	statements := splitStatements(string(content))
+	if r.pretend(statements...) {
+		return nil
+	}
+
+	if err := r.orm.Transaction(func(tx contractsorm.Query) error {
+		for _, statement := range statements {
+			if _, err := tx.Exec(statement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return errors.SchemaFailedToLoad.Args(path, err)
+	}
+
+	return nil
+}
+
+// dumpStructure builds the database structure from the tables, columns, indexes and foreign keys of the schema,
+// it's used when the driver doesn't implement driver.SchemaDumper.
+func (r *Schema) dumpStructure() (string, error) {
+	tables, err := r.GetTables()
+	if err != nil {
+		return "", err
+	}
+
+	driverName := r.driverName()
+
+	var statements, constraints []string
+	for _, table := range tables {
+		if strings.HasPrefix(table.Name, "sqlite_") {
+			continue
+		}
+
+		columns, err := r.GetColumns(table.Name)
+		if err != nil {
+			return "", err
+		}
+
+		indexes, err := r.GetIndexes(table.Name)
+		if err != nil {
+			return "", err
+		}
+
+		foreignKeys, err := r.GetForeignKeys(table.Name)
+		if err != nil {
+			return "", err
+		}
+
+		var (
+			definitions       []string
+			hasInlinePrimary  bool
+			indexStatements   []string
+			foreignStatements []string
+		)
+
+		for _, column := range columns {
+			definition := r.quote(column.Name) + " " + dumpColumnType(driverName, column)
+			if column.Autoincrement && driverName == "sqlite" {
+				hasInlinePrimary = true
+			} else {
+				if !column.Nullable {
+					definition += " not null"
+				}
+				if column.Default != "" && !(column.Autoincrement && driverName == "postgres") {
+					definition += " default " + dumpColumnDefault(driverName, column)
+				}
+				if driverName == "mysql" {
+					if index := strings.Index(strings.ToLower(column.Extra), "on update "); index >= 0 {
+						definition += " " + column.Extra[index:]
+					}
+				}
+			}
+
+			definitions = append(definitions, definition)
+		}
+
+		for _, index := range indexes {
+			if index.Primary {
+				if !hasInlinePrimary {
+					definitions = append(definitions, fmt.Sprintf("primary key (%s)", r.dumpColumnize(index.Columns)))
+				}
+
+				continue
+			}
+			if strings.HasPrefix(index.Name, "sqlite_autoindex") {
+				continue
+			}
+
+			unique := ""
+			if index.Unique {
+				unique = "unique "
+			}
+
+			indexStatements = append(indexStatements, fmt.Sprintf("create %sindex %s on %s (%s);",
+				unique, r.quote(index.Name), r.quote(table.Name), r.dumpColumnize(index.Columns)))
+		}
+
+		for _, foreignKey := range foreignKeys {
+			reference := fmt.Sprintf("foreign key (%s) references %s (%s)",
+				r.dumpColumnize(foreignKey.Columns), r.quote(foreignKey.ForeignTable), r.dumpColumnize(foreignKey.ForeignColumns))
+			if foreignKey.OnUpdate != "" {
+				reference += " on update " + foreignKey.OnUpdate
+			}
+			if foreignKey.OnDelete != "" {
+				reference += " on delete " + foreignKey.OnDelete
+			}
+
+			// SQLite can't add a foreign key to an existing table, and it doesn't check the referenced table
+			// when creating, so the foreign keys are defined in the create statement.
+			if driverName == "sqlite" {
+				definitions = append(definitions, reference)
+				continue
+			}
+
+			foreignStatements = append(foreignStatements, fmt.Sprintf("alter table %s add constraint %s %s;",
+				r.quote(table.Name), r.quote(foreignKey.Name), reference))
+		}
+
+		statements = append(statements, fmt.Sprintf("create table %s (\n  %s\n);", r.quote(table.Name), strings.Join(definitions, ",\n  ")))
+		statements = append(statements, indexStatements...)
+		constraints = append(constraints, foreignStatements...)
+	}
+
+	// The foreign keys are added after all tables are created, to avoid referencing a table that doesn't exist yet.
+	statements = append(statements, constraints...)
+
+	return strings.Join(statements, "\n\n"), nil
+}
+
+func (r *Schema) dumpMigrations(table string) (string, error) {
+	var migrations []dumpedMigration
+	if err := r.orm.Query().Table(table).OrderBy("id").Get(&migrations); err != nil {
+		return "", err
+	}
+
+	if len(migrations) == 0 {
+		return "", nil
+	}
+
+	values := collect.Map(migrations, func(migration dumpedMigration, _ int) string {
+		return fmt.Sprintf("('%s', %d)", strings.ReplaceAll(migration.Migration, "'", "''"), migration.Batch)
+	})
+
+	return fmt.Sprintf("insert into %s (%s, %s) values\n  %s;\n",
+		r.quote(r.prefix+table), r.quote("migration"), r.quote("batch"), strings.Join(values, ",\n  ")), nil
+}
+
+func (r *Schema) dumpColumnize(columns []string) string {
+	return strings.Join(collect.Map(columns, func(column string, _ int) string {
+		return r.quote(column)
+	}), ", ")
+}
+
+func (r *Schema) quote(value string) string {
+	switch r.driverName() {
+	case "mysql":
+		return "`" + strings.ReplaceAll(value, "`", "``") + "`"
+	case "sqlserver":
+		return "[" + strings.ReplaceAll(value, "]", "]]") + "]"
+	default:
+		return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+	}
+}
+
+func (r *Schema) driverName() string {
+	if r.driver == nil {
+		return ""
+	}
+
+	writers := r.driver.Pool().Writers
+	if len(writers) == 0 {
+		return ""
+	}
+
+	return writers[0].Driver
+}
+
+func dumpColumnType(driverName string, column driver.Column) string {
+	if !column.Autoincrement {
+		return column.Type
+	}
+
+	switch driverName {
+	case "postgres":
+		switch strings.ToLower(column.TypeName) {
+		case "int2", "smallint":
+			return "smallserial"
+		case "int4", "integer":
+			return "serial"
+		default:
+			return "bigserial"
+		}
+	case "mysql":
+		return column.Type + " auto_increment"
+	case "sqlite":
+		return "integer primary key autoincrement"
+	case "sqlserver":
+		return column.Type + " identity(1,1)"
+	default:
+		return column.Type
+	}
+}
+
+// dumpColumnDefault gets the default value of the column as a SQL expression. The other drivers return the
+// expressions, but MySQL returns the string values without quotes and the generated expressions without parentheses,
+// eg: "goravel" -> "'goravel'", "uuid()" -> "(uuid())".
+func dumpColumnDefault(driverName string, column driver.Column) string {
+	value := column.Default
+	if driverName != "mysql" {
+		return value
+	}
+
+	switch {
+	case mysqlDefaultKeywordRegex.MatchString(value):
+		return value
+	case strings.Contains(strings.ToUpper(column.Extra), "DEFAULT_GENERATED"):
+		if strings.HasPrefix(value, "(") {
+			return value
+		}
+
+		return "(" + value + ")"
+	// MariaDB returns the quoted string values.
+	case strings.HasPrefix(value, "'") || strings.HasPrefix(value, "b'"):
+		return value
+	case mysqlDefaultNumberRegex.MatchString(value):
+		return value
+	default:
+		return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	}
+}
+
+// splitStatements splits the SQL file into statements, the statements should be separated by a semicolon
+// at the end of the line.
+func splitStatements(content string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+	)
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current.Len() == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
@@ -0,0 +1,133 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
+)
+
+func TestDumpColumnDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		driverName string
+		column     driver.Column
+		expect     string
+	}{
+		{
+			name:       "postgres",
+			driverName: "postgres",
+			column:     driver.Column{Default: "'goravel'::character varying"},
+			expect:     "'goravel'::character varying",
+		},
+		{
+			name:       "mysql string",
+			driverName: "mysql",
+			column:     driver.Column{Default: "it's goravel"},
+			expect:     "'it''s goravel'",
+		},
+		{
+			name:       "mysql number",
+			driverName: "mysql",
+			column:     driver.Column{Default: "-1.5"},
+			expect:     "-1.5",
+		},
+		{
+			name:       "mysql current timestamp",
+			driverName: "mysql",
+			column:     driver.Column{Default: "CURRENT_TIMESTAMP(3)", Extra: "DEFAULT_GENERATED"},
+			expect:     "CURRENT_TIMESTAMP(3)",
+		},
+		{
+			name:       "mysql generated expression",
+			driverName: "mysql",
+			column:     driver.Column{Default: "uuid()", Extra: "DEFAULT_GENERATED"},
+			expect:     "(uuid())",
+		},
+		{
+			name:       "mariadb string",
+			driverName: "mysql",
+			column:     driver.Column{Default: "'goravel'"},
+			expect:     "'goravel'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, dumpColumnDefault(test.driverName, test.column))
+		})
+	}
+}
+
+func TestDumpColumnType(t *testing.T) {
+	tests := []struct {
+		name       string
+		driverName string
+		column     driver.Column
+		expect     string
+	}{
+		{
+			name:       "not autoincrement",
+			driverName: "postgres",
+			column:     driver.Column{Type: "varchar(255)"},
+			expect:     "varchar(255)",
+		},
+		{
+			name:       "postgres bigint",
+			driverName: "postgres",
+			column:     driver.Column{Type: "bigint", TypeName: "int8", Autoincrement: true},
+			expect:     "bigserial",
+		},
+		{
+			name:       "postgres integer",
+			driverName: "postgres",
+			column:     driver.Column{Type: "integer", TypeName: "int4", Autoincrement: true},
+			expect:     "serial",
+		},
+		{
+			name:       "mysql",
+			driverName: "mysql",
+			column:     driver.Column{Type: "bigint unsigned", Autoincrement: true},
+			expect:     "bigint unsigned auto_increment",
+		},
+		{
+			name:       "sqlite",
+			driverName: "sqlite",
+			column:     driver.Column{Type: "integer", Autoincrement: true},
+			expect:     "integer primary key autoincrement",
+		},
+		{
+			name:       "sqlserver",
+			driverName: "sqlserver",
+			column:     driver.Column{Type: "bigint", Autoincrement: true},
+			expect:     "bigint identity(1,1)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, dumpColumnType(test.driverName, test.column))
+		})
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	content := `-- The users table
+create table "users" (
+  "id" bigserial not null,
+  "name" varchar(255) not null default 'a;b'
+);
+
+create unique index "users_name_unique" on "users" ("name");
+
+insert into "migrations" ("migration", "batch") values
+  ('20240817214501_create_users_table', 1);
+`
+
+	assert.Equal(t, []string{
+		"create table \"users\" (\n  \"id\" bigserial not null,\n  \"name\" varchar(255) not null default 'a;b'\n)",
+		`create unique index "users_name_unique" on "users" ("name")`,
+		"insert into \"migrations\" (\"migration\", \"batch\") values\n  ('20240817214501_create_users_table', 1)",
+	}, splitStatements(content))
+}
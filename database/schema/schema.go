@@ -22,10 +22,14 @@ type Schema struct {
 	log        log.Log
 	migrations []contractsschema.Migration
 	orm        contractsorm.Orm
+	pretending *[]string
 	prefix     string
 	processor  driver.Processor
 	schema     string
 	goTypes    []contractsschema.GoType
+	// wiped records the tables, views and types that are dropped in the pretend mode, they still exist in the
+	// database, but they are treated as dropped by the following pretended statements, eg: HasTable returns false.
+	wiped map[string]bool
 }
 
 func NewSchema(config config.Config, log log.Log, orm contractsorm.Orm, driver driver.Driver, migrations []contractsschema.Migration) (*Schema, error) {
@@ -60,6 +64,9 @@ func (r *Schema) Connection(name string) contractsschema.Schema {
 		return nil
 	}
 
+	schema.pretending = r.pretending
+	schema.wiped = r.wiped
+
 	return schema
 }
 
@@ -93,6 +100,11 @@ func (r *Schema) DropAllTables() error {
 	}
 
 	sqls := r.grammar.CompileDropAllTables(r.schema, tables)
+	if r.pretend(sqls...) {
+		r.wipe("tables")
+
+		return nil
+	}
 	if sqls == nil {
 		return nil
 	}
@@ -114,8 +126,15 @@ func (r *Schema) DropAllTypes() error {
 		return err
 	}
 
+	sqls := r.grammar.CompileDropAllTypes(r.schema, types)
+	if r.pretend(sqls...) {
+		r.wipe("types")
+
+		return nil
+	}
+
 	return r.orm.Transaction(func(tx contractsorm.Query) error {
-		for _, sql := range r.grammar.CompileDropAllTypes(r.schema, types) {
+		for _, sql := range sqls {
 			if _, err := tx.Exec(sql); err != nil {
 				return err
 			}
@@ -132,6 +151,11 @@ func (r *Schema) DropAllViews() error {
 	}
 
 	sqls := r.grammar.CompileDropAllViews(r.schema, views)
+	if r.pretend(sqls...) {
+		r.wipe("views")
+
+		return nil
+	}
 	if sqls == nil {
 		return nil
 	}
@@ -174,6 +198,29 @@ func (r *Schema) Extend(extend contractsschema.Extension) contractsschema.Schema
 	return r
 }
 
+func (r *Schema) GetChecks(table string) ([]driver.Check, error) {
+	if r.isWiped("tables") {
+		return nil, nil
+	}
+
+	checkGrammar, ok := r.grammar.(driver.CompileCheckGrammar)
+	if !ok {
+		return nil, errors.SchemaCheckNotSupported
+	}
+
+	sql, err := checkGrammar.CompileChecks(r.schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []driver.Check
+	if err := r.orm.Query().Raw(sql).Scan(&checks); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
 func (r *Schema) GetColumnListing(table string) []string {
 	columns, err := r.GetColumns(table)
 	if err != nil {
@@ -190,6 +237,10 @@ func (r *Schema) GetColumnListing(table string) []string {
 }
 
 func (r *Schema) GetColumns(table string) ([]driver.Column, error) {
+	if r.isWiped("tables") {
+		return nil, nil
+	}
+
 	var dbColumns []driver.DBColumn
 	sql, err := r.grammar.CompileColumns(r.schema, table)
 	if err != nil {
@@ -200,7 +251,7 @@ func (r *Schema) GetColumns(table string) ([]driver.Column, error) {
 		return nil, err
 	}
 
-	return r.processor.ProcessColumns(dbColumns), nil
+	return fillColumns(r.processor.ProcessColumns(dbColumns), dbColumns), nil
 }
 
 func (r *Schema) GetConnection() string {
@@ -208,6 +259,10 @@ func (r *Schema) GetConnection() string {
 }
 
 func (r *Schema) GetForeignKeys(table string) ([]driver.ForeignKey, error) {
+	if r.isWiped("tables") {
+		return nil, nil
+	}
+
 	table = r.prefix + table
 
 	var dbForeignKeys []driver.DBForeignKey
@@ -234,6 +289,10 @@ func (r *Schema) GetIndexListing(table string) []string {
 }
 
 func (r *Schema) GetIndexes(table string) ([]driver.Index, error) {
+	if r.isWiped("tables") {
+		return nil, nil
+	}
+
 	var dbIndexes []driver.DBIndex
 	sql, err := r.grammar.CompileIndexes(r.schema, table)
 	if err != nil {
@@ -263,6 +322,10 @@ func (r *Schema) GetTableListing() []string {
 }
 
 func (r *Schema) GetTables() ([]driver.Table, error) {
+	if r.isWiped("tables") {
+		return nil, nil
+	}
+
 	var tables []driver.Table
 	if err := r.orm.Query().Raw(r.grammar.CompileTables(r.orm.DatabaseName())).Scan(&tables); err != nil {
 		return nil, err
@@ -272,6 +335,10 @@ func (r *Schema) GetTables() ([]driver.Table, error) {
 }
 
 func (r *Schema) GetTypes() ([]driver.Type, error) {
+	if r.isWiped("types") {
+		return nil, nil
+	}
+
 	var types []driver.Type
 	if err := r.orm.Query().Raw(r.grammar.CompileTypes()).Scan(&types); err != nil {
 		return nil, err
@@ -281,6 +348,10 @@ func (r *Schema) GetTypes() ([]driver.Type, error) {
 }
 
 func (r *Schema) GetViews() ([]driver.View, error) {
+	if r.isWiped("views") {
+		return nil, nil
+	}
+
 	var views []driver.View
 	if err := r.orm.Query().Raw(r.grammar.CompileViews(r.orm.DatabaseName())).Scan(&views); err != nil {
 		return nil, err
@@ -383,6 +454,10 @@ func (r *Schema) Orm() contractsorm.Orm {
 
 func (r *Schema) Prune() error {
 	if sql := r.grammar.CompilePrune(r.orm.DatabaseName()); len(sql) > 0 {
+		if r.pretend(sql) {
+			return nil
+		}
+
 		_, err := r.orm.Query().Exec(sql)
 
 		return err
@@ -391,6 +466,25 @@ func (r *Schema) Prune() error {
 	return nil
 }
 
+func (r *Schema) Pretend(callback func() error) ([]string, error) {
+	previous, previousWiped := r.pretending, r.wiped
+	sqls := make([]string, 0)
+	r.pretending = &sqls
+	// The nested pretend mode shares the wiped objects of the outer one, eg: the migrations pretended after db:wipe.
+	if r.wiped == nil {
+		r.wiped = make(map[string]bool)
+	}
+
+	defer func() {
+		r.pretending = previous
+		r.wiped = previousWiped
+	}()
+
+	err := callback()
+
+	return sqls, err
+}
+
 func (r *Schema) Register(migrations []contractsschema.Migration) {
 	existingSignatures := make(map[string]bool)
 
@@ -422,6 +516,10 @@ func (r *Schema) SetConnection(name string) {
 }
 
 func (r *Schema) Sql(sql string) error {
+	if r.pretend(sql) {
+		return nil
+	}
+
 	_, err := r.orm.Query().Exec(sql)
 
 	return err
@@ -439,6 +537,17 @@ func (r *Schema) Table(table string, callback func(table contractsschema.Bluepri
 }
 
 func (r *Schema) build(blueprint contractsschema.Blueprint) error {
+	if r.pretending != nil {
+		sqls, err := blueprint.ToSql(r.grammar)
+		if err != nil {
+			return err
+		}
+
+		r.pretend(sqls...)
+
+		return nil
+	}
+
 	if r.orm.Query().InTransaction() {
 		return blueprint.Build(r.orm.Query(), r.grammar)
 	}
@@ -448,6 +557,33 @@ func (r *Schema) build(blueprint contractsschema.Blueprint) error {
 	})
 }
 
+// isWiped reports whether the tables, views or types of the connection are dropped in the pretend mode.
+func (r *Schema) isWiped(object string) bool {
+	if len(r.wiped) == 0 {
+		return false
+	}
+
+	return r.wiped[r.orm.Name()+"."+object]
+}
+
+// wipe records the tables, views or types of the connection are dropped in the pretend mode.
+func (r *Schema) wipe(object string) {
+	if r.wiped != nil {
+		r.wiped[r.orm.Name()+"."+object] = true
+	}
+}
+
+// pretend records the SQL instead of executing it when the schema is in the pretend mode.
+func (r *Schema) pretend(sqls ...string) bool {
+	if r.pretending == nil {
+		return false
+	}
+
+	*r.pretending = append(*r.pretending, sqls...)
+
+	return true
+}
+
 func (r *Schema) createBlueprint(table string) contractsschema.Blueprint {
 	return NewBlueprint(r, r.prefix, table)
 }
@@ -498,6 +634,32 @@ func (r *Schema) extendGoTypes(overrides []contractsschema.GoType) {
 	r.goTypes = result
 }
 
+// fillColumns fills the charset and the generated expression of the columns from the raw columns, the processors of
+// the drivers only map the attributes they know about.
+func fillColumns(columns []driver.Column, dbColumns []driver.DBColumn) []driver.Column {
+	for i := range columns {
+		for _, dbColumn := range dbColumns {
+			if dbColumn.Name != columns[i].Name {
+				continue
+			}
+
+			if columns[i].Charset == "" {
+				columns[i].Charset = dbColumn.Charset
+			}
+			if columns[i].Generation == "" {
+				columns[i].Generation = dbColumn.Generation
+			}
+			if columns[i].GenerationExpression == "" {
+				columns[i].GenerationExpression = dbColumn.GenerationExpression
+			}
+
+			break
+		}
+	}
+
+	return columns
+}
+
 func defaultGoTypes() []contractsschema.GoType {
 	return []contractsschema.GoType{
 		// Special cases first - these need to be matched before general patterns
@@ -1,11 +1,19 @@
 package schema
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
 	contractsschema "github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksdriver "github.com/rusmanplatd/goravelframework/mocks/database/driver"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
 )
 
 type SchemaTestSuite struct {
@@ -16,6 +24,73 @@ func TestSchemaTestSuite(t *testing.T) {
 	suite.Run(t, new(SchemaTestSuite))
 }
 
+func (r *SchemaTestSuite) TestFillColumns() {
+	columns := fillColumns([]driver.Column{
+		{Name: "id", Type: "bigint"},
+		{Name: "total", Type: "decimal(8,2)", Generation: "stored", GenerationExpression: "price * quantity"},
+		{Name: "name", Type: "varchar(255)", Charset: "latin1"},
+	}, []driver.DBColumn{
+		{Name: "id", Type: "bigint"},
+		{Name: "total", Type: "decimal(8,2)", Generation: "virtual", GenerationExpression: "price"},
+		{Name: "name", Type: "varchar(255)", Charset: "utf8mb4", Collation: "utf8mb4_unicode_ci"},
+		{Name: "full_name", Type: "varchar(255)", Generation: "virtual", GenerationExpression: "concat(first_name, last_name)"},
+	})
+
+	r.Equal([]driver.Column{
+		{Name: "id", Type: "bigint"},
+		{Name: "total", Type: "decimal(8,2)", Generation: "stored", GenerationExpression: "price * quantity"},
+		{Name: "name", Type: "varchar(255)", Charset: "latin1"},
+	}, columns)
+
+	columns = fillColumns([]driver.Column{
+		{Name: "name", Type: "varchar(255)", Collation: "utf8mb4_unicode_ci"},
+		{Name: "full_name", Type: "varchar(255)"},
+	}, []driver.DBColumn{
+		{Name: "name", Type: "varchar(255)", Charset: "utf8mb4", Collation: "utf8mb4_unicode_ci"},
+		{Name: "full_name", Type: "varchar(255)", Generation: "virtual", GenerationExpression: "concat(first_name, last_name)"},
+	})
+
+	r.Equal([]driver.Column{
+		{Name: "name", Type: "varchar(255)", Charset: "utf8mb4", Collation: "utf8mb4_unicode_ci"},
+		{Name: "full_name", Type: "varchar(255)", Generation: "virtual", GenerationExpression: "concat(first_name, last_name)"},
+	}, columns)
+}
+
+func (r *SchemaTestSuite) TestGetChecks() {
+	schema := getSchema()
+	schema.grammar = mocksdriver.NewGrammar(r.T())
+
+	checks, err := schema.GetChecks("users")
+	r.Equal(errors.SchemaCheckNotSupported, err)
+	r.Nil(checks)
+
+	grammar := &checkGrammar{Grammar: mocksdriver.NewGrammar(r.T()), CompileCheckGrammar: mocksdriver.NewCompileCheckGrammar(r.T())}
+	mockOrm := mocksorm.NewOrm(r.T())
+	mockQuery := mocksorm.NewQuery(r.T())
+	schema.grammar = grammar
+	schema.orm = mockOrm
+	schema.schema = "public"
+
+	grammar.CompileCheckGrammar.EXPECT().CompileChecks("public", "users").Return("select checks", nil).Once()
+	mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Raw("select checks").Return(mockQuery).Once()
+	mockQuery.EXPECT().Scan(mock.Anything).RunAndReturn(func(dest any) error {
+		*dest.(*[]driver.Check) = []driver.Check{{Name: "users_age_check", Expression: "age >= 18"}}
+
+		return nil
+	}).Once()
+
+	checks, err = schema.GetChecks("users")
+	r.NoError(err)
+	r.Equal([]driver.Check{{Name: "users_age_check", Expression: "age >= 18"}}, checks)
+
+	grammar.CompileCheckGrammar.EXPECT().CompileChecks("public", "users").Return("", assert.AnError).Once()
+
+	checks, err = schema.GetChecks("users")
+	r.Equal(assert.AnError, err)
+	r.Nil(checks)
+}
+
 func (r *SchemaTestSuite) TestExtendGoTypes() {
 	defaultLen := len(defaultGoTypes())
 	tests := []struct {
@@ -140,6 +215,94 @@ func (r *SchemaTestSuite) TestExtendGoTypes() {
 	}
 }
 
+func (r *SchemaTestSuite) TestPretend() {
+	schema := getSchema()
+
+	sqls, err := schema.Pretend(func() error {
+		if err := schema.Sql("create table users (id int)"); err != nil {
+			return err
+		}
+
+		nested, err := schema.Pretend(func() error {
+			return schema.Sql("create table agents (id int)")
+		})
+		r.NoError(err)
+		r.Equal([]string{"create table agents (id int)"}, nested)
+
+		return schema.Sql("drop table users")
+	})
+
+	r.NoError(err)
+	r.Equal([]string{"create table users (id int)", "drop table users"}, sqls)
+	r.Nil(schema.pretending)
+
+	sqls, err = schema.Pretend(func() error {
+		return assert.AnError
+	})
+	r.Equal(assert.AnError, err)
+	r.Empty(sqls)
+
+	// The statements of the dump are recorded even if the driver loads the dump itself.
+	path := filepath.Join(r.T().TempDir(), "schema.sql")
+	r.NoError(os.WriteFile(path, []byte("create table users (id int);\n\ninsert into migrations (migration, batch) values\n  ('create_users_table', 1);\n"), 0644))
+	schema.driver = &dumperDriver{Driver: mocksdriver.NewDriver(r.T()), SchemaDumper: mocksdriver.NewSchemaDumper(r.T())}
+
+	sqls, err = schema.Pretend(func() error {
+		return schema.Load(path)
+	})
+	r.NoError(err)
+	r.Equal([]string{"create table users (id int)", "insert into migrations (migration, batch) values\n  ('create_users_table', 1)"}, sqls)
+}
+
+func (r *SchemaTestSuite) TestPretendWipe() {
+	mockGrammar := mocksdriver.NewGrammar(r.T())
+	mockOrm := mocksorm.NewOrm(r.T())
+	mockQuery := mocksorm.NewQuery(r.T())
+	schema := getSchema()
+	schema.grammar = mockGrammar
+	schema.orm = mockOrm
+
+	tables := []driver.Table{{Name: "users"}}
+	mockOrm.EXPECT().Name().Return("postgres")
+	mockOrm.EXPECT().DatabaseName().Return("goravel")
+	mockOrm.EXPECT().Query().Return(mockQuery)
+	mockGrammar.EXPECT().CompileTables("goravel").Return("select tables")
+	mockQuery.EXPECT().Raw("select tables").Return(mockQuery)
+	mockQuery.EXPECT().Scan(mock.Anything).RunAndReturn(func(dest any) error {
+		*dest.(*[]driver.Table) = tables
+
+		return nil
+	}).Times(3)
+	mockGrammar.EXPECT().CompileDropAllTables("", tables).Return([]string{"drop table \"users\""}).Once()
+
+	sqls, err := schema.Pretend(func() error {
+		r.True(schema.HasTable("users"))
+
+		// The tables still exist, but they are treated as dropped by the following pretended statements.
+		wiped, err := schema.Pretend(func() error {
+			return schema.DropAllTables()
+		})
+		r.NoError(err)
+		r.Equal([]string{"drop table \"users\""}, wiped)
+
+		r.False(schema.HasTable("users"))
+		r.False(schema.HasColumn("users", "id"))
+		r.False(schema.HasIndex("users", "users_pkey"))
+
+		return nil
+	})
+	r.NoError(err)
+	r.Empty(sqls)
+	r.Nil(schema.wiped)
+
+	r.True(schema.HasTable("users"))
+}
+
+type dumperDriver struct {
+	*mocksdriver.Driver
+	*mocksdriver.SchemaDumper
+}
+
 func getSchema() *Schema {
 	return &Schema{
 		goTypes: defaultGoTypes(),
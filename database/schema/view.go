@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/collect"
+)
+
+func (r *Schema) CreateMaterializedView(name string, query any) error {
+	if err := r.supportsMaterializedView(); err != nil {
+		return errors.SchemaFailedToCreateView.Args(name, err)
+	}
+
+	sql, err := viewQuery(query)
+	if err != nil {
+		return errors.SchemaFailedToCreateView.Args(name, err)
+	}
+
+	if err := r.execute(fmt.Sprintf("create materialized view %s as %s", r.quoteView(name), sql)); err != nil {
+		return errors.SchemaFailedToCreateView.Args(name, err)
+	}
+
+	return nil
+}
+
+func (r *Schema) CreateView(name string, query any, orReplace ...bool) error {
+	sql, err := viewQuery(query)
+	if err != nil {
+		return errors.SchemaFailedToCreateView.Args(name, err)
+	}
+
+	var sqls []string
+	create := "create view"
+	if len(orReplace) > 0 && orReplace[0] {
+		switch r.driverName() {
+		case "sqlite":
+			// SQLite doesn't support "create or replace view".
+			sqls = append(sqls, fmt.Sprintf("drop view if exists %s", r.quoteView(name)))
+		case "sqlserver":
+			create = "create or alter view"
+		default:
+			create = "create or replace view"
+		}
+	}
+
+	sqls = append(sqls, fmt.Sprintf("%s %s as %s", create, r.quoteView(name), sql))
+	if err := r.execute(sqls...); err != nil {
+		return errors.SchemaFailedToCreateView.Args(name, err)
+	}
+
+	return nil
+}
+
+func (r *Schema) DropMaterializedView(name string) error {
+	if err := r.supportsMaterializedView(); err != nil {
+		return errors.SchemaFailedToDropView.Args(name, err)
+	}
+
+	if err := r.execute(fmt.Sprintf("drop materialized view %s", r.quoteView(name))); err != nil {
+		return errors.SchemaFailedToDropView.Args(name, err)
+	}
+
+	return nil
+}
+
+func (r *Schema) DropView(name string) error {
+	if err := r.execute(fmt.Sprintf("drop view %s", r.quoteView(name))); err != nil {
+		return errors.SchemaFailedToDropView.Args(name, err)
+	}
+
+	return nil
+}
+
+func (r *Schema) RefreshMaterializedView(name string, concurrently ...bool) error {
+	if err := r.supportsMaterializedView(); err != nil {
+		return errors.SchemaFailedToRefreshView.Args(name, err)
+	}
+
+	refresh := "refresh materialized view"
+	if len(concurrently) > 0 && concurrently[0] {
+		refresh += " concurrently"
+	}
+
+	if err := r.execute(fmt.Sprintf("%s %s", refresh, r.quoteView(name))); err != nil {
+		return errors.SchemaFailedToRefreshView.Args(name, err)
+	}
+
+	return nil
+}
+
+// execute runs the statements, they are run in a transaction if there are multiple statements.
+func (r *Schema) execute(sqls ...string) error {
+	if r.pretend(sqls...) {
+		return nil
+	}
+
+	if len(sqls) == 1 {
+		_, err := r.orm.Query().Exec(sqls[0])
+
+		return err
+	}
+
+	return r.orm.Transaction(func(tx contractsorm.Query) error {
+		for _, sql := range sqls {
+			if _, err := tx.Exec(sql); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// quoteView quotes the view name, the name can contain the schema, eg: reporting.monthly_sales.
+func (r *Schema) quoteView(name string) string {
+	return strings.Join(collect.Map(strings.Split(name, "."), func(segment string, _ int) string {
+		return r.quote(segment)
+	}), ".")
+}
+
+func (r *Schema) supportsMaterializedView() error {
+	if driverName := r.driverName(); driverName != "postgres" {
+		return errors.SchemaMaterializedViewNotSupported.Args(driverName)
+	}
+
+	return nil
+}
+
+// viewQuery gets the SQL of the view, the bindings of the db.Query are inlined, because the view definition can't
+// contain placeholders. The db.Query logs the error and returns an empty SQL if it fails to build the SQL.
+func viewQuery(query any) (string, error) {
+	var sql string
+	switch query := query.(type) {
+	case string:
+		sql = query
+	case contractsdb.Query:
+		sql = query.ToRawSql().Get()
+	default:
+		return "", errors.SchemaInvalidViewQuery.Args(query)
+	}
+
+	if strings.TrimSpace(sql) == "" {
+		return "", errors.SchemaEmptyViewQuery
+	}
+
+	return strings.TrimRight(strings.TrimSpace(sql), ";"), nil
+}
@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/contracts/database"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksdb "github.com/rusmanplatd/goravelframework/mocks/database/db"
+	mocksdriver "github.com/rusmanplatd/goravelframework/mocks/database/driver"
+)
+
+func TestViews(t *testing.T) {
+	tests := []struct {
+		name       string
+		driverName string
+		callback   func(schema *Schema) error
+		expectSqls []string
+		expectErr  error
+	}{
+		{
+			name:       "create view",
+			driverName: "mysql",
+			callback: func(schema *Schema) error {
+				return schema.CreateView("active_users", "select * from users where active = 1;")
+			},
+			expectSqls: []string{"create view `active_users` as select * from users where active = 1"},
+		},
+		{
+			name:       "create or replace view",
+			driverName: "postgres",
+			callback: func(schema *Schema) error {
+				return schema.CreateView("reporting.active_users", "select * from users", true)
+			},
+			expectSqls: []string{`create or replace view "reporting"."active_users" as select * from users`},
+		},
+		{
+			name:       "create or replace view with sqlite",
+			driverName: "sqlite",
+			callback: func(schema *Schema) error {
+				return schema.CreateView("active_users", "select * from users", true)
+			},
+			expectSqls: []string{
+				`drop view if exists "active_users"`,
+				`create view "active_users" as select * from users`,
+			},
+		},
+		{
+			name:       "create or replace view with sqlserver",
+			driverName: "sqlserver",
+			callback: func(schema *Schema) error {
+				return schema.CreateView("active_users", "select * from users", true)
+			},
+			expectSqls: []string{"create or alter view [active_users] as select * from users"},
+		},
+		{
+			name:       "create view with db query",
+			driverName: "postgres",
+			callback: func(schema *Schema) error {
+				mockQuery := mocksdb.NewQuery(t)
+				mockToSql := mocksdb.NewToSql(t)
+				mockQuery.EXPECT().ToRawSql().Return(mockToSql).Once()
+				mockToSql.EXPECT().Get().Return("SELECT * FROM users WHERE active = true").Once()
+
+				return schema.CreateView("active_users", mockQuery)
+			},
+			expectSqls: []string{`create view "active_users" as SELECT * FROM users WHERE active = true`},
+		},
+		{
+			name:       "create view with invalid query",
+			driverName: "postgres",
+			callback: func(schema *Schema) error {
+				return schema.CreateView("active_users", 1)
+			},
+			expectSqls: []string{},
+			expectErr:  errors.SchemaFailedToCreateView.Args("active_users", errors.SchemaInvalidViewQuery.Args(1)),
+		},
+		{
+			name:       "create view with empty query",
+			driverName: "postgres",
+			callback: func(schema *Schema) error {
+				return schema.CreateView("active_users", " ")
+			},
+			expectSqls: []string{},
+			expectErr:  errors.SchemaFailedToCreateView.Args("active_users", errors.SchemaEmptyViewQuery),
+		},
+		{
+			name:       "drop view",
+			driverName: "mysql",
+			callback: func(schema *Schema) error {
+				return schema.DropView("active_users")
+			},
+			expectSqls: []string{"drop view `active_users`"},
+		},
+		{
+			name:       "materialized view",
+			driverName: "postgres",
+			callback: func(schema *Schema) error {
+				if err := schema.CreateMaterializedView("monthly_sales", "select * from sales"); err != nil {
+					return err
+				}
+				if err := schema.RefreshMaterializedView("monthly_sales"); err != nil {
+					return err
+				}
+				if err := schema.RefreshMaterializedView("monthly_sales", true); err != nil {
+					return err
+				}
+
+				return schema.DropMaterializedView("monthly_sales")
+			},
+			expectSqls: []string{
+				`create materialized view "monthly_sales" as select * from sales`,
+				`refresh materialized view "monthly_sales"`,
+				`refresh materialized view concurrently "monthly_sales"`,
+				`drop materialized view "monthly_sales"`,
+			},
+		},
+		{
+			name:       "materialized view is not supported",
+			driverName: "mysql",
+			callback: func(schema *Schema) error {
+				return schema.CreateMaterializedView("monthly_sales", "select * from sales")
+			},
+			expectSqls: []string{},
+			expectErr:  errors.SchemaFailedToCreateView.Args("monthly_sales", errors.SchemaMaterializedViewNotSupported.Args("mysql")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockDriver := mocksdriver.NewDriver(t)
+			mockDriver.EXPECT().Pool().Return(database.Pool{
+				Writers: []database.Config{{Driver: test.driverName}},
+			}).Maybe()
+
+			schema := &Schema{driver: mockDriver}
+			sqls, err := schema.Pretend(func() error {
+				return test.callback(schema)
+			})
+
+			assert.Equal(t, test.expectErr, err)
+			assert.Equal(t, test.expectSqls, sqls)
+		})
+	}
+}
@@ -3,21 +3,28 @@ package database
 import (
 	"context"
 	"fmt"
+	"time"
 
 	contractsbinding "github.com/rusmanplatd/goravelframework/contracts/binding"
+	contractscache "github.com/rusmanplatd/goravelframework/contracts/cache"
 	contractsconsole "github.com/rusmanplatd/goravelframework/contracts/console"
+	contractscrypt "github.com/rusmanplatd/goravelframework/contracts/crypt"
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
 	"github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	contractsevent "github.com/rusmanplatd/goravelframework/contracts/event"
 	"github.com/rusmanplatd/goravelframework/contracts/foundation"
 	"github.com/rusmanplatd/goravelframework/database/console"
 	consolemigration "github.com/rusmanplatd/goravelframework/database/console/migration"
 	"github.com/rusmanplatd/goravelframework/database/db"
 	"github.com/rusmanplatd/goravelframework/database/migration"
 	databaseorm "github.com/rusmanplatd/goravelframework/database/orm"
+	"github.com/rusmanplatd/goravelframework/database/orm/casts"
 	databaseschema "github.com/rusmanplatd/goravelframework/database/schema"
 	databaseseeder "github.com/rusmanplatd/goravelframework/database/seeder"
 	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/binding"
 	"github.com/rusmanplatd/goravelframework/support/color"
+	supportdatabase "github.com/rusmanplatd/goravelframework/support/database"
 )
 
 type ServiceProvider struct {
@@ -127,9 +134,73 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 }
 
 func (r *ServiceProvider) Boot(app foundation.Application) {
+	if config := app.MakeConfig(); config != nil {
+		supportdatabase.SetCursorKey(config.GetString("app.key"))
+	}
+
+	r.registerSlowQueryEvent(app)
+	r.registerQueryCache(app)
+	r.registerCastCrypt(app)
 	r.registerCommands(app)
 }
 
+// registerQueryCache sets the cache store of the remembered queries, the cache facade is resolved lazily to avoid
+// the circular dependency, the queries are not cached if the cache module is not installed.
+func (r *ServiceProvider) registerQueryCache(app foundation.Application) {
+	config := app.MakeConfig()
+	if config == nil {
+		return
+	}
+
+	store := config.GetString("database.cache.store")
+	db.SetQueryCache(func() contractscache.Driver {
+		instance, err := app.Make(contractsbinding.Cache)
+		if err != nil {
+			return nil
+		}
+
+		cache, ok := instance.(contractscache.Cache)
+		if !ok {
+			return nil
+		}
+		if store != "" {
+			return cache.Store(store)
+		}
+
+		return cache
+	}, config.GetBool("database.cache.flush_on_write", true))
+}
+
+// registerCastCrypt sets the crypt of the encrypted casts, the crypt facade is resolved lazily to avoid the
+// circular dependency.
+func (r *ServiceProvider) registerCastCrypt(app foundation.Application) {
+	casts.SetCrypt(func() contractscrypt.Crypt {
+		instance, err := app.Make(contractsbinding.Crypt)
+		if err != nil {
+			return nil
+		}
+
+		crypt, _ := instance.(contractscrypt.Crypt)
+
+		return crypt
+	})
+}
+
+// registerSlowQueryEvent dispatches the QueryExecuted event for the slow queries, the event facade is resolved
+// lazily to avoid the circular dependency, it's ignored if the event module is not installed.
+func (r *ServiceProvider) registerSlowQueryEvent(app foundation.Application) {
+	db.SetSlowQueryEvent(func(event contractsdb.QueryExecuted) {
+		instance, err := app.Make(contractsbinding.Event)
+		if err != nil {
+			return
+		}
+
+		if eventInstance, ok := instance.(contractsevent.Instance); ok {
+			_, _ = eventInstance.Dispatch(event)
+		}
+	})
+}
+
 func (r *ServiceProvider) registerCommands(app foundation.Application) {
 	artisan := app.MakeArtisan()
 	config := app.MakeConfig()
@@ -138,23 +209,28 @@ func (r *ServiceProvider) registerCommands(app foundation.Application) {
 	seeder := app.MakeSeeder()
 
 	if artisan != nil && config != nil && log != nil && schema != nil && seeder != nil {
-		migrator := migration.NewMigrator(artisan, schema, config.GetString("database.migrations.table"))
+		migrator := migration.NewMigrator(artisan, schema, config.GetString("database.migrations.table"), time.Duration(config.GetInt("database.migrations.lock_timeout", 60))*time.Second)
 		artisan.Register([]contractsconsole.Command{
 			consolemigration.NewMigrateMakeCommand(app, migrator),
+			consolemigration.NewMigrateMakeViewCommand(app),
 			consolemigration.NewMigrateCommand(migrator),
 			consolemigration.NewMigrateRollbackCommand(migrator),
 			consolemigration.NewMigrateResetCommand(migrator),
 			consolemigration.NewMigrateRefreshCommand(artisan),
 			consolemigration.NewMigrateFreshCommand(artisan, migrator),
 			consolemigration.NewMigrateStatusCommand(migrator),
+			consolemigration.NewMigrateDiffCommand(app, config, schema),
 			console.NewModelMakeCommand(artisan, schema),
 			console.NewObserverMakeCommand(),
+			console.NewCastMakeCommand(),
+			console.NewPruneCommand(config),
 			console.NewSeedCommand(config, seeder),
 			console.NewSeederMakeCommand(app),
 			console.NewFactoryMakeCommand(),
 			console.NewTableCommand(config, schema),
 			console.NewShowCommand(config, schema),
 			console.NewWipeCommand(config, schema),
+			console.NewDumpCommand(app, config, schema),
 		})
 	}
 }
@@ -99,29 +99,65 @@ func (s Stubs) Config() []Config {
 				"Pool configuration",
 			},
 		},
-		// // Sets the threshold for slow queries in milliseconds, the slow query will be logged.
+		// // Sets the threshold for slow queries in milliseconds, the slow query will be logged and the
+		// // QueryExecuted event will be dispatched. It can be overridden by database.connections.{name}.slow_threshold.
 		// // Unit: Millisecond
 		// "slow_threshold": 200,
 		{
 			Key:   "slow_threshold",
 			Value: `200`,
 			Annotations: []string{
-				"Sets the threshold for slow queries in milliseconds, the slow query will be logged.",
+				"Sets the threshold for slow queries in milliseconds, the slow query will be logged and the",
+				"QueryExecuted event will be dispatched. It can be overridden by database.connections.{name}.slow_threshold.",
 				"Unit: Millisecond",
 			},
 		},
+		// // Query cache configuration, the results of the queries that call Remember are stored in the
+		// // given cache store, the default cache store is used if it's empty. The cached results of a table
+		// // are flushed after the table is written if flush_on_write is true.
+		// "cache": map[string]any{
+		// 	"store":          "",
+		// 	"flush_on_write": true,
+		// },
+		{
+			Key: "cache",
+			Value: `map[string]any{
+					"store":          "",
+					"flush_on_write": true,
+				}`,
+			Annotations: []string{
+				"Query cache configuration, the results of the queries that call Remember are stored in the",
+				"given cache store, the default cache store is used if it's empty. The cached results of a table",
+				"are flushed after the table is written if flush_on_write is true.",
+			},
+		},
+		// // The prunable models that are pruned by the model:prune command, the models should implement
+		// // orm.Prunable or orm.MassPrunable, eg: []any{&models.AuditLog{}}.
+		// "prunable": []any{},
+		{
+			Key:   "prunable",
+			Value: `[]any{}`,
+			Annotations: []string{
+				"The prunable models that are pruned by the model:prune command, the models should implement",
+				"orm.Prunable or orm.MassPrunable, eg: []any{&models.AuditLog{}}.",
+			},
+		},
 		// // Migration Repository Table
 		// //
 		// // This table keeps track of all the migrations that have already run for
 		// // your application. Using this information, we can determine which of
 		// // the migrations on disk haven't actually been run in the database.
+		// // The lock_timeout is the max seconds to wait for other processes to
+		// // finish running the migrations.
 		// "migrations": map[string]any{
-		// 	"table": "migrations",
+		// 	"table":        "migrations",
+		// 	"lock_timeout": 60,
 		// },
 		{
 			Key: "migrations",
 			Value: `map[string]any{
-					"table": "migrations",
+					"table":        "migrations",
+					"lock_timeout": 60,
 				}`,
 			Annotations: []string{
 				"Migration Repository Table",
@@ -129,6 +165,8 @@ func (s Stubs) Config() []Config {
 				"This table keeps track of all the migrations that have already run for",
 				"your application. Using this information, we can determine which of",
 				"the migrations on disk haven't actually been run in the database.",
+				"The lock_timeout is the max seconds to wait for other processes to",
+				"finish running the migrations.",
 			},
 		},
 	}
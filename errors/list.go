@@ -11,6 +11,7 @@ var (
 	LogFacadeNotSet         = New("log facade is not initialized")
 	MailFacadeNotSet        = New("mail facade is not initialized")
 	OrmFacadeNotSet         = New("orm facade is not initialized")
+	OutboxFacadeNotSet      = New("outbox facade is not initialized")
 	QueueFacadeNotSet       = New("queue facade is not initialized")
 	RateLimiterFacadeNotSet = New("rate limiter facade is not initialized")
 	ScheduleFacadeNotSet    = New("schedule facade is not initialized")
@@ -57,6 +58,7 @@ var (
 	ConsoleFileAlreadyExists                 = New("the %s already exists. Use the --force or -f flag to overwrite")
 	ConsoleFailedToConfirm                   = New("failed to confirm the action: %v")
 	ConsolePruneFailed                       = New("prune failed: %v")
+	ConsoleSchemaDumpFailed                  = New("schema dump failed: %v")
 	ConsoleRunInProduction                   = New("please use the --force option if you want to run the command in production")
 
 	CryptAppKeyNotSet        = New("APP_KEY is required in artisan environment")
@@ -73,8 +75,25 @@ var (
 	DatabaseUnsupportedType             = New("unsupported type: %s, expected %s")
 	DatabaseInvalidArgumentNumber       = New("invalid argument number: %s, expected %s")
 	DatabaseTransactionNotStarted       = New("transaction not started")
+	DatabaseTransactionAlreadyStarted   = New("the queries of the %s connection are already run in a transaction")
+	DatabaseConnectionNotInitialized    = New("the %s connection is not initialized")
+	DatabaseCursorInvalid               = New("invalid pagination cursor")
+	DatabaseCursorInvalidOrder          = New("cursor pagination doesn't support the order: %s")
+	DatabaseCursorColumnNotFound        = New("cursor column %s is not found in the result")
+	DatabaseCursorKeyNotSet             = New("the key of the pagination cursors is empty, please set app.key")
+	DatabaseCursorOrderMismatch         = New("the pagination cursor is created for the orders %s, but the query is ordered by %s")
+	DatabaseCursorInvalidLimit          = New("the cursor pagination limit must be greater than 0, got %d")
 	DatabaseFailedToGetSql              = New("failed to get sql: %v")
 	DatabaseDataIsEmpty                 = New("data can't be empty")
+	DatabaseIgnoreUniqueByIsRequired    = New("unique by columns are required for insert or ignore on %s")
+	DatabaseUpsertUniqueByIsRequired    = New("unique by columns are required for upsert")
+	DatabaseQueryCacheFlushFailed       = New("failed to flush the query cache of %s")
+	DatabaseQueryCacheUnsupportedModel  = New("the results of %s can't be cached: %s")
+	DatabaseFullTextLanguageInvalid     = New("invalid full-text search language: %s")
+	DatabaseFullTextNotSupported        = New("full-text search is not supported by %s")
+	DatabaseFullTextModeNotSupported    = New("full-text search mode %s is not supported by %s")
+	DatabaseFullTextOrderNotSupported   = New("ordering by the full-text relevance is not supported by %s")
+	DatabaseFullTextTableNotSet         = New("the table is required to search the columns %s by full-text on %s")
 
 	DockerUnknownContainerType           = New("unknown container type")
 	DockerInsufficientDatabaseContainers = New("the number of database container is not enough, expect: %d, got: %d")
@@ -117,36 +136,57 @@ var (
 
 	MiddlewareRegisterFailed = New("failed to register middleware '%s': %v")
 
-	MigrationCreateFailed    = New("create migration failed: %v")
-	MigrationFreshFailed     = New("migration fresh failed: %v")
-	MigrationGetStatusFailed = New("get migration status failed: %v")
-	MigrationMigrateFailed   = New("migrate failed: %v")
-	MigrationNameIsRequired  = New("migration name cannot be empty")
-	MigrationRefreshFailed   = New("migration refresh failed: %v")
-	MigrationRegisterFailed  = New("migration register failed: %v")
-	MigrationResetFailed     = New("migration reset failed: %v")
-	MigrationRollbackFailed  = New("migration rollback failed: %v")
-
+	MigrationCreateFailed     = New("create migration failed: %v")
+	MigrationDiffFailed       = New("diff migration failed: %v")
+	MigrationFreshFailed      = New("migration fresh failed: %v")
+	MigrationGetStatusFailed  = New("get migration status failed: %v")
+	MigrationLocked           = New("the migrations are being run by another process")
+	MigrationLockTimeout      = New("timed out after %s waiting for the migration lock")
+	MigrationMigrateFailed    = New("migrate failed: %v")
+	MigrationNameIsRequired   = New("migration name cannot be empty")
+	MigrationRefreshFailed    = New("migration refresh failed: %v")
+	MigrationRegisterFailed   = New("migration register failed: %v")
+	MigrationResetFailed      = New("migration reset failed: %v")
+	MigrationRollbackFailed   = New("migration rollback failed: %v")
+	MigrationUnregisterFailed = New("migration unregister failed: %v")
+
+	OrmCastCryptNotSet             = New("crypt is not initialized, it's required by the encrypted casts")
+	OrmCastFieldNotFound           = New("the cast field %s is not found in the model %s")
+	OrmCastInvalidEnumValue        = New("invalid enum value %v, expected one of %s")
+	OrmCastInvalidValue            = New("invalid value type %T for the %s cast")
 	OrmDriverNotSupported          = New("invalid driver: %s, only support mysql, postgres, sqlite and sqlserver")
 	OrmFailedToGenerateDNS         = New("failed to generate DSN, please check the database configuration")
 	OrmFactoryMissingAttributes    = New("failed to get raw attributes")
 	OrmFactoryMissingMethod        = New("%s does not find factory method")
+	OrmFactoryRelationNotFound     = New("the relationship %s is not found in the model %s")
+	OrmFactoryStateNotFound        = New("the state %s is not found in the factory of %s")
 	OrmInitConnection              = New("init %s connection error: %v")
 	OrmMissingWhereClause          = New("WHERE conditions required")
+	OrmModelPrimaryKeyNotFound     = New("the primary key of the model %s is not found")
 	OrmNoDialectorsFound           = New("no dialectors found")
 	OrmQueryAssociationsConflict   = New("cannot set orm.Associations and other fields at the same time")
 	OrmQueryConditionRequired      = New("query condition is required")
 	OrmQueryEmptyId                = New("id can't be empty")
 	OrmQueryEmptyRelation          = New("relation can't be empty")
+	OrmQueryInvalidChunkSize       = New("the chunk size must be greater than 0, got %d")
 	OrmQueryInvalidModel           = New("invalid model %s")
 	OrmQueryInvalidParameter       = New("parameter error, please check the document")
 	OrmQueryModelNotPointer        = New("model must be pointer")
+	OrmQueryModelNotSet            = New("model is required, please set it by Model()")
 	OrmQuerySelectAndOmitsConflict = New("cannot set Select and Omits at the same time")
 	OrmRecordNotFound              = New("record not found")
+	OrmStaleModel                  = New("the model is stale, it has been modified since it was retrieved")
 	OrmDeletedAtColumnNotFound     = New("deleted at column not found")
+	OrmCascadeRelationNotFound     = New("the cascaded relationship %s is not found in the model %s")
+	OrmCascadeRelationNotSupported = New("the cascaded relationship %s of the model %s is not a has one or has many relationship")
+	OrmTenantNotFound              = New("the model %s belongs to a tenant, but the query has no tenant, set it by Orm().WithContext(ctx) or query all the tenants by tenancy.WithoutTenant(ctx)")
 	OrmJsonContainsInvalidBinding  = New("invalid value for JSON contains: %v")
 	OrmJsonColumnUpdateInvalid     = New("invalid value for JSON column update: %v")
 
+	OutboxEventNotRegistered = New("outbox event %s is not registered")
+	OutboxInvalidMessageType = New("invalid outbox message type: %s")
+	OutboxRelayFailed        = New("failed to relay outbox messages: %v")
+
 	PackageConfigKeyExists       = New("config key '%s' already exists,using ReplaceConfig instead if you want to update it")
 	PackageFacadeNotFound        = New("facade %s not found")
 	PackageMatchGoNodeFail       = New("%d out of %d matchers did not match")
@@ -195,17 +235,27 @@ var (
 	RouteDefaultDriverNotSet = New("please set default driver")
 	RouteInvalidDriver       = New("init %s route driver fail: route must be implement route.Route or func() (route.Route, error)")
 
-	SchemaDriverNotSupported   = New("driver %s is not supported")
-	SchemaFailedToCreateTable  = New("failed to create %s table: %v")
-	SchemaFailedToChangeTable  = New("failed to change %s table: %v")
-	SchemaFailedToDropTable    = New("failed to drop %s table: %v")
-	SchemaFailedToDropColumns  = New("failed to drop %s table columns: %v")
-	SchemaFailedToGetTables    = New("failed to get %s tables: %v")
-	SchemaFailedToRenameTable  = New("failed to rename %s table: %v")
-	SchemaEmptyReferenceString = New("reference string can't be empty")
-	SchemaErrorReferenceFormat = New("invalid format: too many dots in reference")
-	SchemaConnectionNotFound   = New("connection %s not found")
-	SchemaTableNotFound        = New("table %s not found")
+	SchemaDriverNotSupported           = New("driver %s is not supported")
+	SchemaFailedToCreateTable          = New("failed to create %s table: %v")
+	SchemaFailedToChangeTable          = New("failed to change %s table: %v")
+	SchemaFailedToDropTable            = New("failed to drop %s table: %v")
+	SchemaFailedToDropColumns          = New("failed to drop %s table columns: %v")
+	SchemaFailedToGetTables            = New("failed to get %s tables: %v")
+	SchemaFailedToRenameTable          = New("failed to rename %s table: %v")
+	SchemaEmptyReferenceString         = New("reference string can't be empty")
+	SchemaErrorReferenceFormat         = New("invalid format: too many dots in reference")
+	SchemaConnectionNotFound           = New("connection %s not found")
+	SchemaTableNotFound                = New("table %s not found")
+	SchemaFailedToDump                 = New("failed to dump the schema: %v")
+	SchemaFailedToLoad                 = New("failed to load the schema from %s: %v")
+	SchemaCheckNotSupported            = New("check constraints are not supported by the grammar")
+	SchemaColumnModifierNotSupported   = New("the %s modifier of the column %s is not supported by the grammar")
+	SchemaEmptyViewQuery               = New("the view query is empty")
+	SchemaFailedToCreateView           = New("failed to create %s view: %v")
+	SchemaFailedToDropView             = New("failed to drop %s view: %v")
+	SchemaFailedToRefreshView          = New("failed to refresh %s view: %v")
+	SchemaInvalidViewQuery             = New("the view query should be a db.Query or a string, got %T")
+	SchemaMaterializedViewNotSupported = New("materialized views are not supported by %s")
 
 	SessionDriverAlreadyExists        = New("session driver [%s] already exists")
 	SessionDriverExtensionFailed      = New("session failed to extend session [%s] driver [%v]")
@@ -217,10 +267,13 @@ var (
 	TemplateFailedToParse         = New("failed to parse template: %v")
 	TemplateFailedToFormateGoCode = New("failed to format go code: %v")
 
-	TestingImageBuildFailed   = New("init %s docker error: %v")
-	TestingImageNoContainerId = New("no container id return when creating %s docker")
-	TestingImageStopFailed    = New("stop %s docker error: %v")
-	TestingImageReadyTimeout  = New("image %s is not ready after %s")
+	TenancyTenantsResolverNotSet = New("the tenants resolver is not set, please set it by tenancy.SetTenantsResolver")
+
+	TestingImageBuildFailed       = New("init %s docker error: %v")
+	TestingImageNoContainerId     = New("no container id return when creating %s docker")
+	TestingImageStopFailed        = New("stop %s docker error: %v")
+	TestingImageReadyTimeout      = New("image %s is not ready after %s")
+	TestingModelPrimaryKeyIsEmpty = New("the primary key of the model %T is empty")
 
 	UnknownFileExtension = New("unknown file extension")
 
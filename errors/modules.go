@@ -18,6 +18,7 @@ var (
 	ModuleMigration    = "migration"
 	ModuleNotification = "notification"
 	ModuleOrm          = "orm"
+	ModuleOutbox       = "outbox"
 	ModulePackages     = "packages"
 	ModulePluralizer   = "pluralizer"
 	ModuleProcess      = "process"
@@ -13,6 +13,7 @@ type Application struct {
 	wildcards      map[string][]any // wildcard patterns -> listeners
 	wildcardsCache map[string][]any // cached prepared wildcard listeners per event
 	pushedEvents   map[string][]any // pushed events -> payloads
+	subscribers    []event.RegisteredSubscriber
 	queue          queue.Queue
 }
 
@@ -54,6 +55,22 @@ func (app *Application) GetEvents() map[event.Event][]event.Listener {
 	return app.events
 }
 
+func (app *Application) GetListeners() map[string][]any {
+	listeners := make(map[string][]any, len(app.listeners)+len(app.wildcards))
+	for eventName, eventListeners := range app.listeners {
+		listeners[eventName] = eventListeners
+	}
+	for pattern, eventListeners := range app.wildcards {
+		listeners[pattern] = eventListeners
+	}
+
+	return listeners
+}
+
+func (app *Application) GetSubscribers() []event.RegisteredSubscriber {
+	return app.subscribers
+}
+
 func (app *Application) Job(e event.Event, args []event.Arg) event.Task {
 	listeners, ok := app.events[e]
 	if !ok {
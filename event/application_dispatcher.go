@@ -2,6 +2,7 @@ package event
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/rusmanplatd/goravelframework/contracts/event"
@@ -18,7 +19,7 @@ func (app *Application) Listen(evt any, listeners ...any) error {
 	}
 
 	// Parse event name
-	eventName, err := app.getEventName(evt)
+	eventName, err := getEventName(evt)
 	if err != nil {
 		return err
 	}
@@ -88,13 +89,24 @@ func (app *Application) Subscribe(subscriber event.Subscriber) error {
 	}
 
 	eventMap := subscriber.Subscribe(app)
+	eventNames := make([]string, 0, len(eventMap))
 	for evt, listenerList := range eventMap {
 		// listenerList is already []any from the map
 		if err := app.Listen(evt, listenerList...); err != nil {
 			return err
 		}
+
+		// Listen has validated the event, so its name can be parsed.
+		eventName, _ := getEventName(evt)
+		eventNames = append(eventNames, eventName)
 	}
 
+	slices.Sort(eventNames)
+	app.subscribers = append(app.subscribers, event.RegisteredSubscriber{
+		Subscriber: subscriber,
+		Events:     eventNames,
+	})
+
 	return nil
 }
 
@@ -209,18 +221,17 @@ func (app *Application) getListenersForEvent(eventName string) []any {
 	return allListeners
 }
 
-// getEventName extracts the event name from various event types.
-func (app *Application) getEventName(evt any) (string, error) {
-	if evt == nil {
-		return "", fmt.Errorf("event cannot be nil")
-	}
+// getRegisteredListeners returns the listeners registered through Listen, Subscribe and Register for a given event name.
+func (app *Application) getRegisteredListeners(eventName string) []any {
+	allListeners := app.getListenersForEvent(eventName)
 
-	// If it's a string, return as-is
-	if eventName, ok := evt.(string); ok {
-		return eventName, nil
+	for e, listeners := range app.events {
+		if name, err := getEventName(e); err == nil && name == eventName {
+			for _, listener := range listeners {
+				allListeners = append(allListeners, listener)
+			}
+		}
 	}
 
-	// If it's an Event interface, parse it
-	eventName, _, err := parseEventAndPayload(evt, nil)
-	return eventName, err
+	return allListeners
 }
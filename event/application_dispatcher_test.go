@@ -179,6 +179,10 @@ func TestSubscribe(t *testing.T) {
 	// Verify listeners were registered
 	assert.True(t, app.HasListeners("user.created"))
 	assert.True(t, app.HasListeners("user.updated"))
+	assert.Equal(t, []event.RegisteredSubscriber{{
+		Subscriber: subscriber,
+		Events:     []string{"user.created", "user.updated"},
+	}}, app.GetSubscribers())
 
 	// Test dispatching events
 	_, err = app.Dispatch("user.created", "john")
@@ -190,6 +194,22 @@ func TestSubscribe(t *testing.T) {
 	assert.Equal(t, 1, subscriber.updatedCount)
 }
 
+// TestGetListeners tests the GetListeners method
+func TestGetListeners(t *testing.T) {
+	mockQueue := mocksqueue.NewQueue(t)
+	app := NewApplication(mockQueue)
+
+	assert.Empty(t, app.GetListeners())
+
+	assert.NoError(t, app.Listen("user.created", func() error { return nil }))
+	assert.NoError(t, app.Listen("order.*", func() error { return nil }, "LogOrder"))
+
+	listeners := app.GetListeners()
+	assert.Len(t, listeners, 2)
+	assert.Len(t, listeners["user.created"], 1)
+	assert.Len(t, listeners["order.*"], 2)
+}
+
 // TestForget tests the Forget method
 func TestForget(t *testing.T) {
 	mockQueue := mocksqueue.NewQueue(t)
@@ -0,0 +1,256 @@
+package console
+
+import (
+	"cmp"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/packages"
+	"github.com/rusmanplatd/goravelframework/packages/modify"
+	"github.com/rusmanplatd/goravelframework/support"
+	"github.com/rusmanplatd/goravelframework/support/file"
+	"github.com/rusmanplatd/goravelframework/support/path/internals"
+)
+
+type EventDiscoverCommand struct {
+}
+
+// Signature The name and signature of the console command.
+func (r *EventDiscoverCommand) Signature() string {
+	return "event:discover"
+}
+
+// Description The console command description.
+func (r *EventDiscoverCommand) Description() string {
+	return "Discover the application's event listeners and register them"
+}
+
+// Extend The console command extend.
+func (r *EventDiscoverCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "event",
+	}
+}
+
+// Handle Execute the console command.
+func (r *EventDiscoverCommand) Handle(ctx console.Context) error {
+	listenerPath := internals.Abs(support.RelativePath, support.Config.Paths.Listener)
+	if !file.Exists(listenerPath) {
+		ctx.Warning(fmt.Sprintf("The listener directory [%s] doesn't exist.", support.Config.Paths.Listener))
+		return nil
+	}
+
+	listeners, err := discoverListeners(listenerPath, internals.Abs(support.RelativePath), packages.GetModuleName())
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	if len(listeners) == 0 {
+		ctx.Warning("No listeners were discovered.")
+		return nil
+	}
+
+	content, err := generateListeners(listeners)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	if err := modify.AddListeners(content); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("%d listeners discovered successfully", len(listeners)))
+
+	return nil
+}
+
+// discoveredListener is a listener whose Handle method receives an event struct, eg:
+//
+//	func (r *SendWelcomeEmail) Handle(event *events.UserRegistered) error
+type discoveredListener struct {
+	eventImport    string
+	eventType      string
+	eventPointer   bool
+	listenerImport string
+	listenerType   string
+}
+
+// discoverListeners scans the Go files in dir and returns the listeners whose Handle method receives an event
+// struct from another package, the import paths are resolved from the module name and the root directory.
+func discoverListeners(dir, root, module string) ([]discoveredListener, error) {
+	var listeners []discoveredListener
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		relativeDir, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		df, err := decorator.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		imports := make(map[string]string)
+		for _, spec := range df.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return err
+			}
+
+			name := importPath[strings.LastIndex(importPath, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+
+		for _, decl := range df.Decls {
+			fn, ok := decl.(*dst.FuncDecl)
+			if !ok || fn.Name.Name != "Handle" || fn.Recv == nil || len(fn.Recv.List) == 0 || len(fn.Type.Params.List) == 0 {
+				continue
+			}
+
+			listenerType := receiverTypeName(fn.Recv.List[0].Type)
+			if listenerType == "" || !token.IsExported(listenerType) {
+				continue
+			}
+
+			paramType := fn.Type.Params.List[0].Type
+			star, eventPointer := paramType.(*dst.StarExpr)
+			if eventPointer {
+				paramType = star.X
+			}
+
+			selector, ok := paramType.(*dst.SelectorExpr)
+			if !ok {
+				continue
+			}
+
+			pkg, ok := selector.X.(*dst.Ident)
+			if !ok || imports[pkg.Name] == "" {
+				continue
+			}
+
+			listeners = append(listeners, discoveredListener{
+				eventImport:    imports[pkg.Name],
+				eventType:      selector.Sel.Name,
+				eventPointer:   eventPointer,
+				listenerImport: module + "/" + filepath.ToSlash(relativeDir),
+				listenerType:   listenerType,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(listeners, func(a, b discoveredListener) int {
+		return cmp.Or(
+			strings.Compare(a.eventImport, b.eventImport),
+			strings.Compare(a.eventType, b.eventType),
+			strings.Compare(a.listenerImport, b.listenerImport),
+			strings.Compare(a.listenerType, b.listenerType),
+		)
+	})
+
+	return listeners, nil
+}
+
+// generateListeners generates the bootstrap/listeners.go file that registers the discovered listeners.
+func generateListeners(listeners []discoveredListener) (string, error) {
+	var (
+		aliases   = make(map[string]string)
+		usedNames = make(map[string]bool)
+		imports   []string
+	)
+
+	alias := func(importPath string) string {
+		if name, ok := aliases[importPath]; ok {
+			return name
+		}
+
+		base := importPath[strings.LastIndex(importPath, "/")+1:]
+		name := base
+		for i := 2; usedNames[name]; i++ {
+			name = fmt.Sprintf("%s%d", base, i)
+		}
+
+		aliases[importPath] = name
+		usedNames[name] = true
+		if name == base {
+			imports = append(imports, strconv.Quote(importPath))
+		} else {
+			imports = append(imports, fmt.Sprintf("%s %s", name, strconv.Quote(importPath)))
+		}
+
+		return name
+	}
+
+	var (
+		events           []string
+		eventToListeners = make(map[string][]string)
+	)
+	for _, listener := range listeners {
+		evt := fmt.Sprintf("%s.%s{}", alias(listener.eventImport), listener.eventType)
+		if listener.eventPointer {
+			evt = "&" + evt
+		}
+		if _, ok := eventToListeners[evt]; !ok {
+			events = append(events, evt)
+		}
+
+		eventToListeners[evt] = append(eventToListeners[evt], fmt.Sprintf("&%s.%s{}", alias(listener.listenerImport), listener.listenerType))
+	}
+
+	var builder strings.Builder
+	builder.WriteString("// Code generated by event:discover. DO NOT EDIT.\n\npackage bootstrap\n\n")
+	builder.WriteString(fmt.Sprintf("import (\n%s\n)\n\n", strings.Join(imports, "\n")))
+	builder.WriteString("func Listeners() map[any][]any {\nreturn map[any][]any{\n")
+	for _, evt := range events {
+		builder.WriteString(fmt.Sprintf("%s: {\n%s,\n},\n", evt, strings.Join(eventToListeners[evt], ",\n")))
+	}
+	builder.WriteString("}\n}\n")
+
+	content, err := format.Source([]byte(builder.String()))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func receiverTypeName(expr dst.Expr) string {
+	if star, ok := expr.(*dst.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*dst.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
@@ -0,0 +1,103 @@
+package console
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+func TestDiscoverListeners(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "app", "listeners")
+
+	assert.NoError(t, file.PutContent(filepath.Join(dir, "send_welcome_email.go"), `package listeners
+
+import (
+	"goravel/app/events"
+)
+
+type SendWelcomeEmail struct{}
+
+func (r *SendWelcomeEmail) Handle(event *events.UserRegistered) error {
+	return nil
+}
+`))
+	assert.NoError(t, file.PutContent(filepath.Join(dir, "audit", "log_order.go"), `package audit
+
+import (
+	orderevents "goravel/app/events/orders"
+	"goravel/app/events"
+)
+
+type LogOrder struct{}
+
+func (r LogOrder) Handle(event orderevents.OrderPlaced) error {
+	return nil
+}
+
+type LogUser struct{}
+
+func (r *LogUser) Handle(event *events.UserRegistered) error {
+	return nil
+}
+
+type legacy struct{}
+
+func (r *legacy) Handle(event *events.UserRegistered) error {
+	return nil
+}
+
+type Variadic struct{}
+
+func (r *Variadic) Handle(args ...any) error {
+	return nil
+}
+`))
+
+	listeners, err := discoverListeners(dir, root, "goravel")
+	assert.NoError(t, err)
+	assert.Equal(t, []discoveredListener{
+		{eventImport: "goravel/app/events", eventType: "UserRegistered", eventPointer: true, listenerImport: "goravel/app/listeners", listenerType: "SendWelcomeEmail"},
+		{eventImport: "goravel/app/events", eventType: "UserRegistered", eventPointer: true, listenerImport: "goravel/app/listeners/audit", listenerType: "LogUser"},
+		{eventImport: "goravel/app/events/orders", eventType: "OrderPlaced", listenerImport: "goravel/app/listeners/audit", listenerType: "LogOrder"},
+	}, listeners)
+
+	content, err := generateListeners(listeners)
+	assert.NoError(t, err)
+	assert.Equal(t, `// Code generated by event:discover. DO NOT EDIT.
+
+package bootstrap
+
+import (
+	"goravel/app/events"
+	"goravel/app/events/orders"
+	"goravel/app/listeners"
+	"goravel/app/listeners/audit"
+)
+
+func Listeners() map[any][]any {
+	return map[any][]any{
+		&events.UserRegistered{}: {
+			&listeners.SendWelcomeEmail{},
+			&audit.LogUser{},
+		},
+		orders.OrderPlaced{}: {
+			&audit.LogOrder{},
+		},
+	}
+}
+`, content)
+}
+
+func TestGenerateListeners_Alias(t *testing.T) {
+	content, err := generateListeners([]discoveredListener{
+		{eventImport: "goravel/app/events", eventType: "UserRegistered", eventPointer: true, listenerImport: "goravel/app/listeners", listenerType: "SendWelcomeEmail"},
+		{eventImport: "goravel/packages/billing/events", eventType: "InvoicePaid", eventPointer: true, listenerImport: "goravel/app/listeners", listenerType: "SendInvoice"},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, content, `events2 "goravel/packages/billing/events"`)
+	assert.Contains(t, content, "&events2.InvoicePaid{}: {")
+}
@@ -0,0 +1,182 @@
+package console
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/contracts/event"
+	"github.com/rusmanplatd/goravelframework/support/debug"
+)
+
+type EventListCommand struct {
+	event event.Instance
+}
+
+func NewEventListCommand(event event.Instance) *EventListCommand {
+	return &EventListCommand{
+		event: event,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *EventListCommand) Signature() string {
+	return "event:list"
+}
+
+// Description The console command description.
+func (r *EventListCommand) Description() string {
+	return "List the application's events and listeners"
+}
+
+// Extend The console command extend.
+func (r *EventListCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "event",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "event",
+				Usage: "Filter the events by name",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *EventListCommand) Handle(ctx console.Context) error {
+	ctx.NewLine()
+
+	events := r.getEvents()
+	if filter := ctx.Option("event"); filter != "" {
+		for eventName := range events {
+			if !strings.Contains(strings.ToLower(eventName), strings.ToLower(filter)) {
+				delete(events, eventName)
+			}
+		}
+	}
+
+	if len(events) == 0 {
+		ctx.Warning("Your application doesn't have any events matching the given criteria.")
+		return nil
+	}
+
+	eventNames := make([]string, 0, len(events))
+	for eventName := range events {
+		eventNames = append(eventNames, eventName)
+	}
+	slices.Sort(eventNames)
+
+	for _, eventName := range eventNames {
+		description := ""
+		if strings.Contains(eventName, "*") {
+			description = "<fg=7472a3>wildcard</>"
+		}
+
+		ctx.TwoColumnDetail(fmt.Sprintf("<fg=yellow>%s</>", eventName), description)
+		for _, listener := range events[eventName] {
+			ctx.TwoColumnDetail(fmt.Sprintf("  ⇂ %s", listener.name), listener.queue, ' ')
+		}
+	}
+
+	if subscribers := r.event.GetSubscribers(); len(subscribers) > 0 {
+		ctx.NewLine()
+		ctx.TwoColumnDetail("<fg=green;op=bold>Subscribers</>", "")
+		for _, subscriber := range subscribers {
+			ctx.TwoColumnDetail(fmt.Sprintf("  %s", reflect.TypeOf(subscriber.Subscriber).String()), fmt.Sprintf("<fg=7472a3>%s</>", strings.Join(subscriber.Events, ", ")))
+		}
+	}
+
+	ctx.NewLine()
+	ctx.TwoColumnDetail("", fmt.Sprintf("<fg=blue;op=bold>Showing [%d] events</>", len(eventNames)), ' ')
+
+	return nil
+}
+
+type listenerInfo struct {
+	name  string
+	queue string
+}
+
+// getEvents gets the events registered by Register, Listen and Subscribe with their listeners.
+func (r *EventListCommand) getEvents() map[string][]listenerInfo {
+	events := make(map[string][]listenerInfo)
+
+	for e, listeners := range r.event.GetEvents() {
+		eventName := getTypeName(e)
+		for _, listener := range listeners {
+			info := listenerInfo{name: fmt.Sprintf("%s (%s)", reflect.TypeOf(listener).String(), listener.Signature())}
+			if queue := listener.Queue(); queue.Enable {
+				info.queue = formatQueue(queue.Connection, queue.Queue)
+			}
+
+			events[eventName] = append(events[eventName], info)
+		}
+	}
+
+	for eventName, listeners := range r.event.GetListeners() {
+		for _, listener := range listeners {
+			events[eventName] = append(events[eventName], listenerInfo{
+				name:  getListenerName(listener),
+				queue: getListenerQueue(listener),
+			})
+		}
+	}
+
+	return events
+}
+
+func formatQueue(connection, queue string) string {
+	var options []string
+	if connection != "" {
+		options = append(options, fmt.Sprintf("connection: %s", connection))
+	}
+	if queue != "" {
+		options = append(options, fmt.Sprintf("queue: %s", queue))
+	}
+
+	if len(options) == 0 {
+		return "<fg=7472a3>queued</>"
+	}
+
+	return fmt.Sprintf("<fg=7472a3>queued (%s)</>", strings.Join(options, ", "))
+}
+
+func getListenerName(listener any) string {
+	switch l := listener.(type) {
+	case string:
+		return l
+	case event.Listener:
+		return fmt.Sprintf("%s (%s)", reflect.TypeOf(l).String(), l.Signature())
+	}
+
+	if reflect.TypeOf(listener).Kind() == reflect.Func {
+		return fmt.Sprintf("Closure %s", strings.TrimSuffix(debug.GetFuncInfo(listener).Name, "-fm"))
+	}
+
+	return reflect.TypeOf(listener).String()
+}
+
+func getListenerQueue(listener any) string {
+	shouldQueue, ok := listener.(event.ShouldQueue)
+	if !ok || !shouldQueue.ShouldQueue() {
+		return ""
+	}
+
+	if queueable, ok := listener.(event.QueueableListener); ok {
+		return formatQueue(queueable.ViaConnection(), queueable.ViaQueue())
+	}
+
+	return formatQueue("", "")
+}
+
+func getTypeName(value any) string {
+	name := reflect.TypeOf(value).String()
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+
+	return strings.TrimPrefix(name, "*")
+}
@@ -0,0 +1,133 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/contracts/event"
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksevent "github.com/rusmanplatd/goravelframework/mocks/event"
+)
+
+type testEvent struct{}
+
+func (r *testEvent) Handle(args []event.Arg) ([]event.Arg, error) {
+	return args, nil
+}
+
+type testListener struct{}
+
+func (r *testListener) Signature() string {
+	return "test_listener"
+}
+
+func (r *testListener) Queue(args ...any) event.Queue {
+	return event.Queue{Enable: true, Connection: "redis", Queue: "emails"}
+}
+
+func (r *testListener) Handle(args ...any) error {
+	return nil
+}
+
+type testQueuedListener struct{}
+
+func (r *testQueuedListener) Handle(e *testEvent) error {
+	return nil
+}
+
+func (r *testQueuedListener) ShouldQueue() bool {
+	return true
+}
+
+func (r *testQueuedListener) ViaConnection() string {
+	return "sync"
+}
+
+func (r *testQueuedListener) ViaQueue() string {
+	return ""
+}
+
+func (r *testQueuedListener) WithDelay() int {
+	return 0
+}
+
+type testSubscriber struct{}
+
+func (r *testSubscriber) Subscribe(dispatcher event.Instance) map[any][]any {
+	panic("the subscriber should not be subscribed again")
+}
+
+func TestEventListCommand(t *testing.T) {
+	var (
+		mockContext *mocksconsole.Context
+		mockEvent   *mocksevent.Instance
+	)
+
+	beforeEach := func() {
+		mockContext = mocksconsole.NewContext(t)
+		mockEvent = mocksevent.NewInstance(t)
+	}
+
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "no events",
+			setup: func() {
+				mockContext.EXPECT().NewLine().Once()
+				mockEvent.EXPECT().GetEvents().Return(nil).Once()
+				mockEvent.EXPECT().GetListeners().Return(nil).Once()
+				mockContext.EXPECT().Option("event").Return("").Once()
+				mockContext.EXPECT().Warning("Your application doesn't have any events matching the given criteria.").Once()
+			},
+		},
+		{
+			name: "no events matching the filter",
+			setup: func() {
+				mockContext.EXPECT().NewLine().Once()
+				mockEvent.EXPECT().GetEvents().Return(nil).Once()
+				mockEvent.EXPECT().GetListeners().Return(map[string][]any{"user.created": {"SendWelcomeEmail"}}).Once()
+				mockContext.EXPECT().Option("event").Return("order").Once()
+				mockContext.EXPECT().Warning("Your application doesn't have any events matching the given criteria.").Once()
+			},
+		},
+		{
+			name: "events, listeners and subscribers",
+			setup: func() {
+				mockContext.EXPECT().NewLine().Times(3)
+				mockEvent.EXPECT().GetEvents().Return(map[event.Event][]event.Listener{
+					&testEvent{}: {&testListener{}},
+				}).Once()
+				mockEvent.EXPECT().GetListeners().Return(map[string][]any{
+					"order.*":   {"LogOrder"},
+					"testEvent": {&testQueuedListener{}},
+				}).Once()
+				mockEvent.EXPECT().GetSubscribers().Return([]event.RegisteredSubscriber{{
+					Subscriber: &testSubscriber{},
+					Events:     []string{"order.created", "testEvent"},
+				}}).Once()
+				mockContext.EXPECT().Option("event").Return("").Once()
+
+				mockContext.EXPECT().TwoColumnDetail("<fg=yellow>order.*</>", "<fg=7472a3>wildcard</>").Once()
+				mockContext.EXPECT().TwoColumnDetail("  ⇂ LogOrder", "", ' ').Once()
+				mockContext.EXPECT().TwoColumnDetail("<fg=yellow>testEvent</>", "").Once()
+				mockContext.EXPECT().TwoColumnDetail("  ⇂ *console.testListener (test_listener)", "<fg=7472a3>queued (connection: redis, queue: emails)</>", ' ').Once()
+				mockContext.EXPECT().TwoColumnDetail("  ⇂ *console.testQueuedListener", "<fg=7472a3>queued (connection: sync)</>", ' ').Once()
+				mockContext.EXPECT().TwoColumnDetail("<fg=green;op=bold>Subscribers</>", "").Once()
+				mockContext.EXPECT().TwoColumnDetail("  *console.testSubscriber", "<fg=7472a3>order.created, testEvent</>").Once()
+				mockContext.EXPECT().TwoColumnDetail("", "<fg=blue;op=bold>Showing [2] events</>", ' ').Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+
+			assert.NoError(t, NewEventListCommand(mockEvent).Handle(mockContext))
+		})
+	}
+}
@@ -44,6 +44,22 @@ func parseEventAndPayload(evt any, payload []any) (string, []any, error) {
 	return eventName, []any{evt}, nil
 }
 
+// getEventName extracts the event name from various event types.
+func getEventName(evt any) (string, error) {
+	if evt == nil {
+		return "", fmt.Errorf("event cannot be nil")
+	}
+
+	// If it's a string, return as-is
+	if eventName, ok := evt.(string); ok {
+		return eventName, nil
+	}
+
+	// If it's an Event interface, parse it
+	eventName, _, err := parseEventAndPayload(evt, nil)
+	return eventName, err
+}
+
 // matchWildcard checks if an event name matches a wildcard pattern.
 // Supports patterns like "user.*", "notification.*", etc.
 func matchWildcard(pattern, eventName string) bool {
@@ -0,0 +1,301 @@
+package event
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/contracts/event"
+)
+
+var _ event.Fake = (*Fake)(nil)
+
+// TestingT is the part of *testing.T used by the fake, so the testing package isn't imported by the production code.
+type TestingT interface {
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// Fake records dispatched events instead of calling their listeners.
+// When events are given to NewFake, only those events are faked and the others are passed to the real dispatcher.
+type Fake struct {
+	t            TestingT
+	dispatcher   event.Instance
+	eventsToFake []string
+	dispatched   map[string][][]any
+	pushedEvents map[string][]any
+	mu           sync.RWMutex
+}
+
+func NewFake(t TestingT, dispatcher event.Instance, eventsToFake ...any) *Fake {
+	fake := &Fake{
+		t:            t,
+		dispatcher:   dispatcher,
+		dispatched:   make(map[string][][]any),
+		pushedEvents: make(map[string][]any),
+	}
+
+	for _, evt := range eventsToFake {
+		eventName, err := getEventName(evt)
+		if err != nil {
+			t.Fatalf("failed to fake event: %v", err)
+		}
+
+		fake.eventsToFake = append(fake.eventsToFake, eventName)
+	}
+
+	return fake
+}
+
+func (r *Fake) Register(events map[event.Event][]event.Listener) {
+	r.dispatcher.Register(events)
+}
+
+func (r *Fake) Job(e event.Event, args []event.Arg) event.Task {
+	eventName, _, err := parseEventAndPayload(e, nil)
+	if err != nil || !r.shouldFake(eventName) {
+		return r.dispatcher.Job(e, args)
+	}
+
+	return &fakeTask{fake: r, eventName: eventName, args: args}
+}
+
+func (r *Fake) GetEvents() map[event.Event][]event.Listener {
+	return r.dispatcher.GetEvents()
+}
+
+func (r *Fake) GetListeners() map[string][]any {
+	return r.dispatcher.GetListeners()
+}
+
+func (r *Fake) GetSubscribers() []event.RegisteredSubscriber {
+	return r.dispatcher.GetSubscribers()
+}
+
+func (r *Fake) Listen(evt any, listeners ...any) error {
+	return r.dispatcher.Listen(evt, listeners...)
+}
+
+func (r *Fake) HasListeners(eventName string) bool {
+	return r.dispatcher.HasListeners(eventName)
+}
+
+func (r *Fake) Dispatch(evt any, payload ...any) ([]any, error) {
+	eventName, parsedPayload, err := parseEventAndPayload(evt, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.shouldFake(eventName) {
+		return r.dispatcher.Dispatch(evt, payload...)
+	}
+
+	r.record(eventName, parsedPayload)
+
+	return nil, nil
+}
+
+func (r *Fake) Until(evt any, payload ...any) (any, error) {
+	eventName, parsedPayload, err := parseEventAndPayload(evt, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.shouldFake(eventName) {
+		return r.dispatcher.Until(evt, payload...)
+	}
+
+	r.record(eventName, parsedPayload)
+
+	return nil, nil
+}
+
+func (r *Fake) Subscribe(subscriber event.Subscriber) error {
+	return r.dispatcher.Subscribe(subscriber)
+}
+
+func (r *Fake) Forget(eventName string) {
+	r.dispatcher.Forget(eventName)
+}
+
+func (r *Fake) Push(eventName string, payload ...any) {
+	if !r.shouldFake(eventName) {
+		r.dispatcher.Push(eventName, payload...)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pushedEvents[eventName] = append(r.pushedEvents[eventName], payload...)
+}
+
+func (r *Fake) Flush(eventName string) error {
+	if !r.shouldFake(eventName) {
+		return r.dispatcher.Flush(eventName)
+	}
+
+	r.mu.Lock()
+	payloads := r.pushedEvents[eventName]
+	delete(r.pushedEvents, eventName)
+	r.mu.Unlock()
+
+	for _, payload := range payloads {
+		if _, err := r.Dispatch(eventName, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Fake) AssertDispatched(evt any, callback ...func(payload []any) bool) bool {
+	eventName, err := getEventName(evt)
+	if err != nil {
+		return assert.Fail(r.t, err.Error())
+	}
+
+	return assert.NotEmpty(r.t, r.Dispatched(evt, callback...), fmt.Sprintf("The expected [%s] event was not dispatched.", eventName))
+}
+
+func (r *Fake) AssertDispatchedTimes(evt any, times int) bool {
+	eventName, err := getEventName(evt)
+	if err != nil {
+		return assert.Fail(r.t, err.Error())
+	}
+
+	count := len(r.Dispatched(evt))
+
+	return assert.Equal(r.t, times, count, fmt.Sprintf("The expected [%s] event was dispatched %d times instead of %d times.", eventName, count, times))
+}
+
+func (r *Fake) AssertNotDispatched(evt any, callback ...func(payload []any) bool) bool {
+	eventName, err := getEventName(evt)
+	if err != nil {
+		return assert.Fail(r.t, err.Error())
+	}
+
+	return assert.Empty(r.t, r.Dispatched(evt, callback...), fmt.Sprintf("The unexpected [%s] event was dispatched.", eventName))
+}
+
+func (r *Fake) AssertNothingDispatched() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var eventNames []string
+	for eventName := range r.dispatched {
+		eventNames = append(eventNames, eventName)
+	}
+
+	return assert.Empty(r.t, eventNames, fmt.Sprintf("%d unexpected events were dispatched: %s", len(eventNames), strings.Join(eventNames, ", ")))
+}
+
+func (r *Fake) AssertListening(evt any, listener any) bool {
+	eventName, err := getEventName(evt)
+	if err != nil {
+		return assert.Fail(r.t, err.Error())
+	}
+
+	app, ok := r.dispatcher.(*Application)
+	if !ok {
+		return assert.Fail(r.t, fmt.Sprintf("The dispatcher %T does not support listener assertions.", r.dispatcher))
+	}
+
+	expected := getListenerName(listener)
+	for _, registered := range app.getRegisteredListeners(eventName) {
+		if getListenerName(registered) == expected {
+			return true
+		}
+	}
+
+	return assert.Fail(r.t, fmt.Sprintf("Event [%s] does not have the [%s] listener attached to it.", eventName, expected))
+}
+
+func (r *Fake) Dispatched(evt any, callback ...func(payload []any) bool) [][]any {
+	eventName, err := getEventName(evt)
+	if err != nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(callback) == 0 {
+		return r.dispatched[eventName]
+	}
+
+	var payloads [][]any
+	for _, payload := range r.dispatched[eventName] {
+		if callback[0](payload) {
+			payloads = append(payloads, payload)
+		}
+	}
+
+	return payloads
+}
+
+func (r *Fake) HasDispatched(evt any) bool {
+	return len(r.Dispatched(evt)) > 0
+}
+
+func (r *Fake) record(eventName string, payload []any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dispatched[eventName] = append(r.dispatched[eventName], payload)
+}
+
+func (r *Fake) shouldFake(eventName string) bool {
+	if len(r.eventsToFake) == 0 {
+		return true
+	}
+
+	for _, eventToFake := range r.eventsToFake {
+		if matchWildcard(eventToFake, eventName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type fakeTask struct {
+	fake      *Fake
+	eventName string
+	args      []event.Arg
+}
+
+func (r *fakeTask) Dispatch() error {
+	var payload []any
+	for _, arg := range r.args {
+		payload = append(payload, arg.Value)
+	}
+
+	r.fake.record(r.eventName, payload)
+
+	return nil
+}
+
+// getListenerName gets a comparable name of a listener: the signature of a Listener,
+// the function name of a closure, the type of a struct or the listener name itself.
+func getListenerName(listener any) string {
+	switch l := listener.(type) {
+	case string:
+		return l
+	case event.Listener:
+		return l.Signature()
+	}
+
+	value := reflect.ValueOf(listener)
+	if value.Kind() == reflect.Func {
+		if fn := runtime.FuncForPC(value.Pointer()); fn != nil {
+			return fn.Name()
+		}
+	}
+
+	return value.Type().String()
+}
@@ -0,0 +1,107 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/contracts/event"
+	"github.com/rusmanplatd/goravelframework/contracts/queue"
+	mocksqueue "github.com/rusmanplatd/goravelframework/mocks/queue"
+)
+
+func TestFake_Dispatch(t *testing.T) {
+	mockQueue := mocksqueue.NewQueue(t)
+	app := NewApplication(mockQueue)
+
+	called := false
+	assert.NoError(t, app.Listen("user.registered", func(user string) error {
+		called = true
+		return nil
+	}))
+
+	fake := NewFake(t, app)
+	fake.AssertNothingDispatched()
+
+	responses, err := fake.Dispatch("user.registered", "john")
+	assert.NoError(t, err)
+	assert.Nil(t, responses)
+	assert.False(t, called)
+
+	_, err = fake.Dispatch(&TestEvent{})
+	assert.NoError(t, err)
+
+	fake.AssertDispatched("user.registered")
+	fake.AssertDispatched("user.registered", func(payload []any) bool {
+		return payload[0] == "john"
+	})
+	fake.AssertNotDispatched("user.registered", func(payload []any) bool {
+		return payload[0] == "jane"
+	})
+	fake.AssertDispatched(&TestEvent{})
+	fake.AssertDispatchedTimes("user.registered", 1)
+	fake.AssertNotDispatched("user.deleted")
+	assert.True(t, fake.HasDispatched("TestEvent"))
+
+	fake.Push("user.registered", "jane")
+	assert.NoError(t, fake.Flush("user.registered"))
+	fake.AssertDispatchedTimes("user.registered", 2)
+	assert.Len(t, fake.Dispatched("user.registered"), 2)
+}
+
+func TestFake_OnlyGivenEvents(t *testing.T) {
+	mockQueue := mocksqueue.NewQueue(t)
+	app := NewApplication(mockQueue)
+
+	var called []string
+	assert.NoError(t, app.Listen("user.registered", func() error {
+		called = append(called, "user.registered")
+		return nil
+	}))
+	assert.NoError(t, app.Listen("order.created", func() error {
+		called = append(called, "order.created")
+		return nil
+	}))
+
+	fake := NewFake(t, app, "user.*")
+
+	_, err := fake.Dispatch("user.registered")
+	assert.NoError(t, err)
+	_, err = fake.Dispatch("order.created")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"order.created"}, called)
+	fake.AssertDispatched("user.registered")
+	fake.AssertNotDispatched("order.created")
+}
+
+func TestFake_Job(t *testing.T) {
+	mockQueue := mocksqueue.NewQueue(t)
+	app := NewApplication(mockQueue)
+	fake := NewFake(t, app)
+
+	assert.NoError(t, fake.Job(&TestEvent{}, []event.Arg{{Type: "string", Value: "john"}}).Dispatch())
+
+	fake.AssertDispatched(&TestEvent{}, func(payload []any) bool {
+		return len(payload) == 1 && payload[0] == "john"
+	})
+}
+
+func TestFake_AssertListening(t *testing.T) {
+	mockQueue := mocksqueue.NewQueue(t)
+	mockQueue.EXPECT().Register([]queue.Job{&TestListener{}}).Once()
+	app := NewApplication(mockQueue)
+
+	app.Register(map[event.Event][]event.Listener{
+		&TestEvent{}: {&TestListener{}},
+	})
+	assert.NoError(t, app.Listen("user.registered", &TestListenerHandleError{}, "SendWelcomeEmail"))
+	assert.NoError(t, app.Listen("order.*", TestFake_AssertListening))
+
+	fake := NewFake(t, app)
+
+	fake.AssertListening(&TestEvent{}, &TestListener{})
+	fake.AssertListening("user.registered", &TestListenerHandleError{})
+	fake.AssertListening("user.registered", "SendWelcomeEmail")
+	fake.AssertListening("order.created", TestFake_AssertListening)
+}
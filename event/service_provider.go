@@ -40,5 +40,7 @@ func (r *ServiceProvider) registerCommands(app foundation.Application) {
 	app.Commands([]console.Command{
 		&eventConsole.EventMakeCommand{},
 		&eventConsole.ListenerMakeCommand{},
+		&eventConsole.EventDiscoverCommand{},
+		eventConsole.NewEventListCommand(app.MakeEvent()),
 	})
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/rusmanplatd/goravelframework/contracts/log"
 	"github.com/rusmanplatd/goravelframework/contracts/mail"
 	"github.com/rusmanplatd/goravelframework/contracts/notification"
+	"github.com/rusmanplatd/goravelframework/contracts/outbox"
 	"github.com/rusmanplatd/goravelframework/contracts/process"
 	"github.com/rusmanplatd/goravelframework/contracts/queue"
 	"github.com/rusmanplatd/goravelframework/contracts/route"
@@ -113,6 +114,10 @@ func Orm() orm.Orm {
 	return App().MakeOrm()
 }
 
+func Outbox() outbox.Outbox {
+	return App().MakeOutbox()
+}
+
 func Process() process.Process {
 	return App().MakeProcess()
 }
@@ -23,6 +23,7 @@ type ApplicationBuilder struct {
 	config                     func()
 	configuredServiceProviders []foundation.ServiceProvider
 	eventToListeners           map[event.Event][]event.Listener
+	listeners                  map[any][]any
 	grpcClientInterceptors     map[string][]grpc.UnaryClientInterceptor
 	grpcServerInterceptors     []grpc.UnaryServerInterceptor
 	middleware                 func(middleware contractsconfiguration.Middleware)
@@ -89,6 +90,20 @@ func (r *ApplicationBuilder) Create() foundation.Application {
 		}
 	}
 
+	// Register event listeners by event name, wildcard pattern or event value
+	if len(r.listeners) > 0 {
+		eventFacade := r.app.MakeEvent()
+		if eventFacade == nil {
+			color.Errorln("Event facade not found, please install it first: ./artisan package:install Event")
+		} else {
+			for evt, listeners := range r.listeners {
+				if err := eventFacade.Listen(evt, listeners...); err != nil {
+					color.Errorln(err)
+				}
+			}
+		}
+	}
+
 	// Register commands
 	if len(r.commands) > 0 {
 		artisanFacade := r.app.MakeArtisan()
@@ -181,6 +196,12 @@ func (r *ApplicationBuilder) WithGrpcServerInterceptors(interceptors []grpc.Unar
 	return r
 }
 
+func (r *ApplicationBuilder) WithListeners(eventToListeners map[any][]any) foundation.ApplicationBuilder {
+	r.listeners = eventToListeners
+
+	return r
+}
+
 func (r *ApplicationBuilder) WithMiddleware(fn func(handler contractsconfiguration.Middleware)) foundation.ApplicationBuilder {
 	r.middleware = fn
 
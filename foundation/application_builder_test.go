@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 
@@ -149,6 +150,26 @@ func (s *ApplicationBuilderTestSuite) TestCreate() {
 		s.NotNil(app)
 	})
 
+	s.Run("WithListeners", func() {
+		s.SetupTest()
+
+		s.mockApp.EXPECT().AddServiceProviders([]foundation.ServiceProvider(nil)).Return().Once()
+		s.mockApp.EXPECT().Boot().Return().Once()
+
+		listener := func() error { return nil }
+		mockEventFacade := mocksevent.NewInstance(s.T())
+		mockEventFacade.EXPECT().Listen("user.registered", mock.AnythingOfType("func() error")).Return(nil).Once()
+		s.mockApp.EXPECT().MakeEvent().Return(mockEventFacade).Once()
+
+		app := s.builder.
+			WithListeners(map[any][]any{
+				"user.registered": {listener},
+			}).
+			Create()
+
+		s.NotNil(app)
+	})
+
 	s.Run("WithMiddleware but Route facade is nil", func() {
 		s.SetupTest()
 
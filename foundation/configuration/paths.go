@@ -18,6 +18,12 @@ func (r *Paths) Bootstrap(path string) configuration.Paths {
 	return r
 }
 
+func (r *Paths) Cast(path string) configuration.Paths {
+	support.Config.Paths.Cast = path
+
+	return r
+}
+
 func (r *Paths) Command(path string) configuration.Paths {
 	support.Config.Paths.Command = path
 
@@ -114,6 +120,12 @@ func (r *Paths) Request(path string) configuration.Paths {
 	return r
 }
 
+func (r *Paths) Resource(path string) configuration.Paths {
+	support.Config.Paths.Resource = path
+
+	return r
+}
+
 func (r *Paths) Rule(path string) configuration.Paths {
 	support.Config.Paths.Rule = path
 
@@ -28,6 +28,7 @@ import (
 	contractslog "github.com/rusmanplatd/goravelframework/contracts/log"
 	contractsmail "github.com/rusmanplatd/goravelframework/contracts/mail"
 	contractsnotification "github.com/rusmanplatd/goravelframework/contracts/notification"
+	contractsoutbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
 	contractspipeline "github.com/rusmanplatd/goravelframework/contracts/pipeline"
 	contractsprocess "github.com/rusmanplatd/goravelframework/contracts/process"
 	contractsqueue "github.com/rusmanplatd/goravelframework/contracts/queue"
@@ -282,6 +283,16 @@ func (r *Container) MakeOrm() contractsorm.Orm {
 	return instance.(contractsorm.Orm)
 }
 
+func (r *Container) MakeOutbox() contractsoutbox.Outbox {
+	instance, err := r.Make(facades.FacadeToBinding[facades.Outbox])
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	return instance.(contractsoutbox.Outbox)
+}
+
 func (r *Container) MakePipeline() contractspipeline.Pipeline {
 	instance, err := r.Make(binding.Pipeline)
 	if err != nil {
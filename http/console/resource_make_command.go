@@ -0,0 +1,68 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/support"
+	supportconsole "github.com/rusmanplatd/goravelframework/support/console"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+type ResourceMakeCommand struct {
+}
+
+// Signature The name and signature of the console command.
+func (r *ResourceMakeCommand) Signature() string {
+	return "make:resource"
+}
+
+// Description The console command description.
+func (r *ResourceMakeCommand) Description() string {
+	return "Create a new resource class"
+}
+
+// Extend The console command extend.
+func (r *ResourceMakeCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "make",
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Create the resource even if it already exists",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *ResourceMakeCommand) Handle(ctx console.Context) error {
+	m, err := supportconsole.NewMake(ctx, "resource", ctx.Argument(0), support.Config.Paths.Resource)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	if err = file.PutContent(m.GetFilePath(), r.populateStub(r.getStub(), m.GetPackageName(), m.GetStructName())); err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	ctx.Success("Resource created successfully")
+
+	return nil
+}
+
+func (r *ResourceMakeCommand) getStub() string {
+	return Stubs{}.Resource()
+}
+
+// populateStub Populate the place-holders in the command stub.
+func (r *ResourceMakeCommand) populateStub(stub string, packageName, structName string) string {
+	stub = strings.ReplaceAll(stub, "DummyResource", structName)
+	stub = strings.ReplaceAll(stub, "DummyPackage", packageName)
+
+	return stub
+}
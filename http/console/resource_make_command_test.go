@@ -0,0 +1,41 @@
+package console
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	"github.com/rusmanplatd/goravelframework/support/file"
+)
+
+func TestResourceMakeCommand(t *testing.T) {
+	resourceMakeCommand := &ResourceMakeCommand{}
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Argument(0).Return("").Once()
+	mockContext.EXPECT().Ask("Enter the resource name", mock.Anything).Return("", errors.New("the resource name cannot be empty")).Once()
+	mockContext.EXPECT().Error("the resource name cannot be empty").Once()
+	assert.NoError(t, resourceMakeCommand.Handle(mockContext))
+
+	mockContext.EXPECT().Argument(0).Return("UserResource").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Success("Resource created successfully").Once()
+	assert.NoError(t, resourceMakeCommand.Handle(mockContext))
+	assert.True(t, file.Exists("app/http/resources/user_resource.go"))
+
+	mockContext.EXPECT().Argument(0).Return("UserResource").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Error("the resource already exists. Use the --force or -f flag to overwrite").Once()
+	assert.NoError(t, resourceMakeCommand.Handle(mockContext))
+
+	mockContext.EXPECT().Argument(0).Return("User/PhoneResource").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Success("Resource created successfully").Once()
+	assert.NoError(t, resourceMakeCommand.Handle(mockContext))
+	assert.True(t, file.Exists("app/http/resources/User/phone_resource.go"))
+	assert.True(t, file.Contain("app/http/resources/User/phone_resource.go", "package User"))
+	assert.True(t, file.Contain("app/http/resources/User/phone_resource.go", "type PhoneResource struct"))
+	assert.Nil(t, file.Remove("app"))
+}
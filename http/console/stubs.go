@@ -98,3 +98,28 @@ func DummyMiddleware() http.Middleware {
 }
 `
 }
+
+func (r Stubs) Resource() string {
+	return `package DummyPackage
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/http"
+	"github.com/rusmanplatd/goravelframework/http/resource"
+)
+
+type DummyResource struct {
+	*resource.JsonResource
+}
+
+func NewDummyResource(model any) *DummyResource {
+	return &DummyResource{
+		JsonResource: resource.NewJsonResource(model),
+	}
+}
+
+// ToArray transforms the resource into a map that is sent as the JSON response.
+func (r *DummyResource) ToArray(ctx http.Context) map[string]any {
+	return r.JsonResource.ToArray(ctx)
+}
+`
+}
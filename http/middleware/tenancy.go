@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	httpcontract "github.com/rusmanplatd/goravelframework/contracts/http"
+	contractstenancy "github.com/rusmanplatd/goravelframework/contracts/tenancy"
+	"github.com/rusmanplatd/goravelframework/tenancy"
+)
+
+// Tenancy resolves the tenant of the request and stores it on the context, so it can be got by
+// tenancy.FromContext(ctx). The request is aborted with 404 if the tenant is not found.
+func Tenancy(resolver contractstenancy.Resolver) httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		tenant := resolver.Resolve(ctx)
+		if tenant == "" {
+			ctx.Request().Abort(httpcontract.StatusNotFound)
+			return
+		}
+
+		ctx.WithContext(tenancy.WithTenant(ctx.Context(), tenant))
+		ctx.Request().Next()
+	}
+}
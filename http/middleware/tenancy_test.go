@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/rusmanplatd/goravelframework/contracts/http"
+	mockhttp "github.com/rusmanplatd/goravelframework/mocks/http"
+	"github.com/rusmanplatd/goravelframework/tenancy"
+)
+
+func TestTenancy(t *testing.T) {
+	resolver := tenancy.ResolverFunc(func(ctx contractshttp.Context) string {
+		return ctx.Request().Header("X-Tenant")
+	})
+
+	t.Run("resolves the tenant", func(t *testing.T) {
+		mockCtx := mockhttp.NewContext(t)
+		mockRequest := mockhttp.NewContextRequest(t)
+		mockCtx.EXPECT().Request().Return(mockRequest).Twice()
+		mockRequest.EXPECT().Header("X-Tenant").Return("acme").Once()
+		mockCtx.EXPECT().Context().Return(context.Background()).Once()
+		mockCtx.EXPECT().WithContext(mock.MatchedBy(func(ctx context.Context) bool {
+			return tenancy.FromContext(ctx) == "acme"
+		})).Once()
+		mockRequest.EXPECT().Next().Once()
+
+		Tenancy(resolver)(mockCtx)
+	})
+
+	t.Run("aborts when the tenant is not found", func(t *testing.T) {
+		mockCtx := mockhttp.NewContext(t)
+		mockRequest := mockhttp.NewContextRequest(t)
+		mockCtx.EXPECT().Request().Return(mockRequest).Twice()
+		mockRequest.EXPECT().Header("X-Tenant").Return("").Once()
+		mockRequest.EXPECT().Abort(contractshttp.StatusNotFound).Once()
+
+		Tenancy(resolver)(mockCtx)
+	})
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	httpcontract "github.com/rusmanplatd/goravelframework/contracts/http"
+)
+
+// TrackQueryTime tracks the cumulative query time of the request, so the callbacks registered by
+// DB().WhenQueryingForLongerThan are called when the queries of the request take too long. The queries
+// should be run with the context of the request, eg: facades.Orm().WithContext(ctx).
+func TrackQueryTime(db contractsdb.DB) httpcontract.Middleware {
+	return func(ctx httpcontract.Context) {
+		ctx.WithContext(db.TrackQueryTime(ctx.Context()))
+		ctx.Request().Next()
+	}
+}
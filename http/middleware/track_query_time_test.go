@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	mocksdb "github.com/rusmanplatd/goravelframework/mocks/database/db"
+	mockhttp "github.com/rusmanplatd/goravelframework/mocks/http"
+)
+
+type queryTimeKey struct{}
+
+func TestTrackQueryTime(t *testing.T) {
+	mockDB := mocksdb.NewDB(t)
+	mockCtx := mockhttp.NewContext(t)
+	mockRequest := mockhttp.NewContextRequest(t)
+	background := context.Background()
+	tracked := context.WithValue(background, queryTimeKey{}, true)
+	mockCtx.EXPECT().Context().Return(background).Once()
+	mockDB.EXPECT().TrackQueryTime(background).Return(tracked).Once()
+	mockCtx.EXPECT().WithContext(tracked).Once()
+	mockCtx.EXPECT().Request().Return(mockRequest).Once()
+	mockRequest.EXPECT().Next().Once()
+
+	TrackQueryTime(mockDB)(mockCtx)
+}
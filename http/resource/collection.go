@@ -0,0 +1,128 @@
+package resource
+
+import (
+	"math"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	contractshttp "github.com/rusmanplatd/goravelframework/contracts/http"
+)
+
+type ResourceCollection struct {
+	items     any
+	paginator *paginator
+	transform func(item any) contractshttp.Resource
+}
+
+type paginator struct {
+	page  int
+	limit int
+	total int64
+}
+
+// NewResourceCollection creates a collection of the slice of models, each model is transformed by the transform
+// function, or by JsonResource if the transform function is not set.
+func NewResourceCollection(items any, transform ...func(item any) contractshttp.Resource) *ResourceCollection {
+	collection := &ResourceCollection{
+		items: items,
+		transform: func(item any) contractshttp.Resource {
+			return NewJsonResource(item)
+		},
+	}
+	if len(transform) > 0 && transform[0] != nil {
+		collection.transform = transform[0]
+	}
+
+	return collection
+}
+
+// Paginate adds the "meta" and "links" blocks to the collection, the arguments are the same as the Paginate of
+// the query, eg: query.Paginate(page, limit, &users, &total).
+func (r *ResourceCollection) Paginate(page, limit int, total int64) *ResourceCollection {
+	r.paginator = &paginator{
+		page:  page,
+		limit: limit,
+		total: total,
+	}
+
+	return r
+}
+
+// ToArray transforms the collection into a map with the "data" key, the "meta" and "links" blocks are added if
+// the collection is paginated.
+func (r *ResourceCollection) ToArray(ctx contractshttp.Context) map[string]any {
+	result := map[string]any{
+		"data": r.resolve(ctx),
+	}
+
+	if r.paginator != nil {
+		result["meta"], result["links"] = r.paginator.toArray(ctx.Request().FullUrl())
+	}
+
+	return result
+}
+
+func (r *ResourceCollection) resolve(ctx contractshttp.Context) []any {
+	value := reflect.Indirect(reflect.ValueOf(r.items))
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return []any{}
+	}
+
+	data := make([]any, value.Len())
+	for i := range data {
+		data[i] = Make(ctx, r.transform(value.Index(i).Interface()))
+	}
+
+	return data
+}
+
+// toArray builds the "meta" and "links" blocks, the links keep the query string of the request and only replace
+// the "page" parameter, eg: /users?status=active&page=2.
+func (r *paginator) toArray(fullUrl string) (meta, links map[string]any) {
+	limit := max(r.limit, 1)
+	page := max(r.page, 1)
+	lastPage := max(int(math.Ceil(float64(r.total)/float64(limit))), 1)
+
+	var from, to any
+	if r.total > 0 && page <= lastPage {
+		from = int64((page-1)*limit + 1)
+		to = min(int64(page*limit), r.total)
+	}
+
+	path, query := fullUrl, url.Values{}
+	if parsed, err := url.Parse(fullUrl); err == nil {
+		query = parsed.Query()
+		parsed.RawQuery = ""
+		parsed.Fragment = ""
+		path = parsed.String()
+	}
+
+	link := func(page int) any {
+		if page < 1 || page > lastPage {
+			return nil
+		}
+
+		query.Set("page", strconv.Itoa(page))
+
+		return path + "?" + query.Encode()
+	}
+
+	meta = map[string]any{
+		"current_page": page,
+		"from":         from,
+		"last_page":    lastPage,
+		"path":         path,
+		"per_page":     limit,
+		"to":           to,
+		"total":        r.total,
+	}
+	links = map[string]any{
+		"first": link(1),
+		"last":  link(lastPage),
+		"prev":  link(page - 1),
+		"next":  link(page + 1),
+	}
+
+	return meta, links
+}
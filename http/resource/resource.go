@@ -0,0 +1,126 @@
+package resource
+
+import (
+	"net/http"
+	"reflect"
+
+	contractshttp "github.com/rusmanplatd/goravelframework/contracts/http"
+	"github.com/rusmanplatd/goravelframework/database/orm"
+)
+
+// MissingValue marks the attribute that should be removed from the resource, see When and WhenLoaded.
+type MissingValue struct{}
+
+type JsonResource struct {
+	Resource any
+}
+
+func NewJsonResource(resource any) *JsonResource {
+	return &JsonResource{Resource: resource}
+}
+
+// ToArray transforms the model into a map, the Hidden, Visible and Appends of the model are honored.
+func (r *JsonResource) ToArray(ctx contractshttp.Context) map[string]any {
+	return orm.ToMap(r.Resource)
+}
+
+// Make transforms the resource into a map, the attributes whose value is MissingValue are removed.
+func Make(ctx contractshttp.Context, resource contractshttp.Resource) map[string]any {
+	if resource == nil {
+		return nil
+	}
+
+	return filter(ctx, resource.ToArray(ctx))
+}
+
+// Response sends the resource wrapped by the "data" key as the JSON response, the status code is 200 by default.
+// The collection is sent with its "meta" and "links" blocks if it's paginated.
+func Response(ctx contractshttp.Context, resource contractshttp.Resource, code ...int) contractshttp.AbortableResponse {
+	status := http.StatusOK
+	if len(code) > 0 {
+		status = code[0]
+	}
+
+	if collection, ok := resource.(*ResourceCollection); ok {
+		return ctx.Response().Json(status, collection.ToArray(ctx))
+	}
+
+	return ctx.Response().Json(status, map[string]any{
+		"data": Make(ctx, resource),
+	})
+}
+
+// When returns the value if the condition is true, otherwise the attribute is removed from the resource. The value
+// can be a func() any, it's only called when the condition is true.
+func When(condition bool, value any) any {
+	if !condition {
+		return MissingValue{}
+	}
+	if callback, ok := value.(func() any); ok {
+		return callback()
+	}
+
+	return value
+}
+
+// WhenLoaded returns the relation if it's loaded, otherwise the attribute is removed from the resource. The relation
+// is transformed by the transform function if it's set, eg: resource.WhenLoaded(user.Books, func() any {...}).
+func WhenLoaded(relation any, transform ...func() any) any {
+	value := reflect.ValueOf(relation)
+	if !value.IsValid() {
+		return MissingValue{}
+	}
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		if value.IsNil() {
+			return MissingValue{}
+		}
+	}
+
+	if len(transform) > 0 {
+		return transform[0]()
+	}
+
+	return relation
+}
+
+// filter removes the missing attributes and resolves the nested resources, the models are converted to maps
+// by orm.Serialize, so their Hidden, Visible and Appends are honored.
+func filter(ctx contractshttp.Context, data map[string]any) map[string]any {
+	if data == nil {
+		return nil
+	}
+
+	result := make(map[string]any, len(data))
+	for key, value := range data {
+		if _, ok := value.(MissingValue); ok {
+			continue
+		}
+
+		result[key] = resolve(ctx, value)
+	}
+
+	return result
+}
+
+func resolve(ctx contractshttp.Context, value any) any {
+	switch v := value.(type) {
+	case *ResourceCollection:
+		return v.resolve(ctx)
+	case contractshttp.Resource:
+		return Make(ctx, v)
+	case map[string]any:
+		return filter(ctx, v)
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			if _, ok := item.(MissingValue); !ok {
+				result = append(result, resolve(ctx, item))
+			}
+		}
+
+		return result
+	default:
+		return orm.Serialize(v)
+	}
+}
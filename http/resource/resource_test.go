@@ -0,0 +1,128 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/rusmanplatd/goravelframework/contracts/http"
+	mockshttp "github.com/rusmanplatd/goravelframework/mocks/http"
+)
+
+type User struct {
+	ID       uint    `json:"id"`
+	Name     string  `json:"name"`
+	Password string  `json:"password"`
+	Books    []*Book `json:"books"`
+}
+
+func (r *User) Hidden() []string {
+	return []string{"password"}
+}
+
+type Book struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserResource struct {
+	*JsonResource
+	user *User
+}
+
+func NewUserResource(user *User) *UserResource {
+	return &UserResource{JsonResource: NewJsonResource(user), user: user}
+}
+
+func (r *UserResource) ToArray(ctx contractshttp.Context) map[string]any {
+	return map[string]any{
+		"id":    r.user.ID,
+		"name":  r.user.Name,
+		"books": WhenLoaded(r.user.Books),
+		"admin": When(r.user.ID == 1, func() any {
+			return true
+		}),
+	}
+}
+
+func TestMake(t *testing.T) {
+	mockContext := mockshttp.NewContext(t)
+	user := &User{ID: 1, Name: "goravel", Password: "hash"}
+
+	assert.Equal(t, map[string]any{"id": uint(1), "name": "goravel", "books": nil}, Make(mockContext, NewJsonResource(user)))
+	assert.Equal(t, map[string]any{"id": uint(1), "name": "goravel", "admin": true}, Make(mockContext, NewUserResource(user)))
+
+	user.ID = 2
+	user.Books = []*Book{{ID: 1, Name: "Goravel"}}
+	assert.Equal(t, map[string]any{
+		"id":    uint(2),
+		"name":  "goravel",
+		"books": []any{map[string]any{"id": uint(1), "name": "Goravel"}},
+	}, Make(mockContext, NewUserResource(user)))
+	assert.Nil(t, Make(mockContext, nil))
+}
+
+func TestResponse(t *testing.T) {
+	mockContext := mockshttp.NewContext(t)
+	mockResponse := mockshttp.NewContextResponse(t)
+	mockAbortableResponse := mockshttp.NewAbortableResponse(t)
+	mockContext.EXPECT().Response().Return(mockResponse).Once()
+	mockResponse.EXPECT().Json(201, map[string]any{
+		"data": map[string]any{"id": uint(1), "name": "goravel", "admin": true},
+	}).Return(mockAbortableResponse).Once()
+
+	assert.Equal(t, mockAbortableResponse, Response(mockContext, NewUserResource(&User{ID: 1, Name: "goravel"}), 201))
+}
+
+func TestResourceCollection(t *testing.T) {
+	mockContext := mockshttp.NewContext(t)
+	users := []*User{{ID: 1, Name: "goravel"}, {ID: 2, Name: "framework", Password: "hash"}}
+
+	assert.Equal(t, map[string]any{
+		"data": []any{
+			map[string]any{"id": uint(1), "name": "goravel", "books": nil},
+			map[string]any{"id": uint(2), "name": "framework", "books": nil},
+		},
+	}, NewResourceCollection(users).ToArray(mockContext))
+
+	mockRequest := mockshttp.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Once()
+	mockRequest.EXPECT().FullUrl().Return("http://localhost/users").Once()
+
+	collection := NewResourceCollection(&users, func(item any) contractshttp.Resource {
+		return NewUserResource(item.(*User))
+	}).Paginate(2, 2, 5)
+	assert.Equal(t, map[string]any{
+		"data": []any{
+			map[string]any{"id": uint(1), "name": "goravel", "admin": true},
+			map[string]any{"id": uint(2), "name": "framework"},
+		},
+		"meta": map[string]any{
+			"current_page": 2,
+			"from":         int64(3),
+			"last_page":    3,
+			"path":         "http://localhost/users",
+			"per_page":     2,
+			"to":           int64(4),
+			"total":        int64(5),
+		},
+		"links": map[string]any{
+			"first": "http://localhost/users?page=1",
+			"last":  "http://localhost/users?page=3",
+			"prev":  "http://localhost/users?page=1",
+			"next":  "http://localhost/users?page=3",
+		},
+	}, collection.ToArray(mockContext))
+
+	mockContext.EXPECT().Request().Return(mockRequest).Once()
+	mockRequest.EXPECT().FullUrl().Return("http://localhost/users?status=active&page=2&sort=name").Once()
+
+	result := collection.ToArray(mockContext)
+	assert.Equal(t, "http://localhost/users", result["meta"].(map[string]any)["path"])
+	assert.Equal(t, map[string]any{
+		"first": "http://localhost/users?page=1&sort=name&status=active",
+		"last":  "http://localhost/users?page=3&sort=name&status=active",
+		"prev":  "http://localhost/users?page=1&sort=name&status=active",
+		"next":  "http://localhost/users?page=3&sort=name&status=active",
+	}, result["links"])
+}
@@ -96,5 +96,6 @@ func (r *ServiceProvider) registerCommands(app foundation.Application) {
 		&console.RequestMakeCommand{},
 		&console.ControllerMakeCommand{},
 		&console.MiddlewareMakeCommand{},
+		&console.ResourceMakeCommand{},
 	})
 }
@@ -82,6 +82,51 @@ func (_c *Builder_Beginx_Call) RunAndReturn(run func() (*sqlx.Tx, error)) *Build
 	return _c
 }
 
+// DriverName provides a mock function with no fields
+func (_m *Builder) DriverName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DriverName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Builder_DriverName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DriverName'
+type Builder_DriverName_Call struct {
+	*mock.Call
+}
+
+// DriverName is a helper method to define mock.On call
+func (_e *Builder_Expecter) DriverName() *Builder_DriverName_Call {
+	return &Builder_DriverName_Call{Call: _e.mock.On("DriverName")}
+}
+
+func (_c *Builder_DriverName_Call) Run(run func()) *Builder_DriverName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Builder_DriverName_Call) Return(_a0 string) *Builder_DriverName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Builder_DriverName_Call) RunAndReturn(run func() string) *Builder_DriverName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecContext provides a mock function with given fields: ctx, query, args
 func (_m *Builder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
 	var _ca []interface{}
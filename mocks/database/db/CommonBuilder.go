@@ -25,6 +25,51 @@ func (_m *CommonBuilder) EXPECT() *CommonBuilder_Expecter {
 	return &CommonBuilder_Expecter{mock: &_m.Mock}
 }
 
+// DriverName provides a mock function with no fields
+func (_m *CommonBuilder) DriverName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DriverName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// CommonBuilder_DriverName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DriverName'
+type CommonBuilder_DriverName_Call struct {
+	*mock.Call
+}
+
+// DriverName is a helper method to define mock.On call
+func (_e *CommonBuilder_Expecter) DriverName() *CommonBuilder_DriverName_Call {
+	return &CommonBuilder_DriverName_Call{Call: _e.mock.On("DriverName")}
+}
+
+func (_c *CommonBuilder_DriverName_Call) Run(run func()) *CommonBuilder_DriverName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CommonBuilder_DriverName_Call) Return(_a0 string) *CommonBuilder_DriverName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommonBuilder_DriverName_Call) RunAndReturn(run func() string) *CommonBuilder_DriverName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecContext provides a mock function with given fields: ctx, query, args
 func (_m *CommonBuilder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
 	var _ca []interface{}
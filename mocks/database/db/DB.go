@@ -7,6 +7,8 @@ import (
 
 	db "github.com/rusmanplatd/goravelframework/contracts/database/db"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // DB is an autogenerated mock type for the DB type
@@ -241,6 +243,65 @@ func (_c *DB_Delete_Call) RunAndReturn(run func(string, ...interface{}) (*db.Res
 	return _c
 }
 
+// FlushQueryCache provides a mock function with given fields: tables
+func (_m *DB) FlushQueryCache(tables ...string) error {
+	_va := make([]interface{}, len(tables))
+	for _i := range tables {
+		_va[_i] = tables[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushQueryCache")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...string) error); ok {
+		r0 = rf(tables...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_FlushQueryCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushQueryCache'
+type DB_FlushQueryCache_Call struct {
+	*mock.Call
+}
+
+// FlushQueryCache is a helper method to define mock.On call
+//   - tables ...string
+func (_e *DB_Expecter) FlushQueryCache(tables ...interface{}) *DB_FlushQueryCache_Call {
+	return &DB_FlushQueryCache_Call{Call: _e.mock.On("FlushQueryCache",
+		append([]interface{}{}, tables...)...)}
+}
+
+func (_c *DB_FlushQueryCache_Call) Run(run func(tables ...string)) *DB_FlushQueryCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DB_FlushQueryCache_Call) Return(_a0 error) *DB_FlushQueryCache_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_FlushQueryCache_Call) RunAndReturn(run func(...string) error) *DB_FlushQueryCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Insert provides a mock function with given fields: sql, args
 func (_m *DB) Insert(sql string, args ...interface{}) (*db.Result, error) {
 	var _ca []interface{}
@@ -310,6 +371,39 @@ func (_c *DB_Insert_Call) RunAndReturn(run func(string, ...interface{}) (*db.Res
 	return _c
 }
 
+// ListenQueries provides a mock function with given fields: callback
+func (_m *DB) ListenQueries(callback func(db.QueryExecuted)) {
+	_m.Called(callback)
+}
+
+// DB_ListenQueries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListenQueries'
+type DB_ListenQueries_Call struct {
+	*mock.Call
+}
+
+// ListenQueries is a helper method to define mock.On call
+//   - callback func(db.QueryExecuted)
+func (_e *DB_Expecter) ListenQueries(callback interface{}) *DB_ListenQueries_Call {
+	return &DB_ListenQueries_Call{Call: _e.mock.On("ListenQueries", callback)}
+}
+
+func (_c *DB_ListenQueries_Call) Run(run func(callback func(db.QueryExecuted))) *DB_ListenQueries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(db.QueryExecuted)))
+	})
+	return _c
+}
+
+func (_c *DB_ListenQueries_Call) Return() *DB_ListenQueries_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DB_ListenQueries_Call) RunAndReturn(run func(func(db.QueryExecuted))) *DB_ListenQueries_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Rollback provides a mock function with no fields
 func (_m *DB) Rollback() error {
 	ret := _m.Called()
@@ -518,6 +612,54 @@ func (_c *DB_Table_Call) RunAndReturn(run func(string) db.Query) *DB_Table_Call
 	return _c
 }
 
+// TrackQueryTime provides a mock function with given fields: ctx
+func (_m *DB) TrackQueryTime(ctx context.Context) context.Context {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackQueryTime")
+	}
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context) context.Context); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// DB_TrackQueryTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrackQueryTime'
+type DB_TrackQueryTime_Call struct {
+	*mock.Call
+}
+
+// TrackQueryTime is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DB_Expecter) TrackQueryTime(ctx interface{}) *DB_TrackQueryTime_Call {
+	return &DB_TrackQueryTime_Call{Call: _e.mock.On("TrackQueryTime", ctx)}
+}
+
+func (_c *DB_TrackQueryTime_Call) Run(run func(ctx context.Context)) *DB_TrackQueryTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DB_TrackQueryTime_Call) Return(_a0 context.Context) *DB_TrackQueryTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_TrackQueryTime_Call) RunAndReturn(run func(context.Context) context.Context) *DB_TrackQueryTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Transaction provides a mock function with given fields: txFunc
 func (_m *DB) Transaction(txFunc func(db.Tx) error) error {
 	ret := _m.Called(txFunc)
@@ -633,6 +775,40 @@ func (_c *DB_Update_Call) RunAndReturn(run func(string, ...interface{}) (*db.Res
 	return _c
 }
 
+// WhenQueryingForLongerThan provides a mock function with given fields: threshold, callback
+func (_m *DB) WhenQueryingForLongerThan(threshold time.Duration, callback func(context.Context, time.Duration)) {
+	_m.Called(threshold, callback)
+}
+
+// DB_WhenQueryingForLongerThan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WhenQueryingForLongerThan'
+type DB_WhenQueryingForLongerThan_Call struct {
+	*mock.Call
+}
+
+// WhenQueryingForLongerThan is a helper method to define mock.On call
+//   - threshold time.Duration
+//   - callback func(context.Context , time.Duration)
+func (_e *DB_Expecter) WhenQueryingForLongerThan(threshold interface{}, callback interface{}) *DB_WhenQueryingForLongerThan_Call {
+	return &DB_WhenQueryingForLongerThan_Call{Call: _e.mock.On("WhenQueryingForLongerThan", threshold, callback)}
+}
+
+func (_c *DB_WhenQueryingForLongerThan_Call) Run(run func(threshold time.Duration, callback func(context.Context, time.Duration))) *DB_WhenQueryingForLongerThan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(func(context.Context, time.Duration)))
+	})
+	return _c
+}
+
+func (_c *DB_WhenQueryingForLongerThan_Call) Return() *DB_WhenQueryingForLongerThan_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DB_WhenQueryingForLongerThan_Call) RunAndReturn(run func(time.Duration, func(context.Context, time.Duration))) *DB_WhenQueryingForLongerThan_Call {
+	_c.Run(run)
+	return _c
+}
+
 // WithContext provides a mock function with given fields: ctx
 func (_m *DB) WithContext(ctx context.Context) db.DB {
 	ret := _m.Called(ctx)
@@ -5,6 +5,8 @@ package db
 import (
 	db "github.com/rusmanplatd/goravelframework/contracts/database/db"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Query is an autogenerated mock type for the Query type
@@ -275,6 +277,71 @@ func (_c *Query_Cursor_Call) RunAndReturn(run func() chan db.Row) *Query_Cursor_
 	return _c
 }
 
+// CursorPaginate provides a mock function with given fields: limit, cursor, dest
+func (_m *Query) CursorPaginate(limit int, cursor string, dest interface{}) (string, string, error) {
+	ret := _m.Called(limit, cursor, dest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CursorPaginate")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, string, interface{}) (string, string, error)); ok {
+		return rf(limit, cursor, dest)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, interface{}) string); ok {
+		r0 = rf(limit, cursor, dest)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, interface{}) string); ok {
+		r1 = rf(limit, cursor, dest)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, string, interface{}) error); ok {
+		r2 = rf(limit, cursor, dest)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Query_CursorPaginate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CursorPaginate'
+type Query_CursorPaginate_Call struct {
+	*mock.Call
+}
+
+// CursorPaginate is a helper method to define mock.On call
+//   - limit int
+//   - cursor string
+//   - dest interface{}
+func (_e *Query_Expecter) CursorPaginate(limit interface{}, cursor interface{}, dest interface{}) *Query_CursorPaginate_Call {
+	return &Query_CursorPaginate_Call{Call: _e.mock.On("CursorPaginate", limit, cursor, dest)}
+}
+
+func (_c *Query_CursorPaginate_Call) Run(run func(limit int, cursor string, dest interface{})) *Query_CursorPaginate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Query_CursorPaginate_Call) Return(next string, prev string, err error) *Query_CursorPaginate_Call {
+	_c.Call.Return(next, prev, err)
+	return _c
+}
+
+func (_c *Query_CursorPaginate_Call) RunAndReturn(run func(int, string, interface{}) (string, string, error)) *Query_CursorPaginate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Decrement provides a mock function with given fields: column, value
 func (_m *Query) Decrement(column string, value ...uint64) error {
 	_va := make([]interface{}, len(value))
@@ -863,6 +930,55 @@ func (_c *Query_FirstOrFail_Call) RunAndReturn(run func(interface{}) error) *Que
 	return _c
 }
 
+// FromSub provides a mock function with given fields: query, as
+func (_m *Query) FromSub(query db.Query, as string) db.Query {
+	ret := _m.Called(query, as)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FromSub")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(db.Query, string) db.Query); ok {
+		r0 = rf(query, as)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_FromSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromSub'
+type Query_FromSub_Call struct {
+	*mock.Call
+}
+
+// FromSub is a helper method to define mock.On call
+//   - query db.Query
+//   - as string
+func (_e *Query_Expecter) FromSub(query interface{}, as interface{}) *Query_FromSub_Call {
+	return &Query_FromSub_Call{Call: _e.mock.On("FromSub", query, as)}
+}
+
+func (_c *Query_FromSub_Call) Run(run func(query db.Query, as string)) *Query_FromSub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Query), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Query_FromSub_Call) Return(_a0 db.Query) *Query_FromSub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_FromSub_Call) RunAndReturn(run func(db.Query, string) db.Query) *Query_FromSub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: dest
 func (_m *Query) Get(dest interface{}) error {
 	ret := _m.Called(dest)
@@ -1251,6 +1367,79 @@ func (_c *Query_InsertGetID_Call) RunAndReturn(run func(interface{}) (int64, err
 	return _c
 }
 
+// InsertOrIgnore provides a mock function with given fields: data, uniqueBy
+func (_m *Query) InsertOrIgnore(data interface{}, uniqueBy ...string) (*db.Result, error) {
+	_va := make([]interface{}, len(uniqueBy))
+	for _i := range uniqueBy {
+		_va[_i] = uniqueBy[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, data)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertOrIgnore")
+	}
+
+	var r0 *db.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...string) (*db.Result, error)); ok {
+		return rf(data, uniqueBy...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...string) *db.Result); ok {
+		r0 = rf(data, uniqueBy...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, ...string) error); ok {
+		r1 = rf(data, uniqueBy...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_InsertOrIgnore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertOrIgnore'
+type Query_InsertOrIgnore_Call struct {
+	*mock.Call
+}
+
+// InsertOrIgnore is a helper method to define mock.On call
+//   - data interface{}
+//   - uniqueBy ...string
+func (_e *Query_Expecter) InsertOrIgnore(data interface{}, uniqueBy ...interface{}) *Query_InsertOrIgnore_Call {
+	return &Query_InsertOrIgnore_Call{Call: _e.mock.On("InsertOrIgnore",
+		append([]interface{}{data}, uniqueBy...)...)}
+}
+
+func (_c *Query_InsertOrIgnore_Call) Run(run func(data interface{}, uniqueBy ...string)) *Query_InsertOrIgnore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_InsertOrIgnore_Call) Return(_a0 *db.Result, _a1 error) *Query_InsertOrIgnore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_InsertOrIgnore_Call) RunAndReturn(run func(interface{}, ...string) (*db.Result, error)) *Query_InsertOrIgnore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Join provides a mock function with given fields: query, args
 func (_m *Query) Join(query string, args ...interface{}) db.Query {
 	var _ca []interface{}
@@ -1310,6 +1499,67 @@ func (_c *Query_Join_Call) RunAndReturn(run func(string, ...interface{}) db.Quer
 	return _c
 }
 
+// JoinSub provides a mock function with given fields: query, as, on, args
+func (_m *Query) JoinSub(query db.Query, as string, on string, args ...interface{}) db.Query {
+	var _ca []interface{}
+	_ca = append(_ca, query, as, on)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinSub")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(db.Query, string, string, ...interface{}) db.Query); ok {
+		r0 = rf(query, as, on, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_JoinSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinSub'
+type Query_JoinSub_Call struct {
+	*mock.Call
+}
+
+// JoinSub is a helper method to define mock.On call
+//   - query db.Query
+//   - as string
+//   - on string
+//   - args ...interface{}
+func (_e *Query_Expecter) JoinSub(query interface{}, as interface{}, on interface{}, args ...interface{}) *Query_JoinSub_Call {
+	return &Query_JoinSub_Call{Call: _e.mock.On("JoinSub",
+		append([]interface{}{query, as, on}, args...)...)}
+}
+
+func (_c *Query_JoinSub_Call) Run(run func(query db.Query, as string, on string, args ...interface{})) *Query_JoinSub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(db.Query), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_JoinSub_Call) Return(_a0 db.Query) *Query_JoinSub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_JoinSub_Call) RunAndReturn(run func(db.Query, string, string, ...interface{}) db.Query) *Query_JoinSub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Latest provides a mock function with given fields: column
 func (_m *Query) Latest(column ...string) db.Query {
 	_va := make([]interface{}, len(column))
@@ -1430,6 +1680,67 @@ func (_c *Query_LeftJoin_Call) RunAndReturn(run func(string, ...interface{}) db.
 	return _c
 }
 
+// LeftJoinSub provides a mock function with given fields: query, as, on, args
+func (_m *Query) LeftJoinSub(query db.Query, as string, on string, args ...interface{}) db.Query {
+	var _ca []interface{}
+	_ca = append(_ca, query, as, on)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeftJoinSub")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(db.Query, string, string, ...interface{}) db.Query); ok {
+		r0 = rf(query, as, on, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_LeftJoinSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeftJoinSub'
+type Query_LeftJoinSub_Call struct {
+	*mock.Call
+}
+
+// LeftJoinSub is a helper method to define mock.On call
+//   - query db.Query
+//   - as string
+//   - on string
+//   - args ...interface{}
+func (_e *Query_Expecter) LeftJoinSub(query interface{}, as interface{}, on interface{}, args ...interface{}) *Query_LeftJoinSub_Call {
+	return &Query_LeftJoinSub_Call{Call: _e.mock.On("LeftJoinSub",
+		append([]interface{}{query, as, on}, args...)...)}
+}
+
+func (_c *Query_LeftJoinSub_Call) Run(run func(query db.Query, as string, on string, args ...interface{})) *Query_LeftJoinSub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(db.Query), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_LeftJoinSub_Call) Return(_a0 db.Query) *Query_LeftJoinSub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_LeftJoinSub_Call) RunAndReturn(run func(db.Query, string, string, ...interface{}) db.Query) *Query_LeftJoinSub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Limit provides a mock function with given fields: limit
 func (_m *Query) Limit(limit uint64) db.Query {
 	ret := _m.Called(limit)
@@ -1839,8 +2150,72 @@ func (_c *Query_OrWhereColumn_Call) RunAndReturn(run func(string, ...string) db.
 	return _c
 }
 
+// OrWhereFullText provides a mock function with given fields: columns, value, options
+func (_m *Query) OrWhereFullText(columns []string, value string, options ...db.FullTextOptions) db.Query {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, columns, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrWhereFullText")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func([]string, string, ...db.FullTextOptions) db.Query); ok {
+		r0 = rf(columns, value, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_OrWhereFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrWhereFullText'
+type Query_OrWhereFullText_Call struct {
+	*mock.Call
+}
+
+// OrWhereFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options ...db.FullTextOptions
+func (_e *Query_Expecter) OrWhereFullText(columns interface{}, value interface{}, options ...interface{}) *Query_OrWhereFullText_Call {
+	return &Query_OrWhereFullText_Call{Call: _e.mock.On("OrWhereFullText",
+		append([]interface{}{columns, value}, options...)...)}
+}
+
+func (_c *Query_OrWhereFullText_Call) Run(run func(columns []string, value string, options ...db.FullTextOptions)) *Query_OrWhereFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]db.FullTextOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(db.FullTextOptions)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_OrWhereFullText_Call) Return(_a0 db.Query) *Query_OrWhereFullText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_OrWhereFullText_Call) RunAndReturn(run func([]string, string, ...db.FullTextOptions) db.Query) *Query_OrWhereFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrWhereIn provides a mock function with given fields: column, values
-func (_m *Query) OrWhereIn(column string, values []interface{}) db.Query {
+func (_m *Query) OrWhereIn(column string, values interface{}) db.Query {
 	ret := _m.Called(column, values)
 
 	if len(ret) == 0 {
@@ -1848,7 +2223,7 @@ func (_m *Query) OrWhereIn(column string, values []interface{}) db.Query {
 	}
 
 	var r0 db.Query
-	if rf, ok := ret.Get(0).(func(string, []interface{}) db.Query); ok {
+	if rf, ok := ret.Get(0).(func(string, interface{}) db.Query); ok {
 		r0 = rf(column, values)
 	} else {
 		if ret.Get(0) != nil {
@@ -1866,14 +2241,14 @@ type Query_OrWhereIn_Call struct {
 
 // OrWhereIn is a helper method to define mock.On call
 //   - column string
-//   - values []interface{}
+//   - values interface{}
 func (_e *Query_Expecter) OrWhereIn(column interface{}, values interface{}) *Query_OrWhereIn_Call {
 	return &Query_OrWhereIn_Call{Call: _e.mock.On("OrWhereIn", column, values)}
 }
 
-func (_c *Query_OrWhereIn_Call) Run(run func(column string, values []interface{})) *Query_OrWhereIn_Call {
+func (_c *Query_OrWhereIn_Call) Run(run func(column string, values interface{})) *Query_OrWhereIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].([]interface{}))
+		run(args[0].(string), args[1].(interface{}))
 	})
 	return _c
 }
@@ -1883,7 +2258,7 @@ func (_c *Query_OrWhereIn_Call) Return(_a0 db.Query) *Query_OrWhereIn_Call {
 	return _c
 }
 
-func (_c *Query_OrWhereIn_Call) RunAndReturn(run func(string, []interface{}) db.Query) *Query_OrWhereIn_Call {
+func (_c *Query_OrWhereIn_Call) RunAndReturn(run func(string, interface{}) db.Query) *Query_OrWhereIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2290,7 +2665,7 @@ func (_c *Query_OrWhereNotBetween_Call) RunAndReturn(run func(string, interface{
 }
 
 // OrWhereNotIn provides a mock function with given fields: column, values
-func (_m *Query) OrWhereNotIn(column string, values []interface{}) db.Query {
+func (_m *Query) OrWhereNotIn(column string, values interface{}) db.Query {
 	ret := _m.Called(column, values)
 
 	if len(ret) == 0 {
@@ -2298,7 +2673,7 @@ func (_m *Query) OrWhereNotIn(column string, values []interface{}) db.Query {
 	}
 
 	var r0 db.Query
-	if rf, ok := ret.Get(0).(func(string, []interface{}) db.Query); ok {
+	if rf, ok := ret.Get(0).(func(string, interface{}) db.Query); ok {
 		r0 = rf(column, values)
 	} else {
 		if ret.Get(0) != nil {
@@ -2316,14 +2691,14 @@ type Query_OrWhereNotIn_Call struct {
 
 // OrWhereNotIn is a helper method to define mock.On call
 //   - column string
-//   - values []interface{}
+//   - values interface{}
 func (_e *Query_Expecter) OrWhereNotIn(column interface{}, values interface{}) *Query_OrWhereNotIn_Call {
 	return &Query_OrWhereNotIn_Call{Call: _e.mock.On("OrWhereNotIn", column, values)}
 }
 
-func (_c *Query_OrWhereNotIn_Call) Run(run func(column string, values []interface{})) *Query_OrWhereNotIn_Call {
+func (_c *Query_OrWhereNotIn_Call) Run(run func(column string, values interface{})) *Query_OrWhereNotIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].([]interface{}))
+		run(args[0].(string), args[1].(interface{}))
 	})
 	return _c
 }
@@ -2333,7 +2708,7 @@ func (_c *Query_OrWhereNotIn_Call) Return(_a0 db.Query) *Query_OrWhereNotIn_Call
 	return _c
 }
 
-func (_c *Query_OrWhereNotIn_Call) RunAndReturn(run func(string, []interface{}) db.Query) *Query_OrWhereNotIn_Call {
+func (_c *Query_OrWhereNotIn_Call) RunAndReturn(run func(string, interface{}) db.Query) *Query_OrWhereNotIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2643,12 +3018,76 @@ func (_c *Query_OrderByDesc_Call) RunAndReturn(run func(string) db.Query) *Query
 	return _c
 }
 
-// OrderByRaw provides a mock function with given fields: raw
-func (_m *Query) OrderByRaw(raw string) db.Query {
-	ret := _m.Called(raw)
-
-	if len(ret) == 0 {
-		panic("no return value specified for OrderByRaw")
+// OrderByFullText provides a mock function with given fields: columns, value, options
+func (_m *Query) OrderByFullText(columns []string, value string, options ...db.FullTextOptions) db.Query {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, columns, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderByFullText")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func([]string, string, ...db.FullTextOptions) db.Query); ok {
+		r0 = rf(columns, value, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_OrderByFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderByFullText'
+type Query_OrderByFullText_Call struct {
+	*mock.Call
+}
+
+// OrderByFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options ...db.FullTextOptions
+func (_e *Query_Expecter) OrderByFullText(columns interface{}, value interface{}, options ...interface{}) *Query_OrderByFullText_Call {
+	return &Query_OrderByFullText_Call{Call: _e.mock.On("OrderByFullText",
+		append([]interface{}{columns, value}, options...)...)}
+}
+
+func (_c *Query_OrderByFullText_Call) Run(run func(columns []string, value string, options ...db.FullTextOptions)) *Query_OrderByFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]db.FullTextOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(db.FullTextOptions)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_OrderByFullText_Call) Return(_a0 db.Query) *Query_OrderByFullText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_OrderByFullText_Call) RunAndReturn(run func([]string, string, ...db.FullTextOptions) db.Query) *Query_OrderByFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderByRaw provides a mock function with given fields: raw
+func (_m *Query) OrderByRaw(raw string) db.Query {
+	ret := _m.Called(raw)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderByRaw")
 	}
 
 	var r0 db.Query
@@ -2787,6 +3226,69 @@ func (_c *Query_Pluck_Call) RunAndReturn(run func(string, interface{}) error) *Q
 	return _c
 }
 
+// Remember provides a mock function with given fields: ttl, key
+func (_m *Query) Remember(ttl time.Duration, key ...string) db.Query {
+	_va := make([]interface{}, len(key))
+	for _i := range key {
+		_va[_i] = key[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ttl)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remember")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(time.Duration, ...string) db.Query); ok {
+		r0 = rf(ttl, key...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_Remember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remember'
+type Query_Remember_Call struct {
+	*mock.Call
+}
+
+// Remember is a helper method to define mock.On call
+//   - ttl time.Duration
+//   - key ...string
+func (_e *Query_Expecter) Remember(ttl interface{}, key ...interface{}) *Query_Remember_Call {
+	return &Query_Remember_Call{Call: _e.mock.On("Remember",
+		append([]interface{}{ttl}, key...)...)}
+}
+
+func (_c *Query_Remember_Call) Run(run func(ttl time.Duration, key ...string)) *Query_Remember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(time.Duration), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_Remember_Call) Return(_a0 db.Query) *Query_Remember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Remember_Call) RunAndReturn(run func(time.Duration, ...string) db.Query) *Query_Remember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RightJoin provides a mock function with given fields: query, args
 func (_m *Query) RightJoin(query string, args ...interface{}) db.Query {
 	var _ca []interface{}
@@ -2907,6 +3409,55 @@ func (_c *Query_Select_Call) RunAndReturn(run func(...string) db.Query) *Query_S
 	return _c
 }
 
+// SelectSub provides a mock function with given fields: as, query
+func (_m *Query) SelectSub(as string, query db.Query) db.Query {
+	ret := _m.Called(as, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectSub")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(string, db.Query) db.Query); ok {
+		r0 = rf(as, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_SelectSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectSub'
+type Query_SelectSub_Call struct {
+	*mock.Call
+}
+
+// SelectSub is a helper method to define mock.On call
+//   - as string
+//   - query db.Query
+func (_e *Query_Expecter) SelectSub(as interface{}, query interface{}) *Query_SelectSub_Call {
+	return &Query_SelectSub_Call{Call: _e.mock.On("SelectSub", as, query)}
+}
+
+func (_c *Query_SelectSub_Call) Run(run func(as string, query db.Query)) *Query_SelectSub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(db.Query))
+	})
+	return _c
+}
+
+func (_c *Query_SelectSub_Call) Return(_a0 db.Query) *Query_SelectSub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_SelectSub_Call) RunAndReturn(run func(string, db.Query) db.Query) *Query_SelectSub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SharedLock provides a mock function with no fields
 func (_m *Query) SharedLock() db.Query {
 	ret := _m.Called()
@@ -3095,6 +3646,102 @@ func (_c *Query_ToSql_Call) RunAndReturn(run func() db.ToSql) *Query_ToSql_Call
 	return _c
 }
 
+// Union provides a mock function with given fields: query
+func (_m *Query) Union(query db.Query) db.Query {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Union")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(db.Query) db.Query); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_Union_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Union'
+type Query_Union_Call struct {
+	*mock.Call
+}
+
+// Union is a helper method to define mock.On call
+//   - query db.Query
+func (_e *Query_Expecter) Union(query interface{}) *Query_Union_Call {
+	return &Query_Union_Call{Call: _e.mock.On("Union", query)}
+}
+
+func (_c *Query_Union_Call) Run(run func(query db.Query)) *Query_Union_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Query))
+	})
+	return _c
+}
+
+func (_c *Query_Union_Call) Return(_a0 db.Query) *Query_Union_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Union_Call) RunAndReturn(run func(db.Query) db.Query) *Query_Union_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnionAll provides a mock function with given fields: query
+func (_m *Query) UnionAll(query db.Query) db.Query {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnionAll")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(db.Query) db.Query); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_UnionAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnionAll'
+type Query_UnionAll_Call struct {
+	*mock.Call
+}
+
+// UnionAll is a helper method to define mock.On call
+//   - query db.Query
+func (_e *Query_Expecter) UnionAll(query interface{}) *Query_UnionAll_Call {
+	return &Query_UnionAll_Call{Call: _e.mock.On("UnionAll", query)}
+}
+
+func (_c *Query_UnionAll_Call) Run(run func(query db.Query)) *Query_UnionAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Query))
+	})
+	return _c
+}
+
+func (_c *Query_UnionAll_Call) Return(_a0 db.Query) *Query_UnionAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_UnionAll_Call) RunAndReturn(run func(db.Query) db.Query) *Query_UnionAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: column, value
 func (_m *Query) Update(column interface{}, value ...interface{}) (*db.Result, error) {
 	var _ca []interface{}
@@ -3223,6 +3870,66 @@ func (_c *Query_UpdateOrInsert_Call) RunAndReturn(run func(interface{}, interfac
 	return _c
 }
 
+// Upsert provides a mock function with given fields: data, uniqueBy, update
+func (_m *Query) Upsert(data interface{}, uniqueBy []string, update []string) (*db.Result, error) {
+	ret := _m.Called(data, uniqueBy, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 *db.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, []string, []string) (*db.Result, error)); ok {
+		return rf(data, uniqueBy, update)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, []string, []string) *db.Result); ok {
+		r0 = rf(data, uniqueBy, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, []string, []string) error); ok {
+		r1 = rf(data, uniqueBy, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type Query_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - data interface{}
+//   - uniqueBy []string
+//   - update []string
+func (_e *Query_Expecter) Upsert(data interface{}, uniqueBy interface{}, update interface{}) *Query_Upsert_Call {
+	return &Query_Upsert_Call{Call: _e.mock.On("Upsert", data, uniqueBy, update)}
+}
+
+func (_c *Query_Upsert_Call) Run(run func(data interface{}, uniqueBy []string, update []string)) *Query_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].([]string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *Query_Upsert_Call) Return(_a0 *db.Result, _a1 error) *Query_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_Upsert_Call) RunAndReturn(run func(interface{}, []string, []string) (*db.Result, error)) *Query_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Value provides a mock function with given fields: column, dest
 func (_m *Query) Value(column string, dest interface{}) error {
 	ret := _m.Called(column, dest)
@@ -3672,8 +4379,72 @@ func (_c *Query_WhereExists_Call) RunAndReturn(run func(func() db.Query) db.Quer
 	return _c
 }
 
+// WhereFullText provides a mock function with given fields: columns, value, options
+func (_m *Query) WhereFullText(columns []string, value string, options ...db.FullTextOptions) db.Query {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, columns, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WhereFullText")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func([]string, string, ...db.FullTextOptions) db.Query); ok {
+		r0 = rf(columns, value, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_WhereFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WhereFullText'
+type Query_WhereFullText_Call struct {
+	*mock.Call
+}
+
+// WhereFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options ...db.FullTextOptions
+func (_e *Query_Expecter) WhereFullText(columns interface{}, value interface{}, options ...interface{}) *Query_WhereFullText_Call {
+	return &Query_WhereFullText_Call{Call: _e.mock.On("WhereFullText",
+		append([]interface{}{columns, value}, options...)...)}
+}
+
+func (_c *Query_WhereFullText_Call) Run(run func(columns []string, value string, options ...db.FullTextOptions)) *Query_WhereFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]db.FullTextOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(db.FullTextOptions)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_WhereFullText_Call) Return(_a0 db.Query) *Query_WhereFullText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_WhereFullText_Call) RunAndReturn(run func([]string, string, ...db.FullTextOptions) db.Query) *Query_WhereFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WhereIn provides a mock function with given fields: column, values
-func (_m *Query) WhereIn(column string, values []interface{}) db.Query {
+func (_m *Query) WhereIn(column string, values interface{}) db.Query {
 	ret := _m.Called(column, values)
 
 	if len(ret) == 0 {
@@ -3681,7 +4452,7 @@ func (_m *Query) WhereIn(column string, values []interface{}) db.Query {
 	}
 
 	var r0 db.Query
-	if rf, ok := ret.Get(0).(func(string, []interface{}) db.Query); ok {
+	if rf, ok := ret.Get(0).(func(string, interface{}) db.Query); ok {
 		r0 = rf(column, values)
 	} else {
 		if ret.Get(0) != nil {
@@ -3699,14 +4470,14 @@ type Query_WhereIn_Call struct {
 
 // WhereIn is a helper method to define mock.On call
 //   - column string
-//   - values []interface{}
+//   - values interface{}
 func (_e *Query_Expecter) WhereIn(column interface{}, values interface{}) *Query_WhereIn_Call {
 	return &Query_WhereIn_Call{Call: _e.mock.On("WhereIn", column, values)}
 }
 
-func (_c *Query_WhereIn_Call) Run(run func(column string, values []interface{})) *Query_WhereIn_Call {
+func (_c *Query_WhereIn_Call) Run(run func(column string, values interface{})) *Query_WhereIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].([]interface{}))
+		run(args[0].(string), args[1].(interface{}))
 	})
 	return _c
 }
@@ -3716,7 +4487,7 @@ func (_c *Query_WhereIn_Call) Return(_a0 db.Query) *Query_WhereIn_Call {
 	return _c
 }
 
-func (_c *Query_WhereIn_Call) RunAndReturn(run func(string, []interface{}) db.Query) *Query_WhereIn_Call {
+func (_c *Query_WhereIn_Call) RunAndReturn(run func(string, interface{}) db.Query) *Query_WhereIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4182,7 +4953,7 @@ func (_c *Query_WhereNotBetween_Call) RunAndReturn(run func(string, interface{},
 }
 
 // WhereNotIn provides a mock function with given fields: column, values
-func (_m *Query) WhereNotIn(column string, values []interface{}) db.Query {
+func (_m *Query) WhereNotIn(column string, values interface{}) db.Query {
 	ret := _m.Called(column, values)
 
 	if len(ret) == 0 {
@@ -4190,7 +4961,7 @@ func (_m *Query) WhereNotIn(column string, values []interface{}) db.Query {
 	}
 
 	var r0 db.Query
-	if rf, ok := ret.Get(0).(func(string, []interface{}) db.Query); ok {
+	if rf, ok := ret.Get(0).(func(string, interface{}) db.Query); ok {
 		r0 = rf(column, values)
 	} else {
 		if ret.Get(0) != nil {
@@ -4208,14 +4979,14 @@ type Query_WhereNotIn_Call struct {
 
 // WhereNotIn is a helper method to define mock.On call
 //   - column string
-//   - values []interface{}
+//   - values interface{}
 func (_e *Query_Expecter) WhereNotIn(column interface{}, values interface{}) *Query_WhereNotIn_Call {
 	return &Query_WhereNotIn_Call{Call: _e.mock.On("WhereNotIn", column, values)}
 }
 
-func (_c *Query_WhereNotIn_Call) Run(run func(column string, values []interface{})) *Query_WhereNotIn_Call {
+func (_c *Query_WhereNotIn_Call) Run(run func(column string, values interface{})) *Query_WhereNotIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].([]interface{}))
+		run(args[0].(string), args[1].(interface{}))
 	})
 	return _c
 }
@@ -4225,7 +4996,7 @@ func (_c *Query_WhereNotIn_Call) Return(_a0 db.Query) *Query_WhereNotIn_Call {
 	return _c
 }
 
-func (_c *Query_WhereNotIn_Call) RunAndReturn(run func(string, []interface{}) db.Query) *Query_WhereNotIn_Call {
+func (_c *Query_WhereNotIn_Call) RunAndReturn(run func(string, interface{}) db.Query) *Query_WhereNotIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4424,6 +5195,104 @@ func (_c *Query_WhereRaw_Call) RunAndReturn(run func(string, []interface{}) db.Q
 	return _c
 }
 
+// With provides a mock function with given fields: name, query
+func (_m *Query) With(name string, query db.Query) db.Query {
+	ret := _m.Called(name, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for With")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(string, db.Query) db.Query); ok {
+		r0 = rf(name, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_With_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'With'
+type Query_With_Call struct {
+	*mock.Call
+}
+
+// With is a helper method to define mock.On call
+//   - name string
+//   - query db.Query
+func (_e *Query_Expecter) With(name interface{}, query interface{}) *Query_With_Call {
+	return &Query_With_Call{Call: _e.mock.On("With", name, query)}
+}
+
+func (_c *Query_With_Call) Run(run func(name string, query db.Query)) *Query_With_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(db.Query))
+	})
+	return _c
+}
+
+func (_c *Query_With_Call) Return(_a0 db.Query) *Query_With_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_With_Call) RunAndReturn(run func(string, db.Query) db.Query) *Query_With_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithRecursive provides a mock function with given fields: name, query
+func (_m *Query) WithRecursive(name string, query db.Query) db.Query {
+	ret := _m.Called(name, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithRecursive")
+	}
+
+	var r0 db.Query
+	if rf, ok := ret.Get(0).(func(string, db.Query) db.Query); ok {
+		r0 = rf(name, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_WithRecursive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithRecursive'
+type Query_WithRecursive_Call struct {
+	*mock.Call
+}
+
+// WithRecursive is a helper method to define mock.On call
+//   - name string
+//   - query db.Query
+func (_e *Query_Expecter) WithRecursive(name interface{}, query interface{}) *Query_WithRecursive_Call {
+	return &Query_WithRecursive_Call{Call: _e.mock.On("WithRecursive", name, query)}
+}
+
+func (_c *Query_WithRecursive_Call) Run(run func(name string, query db.Query)) *Query_WithRecursive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(db.Query))
+	})
+	return _c
+}
+
+func (_c *Query_WithRecursive_Call) Return(_a0 db.Query) *Query_WithRecursive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_WithRecursive_Call) RunAndReturn(run func(string, db.Query) db.Query) *Query_WithRecursive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewQuery creates a new instance of Query. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQuery(t interface {
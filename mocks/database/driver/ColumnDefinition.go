@@ -209,6 +209,102 @@ func (_c *ColumnDefinition_Change_Call) RunAndReturn(run func() driver.ColumnDef
 	return _c
 }
 
+// Charset provides a mock function with given fields: charset
+func (_m *ColumnDefinition) Charset(charset string) driver.ColumnDefinition {
+	ret := _m.Called(charset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Charset")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(charset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ColumnDefinition_Charset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charset'
+type ColumnDefinition_Charset_Call struct {
+	*mock.Call
+}
+
+// Charset is a helper method to define mock.On call
+//   - charset string
+func (_e *ColumnDefinition_Expecter) Charset(charset interface{}) *ColumnDefinition_Charset_Call {
+	return &ColumnDefinition_Charset_Call{Call: _e.mock.On("Charset", charset)}
+}
+
+func (_c *ColumnDefinition_Charset_Call) Run(run func(charset string)) *ColumnDefinition_Charset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_Charset_Call) Return(_a0 driver.ColumnDefinition) *ColumnDefinition_Charset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_Charset_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ColumnDefinition_Charset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Collation provides a mock function with given fields: collation
+func (_m *ColumnDefinition) Collation(collation string) driver.ColumnDefinition {
+	ret := _m.Called(collation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Collation")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(collation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ColumnDefinition_Collation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Collation'
+type ColumnDefinition_Collation_Call struct {
+	*mock.Call
+}
+
+// Collation is a helper method to define mock.On call
+//   - collation string
+func (_e *ColumnDefinition_Expecter) Collation(collation interface{}) *ColumnDefinition_Collation_Call {
+	return &ColumnDefinition_Collation_Call{Call: _e.mock.On("Collation", collation)}
+}
+
+func (_c *ColumnDefinition_Collation_Call) Run(run func(collation string)) *ColumnDefinition_Collation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_Collation_Call) Return(_a0 driver.ColumnDefinition) *ColumnDefinition_Collation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_Collation_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ColumnDefinition_Collation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Comment provides a mock function with given fields: comment
 func (_m *ColumnDefinition) Comment(comment string) driver.ColumnDefinition {
 	ret := _m.Called(comment)
@@ -550,6 +646,96 @@ func (_c *ColumnDefinition_GetAutoIncrement_Call) RunAndReturn(run func() bool)
 	return _c
 }
 
+// GetCharset provides a mock function with no fields
+func (_m *ColumnDefinition) GetCharset() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCharset")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ColumnDefinition_GetCharset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCharset'
+type ColumnDefinition_GetCharset_Call struct {
+	*mock.Call
+}
+
+// GetCharset is a helper method to define mock.On call
+func (_e *ColumnDefinition_Expecter) GetCharset() *ColumnDefinition_GetCharset_Call {
+	return &ColumnDefinition_GetCharset_Call{Call: _e.mock.On("GetCharset")}
+}
+
+func (_c *ColumnDefinition_GetCharset_Call) Run(run func()) *ColumnDefinition_GetCharset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_GetCharset_Call) Return(_a0 string) *ColumnDefinition_GetCharset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_GetCharset_Call) RunAndReturn(run func() string) *ColumnDefinition_GetCharset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollation provides a mock function with no fields
+func (_m *ColumnDefinition) GetCollation() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollation")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ColumnDefinition_GetCollation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollation'
+type ColumnDefinition_GetCollation_Call struct {
+	*mock.Call
+}
+
+// GetCollation is a helper method to define mock.On call
+func (_e *ColumnDefinition_Expecter) GetCollation() *ColumnDefinition_GetCollation_Call {
+	return &ColumnDefinition_GetCollation_Call{Call: _e.mock.On("GetCollation")}
+}
+
+func (_c *ColumnDefinition_GetCollation_Call) Run(run func()) *ColumnDefinition_GetCollation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_GetCollation_Call) Return(_a0 string) *ColumnDefinition_GetCollation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_GetCollation_Call) RunAndReturn(run func() string) *ColumnDefinition_GetCollation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComment provides a mock function with no fields
 func (_m *ColumnDefinition) GetComment() string {
 	ret := _m.Called()
@@ -959,6 +1145,51 @@ func (_c *ColumnDefinition_GetPrecision_Call) RunAndReturn(run func() int) *Colu
 	return _c
 }
 
+// GetStoredAs provides a mock function with no fields
+func (_m *ColumnDefinition) GetStoredAs() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStoredAs")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ColumnDefinition_GetStoredAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStoredAs'
+type ColumnDefinition_GetStoredAs_Call struct {
+	*mock.Call
+}
+
+// GetStoredAs is a helper method to define mock.On call
+func (_e *ColumnDefinition_Expecter) GetStoredAs() *ColumnDefinition_GetStoredAs_Call {
+	return &ColumnDefinition_GetStoredAs_Call{Call: _e.mock.On("GetStoredAs")}
+}
+
+func (_c *ColumnDefinition_GetStoredAs_Call) Run(run func()) *ColumnDefinition_GetStoredAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_GetStoredAs_Call) Return(_a0 string) *ColumnDefinition_GetStoredAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_GetStoredAs_Call) RunAndReturn(run func() string) *ColumnDefinition_GetStoredAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotal provides a mock function with no fields
 func (_m *ColumnDefinition) GetTotal() int {
 	ret := _m.Called()
@@ -1184,6 +1415,51 @@ func (_c *ColumnDefinition_GetUseCurrentOnUpdate_Call) RunAndReturn(run func() b
 	return _c
 }
 
+// GetVirtualAs provides a mock function with no fields
+func (_m *ColumnDefinition) GetVirtualAs() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVirtualAs")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ColumnDefinition_GetVirtualAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVirtualAs'
+type ColumnDefinition_GetVirtualAs_Call struct {
+	*mock.Call
+}
+
+// GetVirtualAs is a helper method to define mock.On call
+func (_e *ColumnDefinition_Expecter) GetVirtualAs() *ColumnDefinition_GetVirtualAs_Call {
+	return &ColumnDefinition_GetVirtualAs_Call{Call: _e.mock.On("GetVirtualAs")}
+}
+
+func (_c *ColumnDefinition_GetVirtualAs_Call) Run(run func()) *ColumnDefinition_GetVirtualAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_GetVirtualAs_Call) Return(_a0 string) *ColumnDefinition_GetVirtualAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_GetVirtualAs_Call) RunAndReturn(run func() string) *ColumnDefinition_GetVirtualAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsAlways provides a mock function with no fields
 func (_m *ColumnDefinition) IsAlways() bool {
 	ret := _m.Called()
@@ -1552,6 +1828,54 @@ func (_c *ColumnDefinition_Places_Call) RunAndReturn(run func(int) driver.Column
 	return _c
 }
 
+// StoredAs provides a mock function with given fields: expression
+func (_m *ColumnDefinition) StoredAs(expression string) driver.ColumnDefinition {
+	ret := _m.Called(expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoredAs")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(expression)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ColumnDefinition_StoredAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoredAs'
+type ColumnDefinition_StoredAs_Call struct {
+	*mock.Call
+}
+
+// StoredAs is a helper method to define mock.On call
+//   - expression string
+func (_e *ColumnDefinition_Expecter) StoredAs(expression interface{}) *ColumnDefinition_StoredAs_Call {
+	return &ColumnDefinition_StoredAs_Call{Call: _e.mock.On("StoredAs", expression)}
+}
+
+func (_c *ColumnDefinition_StoredAs_Call) Run(run func(expression string)) *ColumnDefinition_StoredAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_StoredAs_Call) Return(_a0 driver.ColumnDefinition) *ColumnDefinition_StoredAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_StoredAs_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ColumnDefinition_StoredAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Total provides a mock function with given fields: total
 func (_m *ColumnDefinition) Total(total int) driver.ColumnDefinition {
 	ret := _m.Called(total)
@@ -1741,6 +2065,54 @@ func (_c *ColumnDefinition_UseCurrentOnUpdate_Call) RunAndReturn(run func() driv
 	return _c
 }
 
+// VirtualAs provides a mock function with given fields: expression
+func (_m *ColumnDefinition) VirtualAs(expression string) driver.ColumnDefinition {
+	ret := _m.Called(expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VirtualAs")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(expression)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ColumnDefinition_VirtualAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VirtualAs'
+type ColumnDefinition_VirtualAs_Call struct {
+	*mock.Call
+}
+
+// VirtualAs is a helper method to define mock.On call
+//   - expression string
+func (_e *ColumnDefinition_Expecter) VirtualAs(expression interface{}) *ColumnDefinition_VirtualAs_Call {
+	return &ColumnDefinition_VirtualAs_Call{Call: _e.mock.On("VirtualAs", expression)}
+}
+
+func (_c *ColumnDefinition_VirtualAs_Call) Run(run func(expression string)) *ColumnDefinition_VirtualAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ColumnDefinition_VirtualAs_Call) Return(_a0 driver.ColumnDefinition) *ColumnDefinition_VirtualAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnDefinition_VirtualAs_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ColumnDefinition_VirtualAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewColumnDefinition creates a new instance of ColumnDefinition. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewColumnDefinition(t interface {
@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package driver
+
+import mock "github.com/stretchr/testify/mock"
+
+// ColumnModifierGrammar is an autogenerated mock type for the ColumnModifierGrammar type
+type ColumnModifierGrammar struct {
+	mock.Mock
+}
+
+type ColumnModifierGrammar_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ColumnModifierGrammar) EXPECT() *ColumnModifierGrammar_Expecter {
+	return &ColumnModifierGrammar_Expecter{mock: &_m.Mock}
+}
+
+// GetColumnModifiers provides a mock function with no fields
+func (_m *ColumnModifierGrammar) GetColumnModifiers() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnModifiers")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// ColumnModifierGrammar_GetColumnModifiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumnModifiers'
+type ColumnModifierGrammar_GetColumnModifiers_Call struct {
+	*mock.Call
+}
+
+// GetColumnModifiers is a helper method to define mock.On call
+func (_e *ColumnModifierGrammar_Expecter) GetColumnModifiers() *ColumnModifierGrammar_GetColumnModifiers_Call {
+	return &ColumnModifierGrammar_GetColumnModifiers_Call{Call: _e.mock.On("GetColumnModifiers")}
+}
+
+func (_c *ColumnModifierGrammar_GetColumnModifiers_Call) Run(run func()) *ColumnModifierGrammar_GetColumnModifiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ColumnModifierGrammar_GetColumnModifiers_Call) Return(_a0 []string) *ColumnModifierGrammar_GetColumnModifiers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ColumnModifierGrammar_GetColumnModifiers_Call) RunAndReturn(run func() []string) *ColumnModifierGrammar_GetColumnModifiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewColumnModifierGrammar creates a new instance of ColumnModifierGrammar. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewColumnModifierGrammar(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ColumnModifierGrammar {
+	mock := &ColumnModifierGrammar{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,186 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package driver
+
+import (
+	driver "github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompileCheckGrammar is an autogenerated mock type for the CompileCheckGrammar type
+type CompileCheckGrammar struct {
+	mock.Mock
+}
+
+type CompileCheckGrammar_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompileCheckGrammar) EXPECT() *CompileCheckGrammar_Expecter {
+	return &CompileCheckGrammar_Expecter{mock: &_m.Mock}
+}
+
+// CompileCheck provides a mock function with given fields: blueprint, command
+func (_m *CompileCheckGrammar) CompileCheck(blueprint driver.Blueprint, command *driver.Command) string {
+	ret := _m.Called(blueprint, command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileCheck")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(driver.Blueprint, *driver.Command) string); ok {
+		r0 = rf(blueprint, command)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// CompileCheckGrammar_CompileCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileCheck'
+type CompileCheckGrammar_CompileCheck_Call struct {
+	*mock.Call
+}
+
+// CompileCheck is a helper method to define mock.On call
+//   - blueprint driver.Blueprint
+//   - command *driver.Command
+func (_e *CompileCheckGrammar_Expecter) CompileCheck(blueprint interface{}, command interface{}) *CompileCheckGrammar_CompileCheck_Call {
+	return &CompileCheckGrammar_CompileCheck_Call{Call: _e.mock.On("CompileCheck", blueprint, command)}
+}
+
+func (_c *CompileCheckGrammar_CompileCheck_Call) Run(run func(blueprint driver.Blueprint, command *driver.Command)) *CompileCheckGrammar_CompileCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(driver.Blueprint), args[1].(*driver.Command))
+	})
+	return _c
+}
+
+func (_c *CompileCheckGrammar_CompileCheck_Call) Return(_a0 string) *CompileCheckGrammar_CompileCheck_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompileCheckGrammar_CompileCheck_Call) RunAndReturn(run func(driver.Blueprint, *driver.Command) string) *CompileCheckGrammar_CompileCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompileChecks provides a mock function with given fields: schema, table
+func (_m *CompileCheckGrammar) CompileChecks(schema string, table string) (string, error) {
+	ret := _m.Called(schema, table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileChecks")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return rf(schema, table)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(schema, table)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(schema, table)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompileCheckGrammar_CompileChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileChecks'
+type CompileCheckGrammar_CompileChecks_Call struct {
+	*mock.Call
+}
+
+// CompileChecks is a helper method to define mock.On call
+//   - schema string
+//   - table string
+func (_e *CompileCheckGrammar_Expecter) CompileChecks(schema interface{}, table interface{}) *CompileCheckGrammar_CompileChecks_Call {
+	return &CompileCheckGrammar_CompileChecks_Call{Call: _e.mock.On("CompileChecks", schema, table)}
+}
+
+func (_c *CompileCheckGrammar_CompileChecks_Call) Run(run func(schema string, table string)) *CompileCheckGrammar_CompileChecks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CompileCheckGrammar_CompileChecks_Call) Return(_a0 string, _a1 error) *CompileCheckGrammar_CompileChecks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompileCheckGrammar_CompileChecks_Call) RunAndReturn(run func(string, string) (string, error)) *CompileCheckGrammar_CompileChecks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompileDropCheck provides a mock function with given fields: blueprint, command
+func (_m *CompileCheckGrammar) CompileDropCheck(blueprint driver.Blueprint, command *driver.Command) string {
+	ret := _m.Called(blueprint, command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileDropCheck")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(driver.Blueprint, *driver.Command) string); ok {
+		r0 = rf(blueprint, command)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// CompileCheckGrammar_CompileDropCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileDropCheck'
+type CompileCheckGrammar_CompileDropCheck_Call struct {
+	*mock.Call
+}
+
+// CompileDropCheck is a helper method to define mock.On call
+//   - blueprint driver.Blueprint
+//   - command *driver.Command
+func (_e *CompileCheckGrammar_Expecter) CompileDropCheck(blueprint interface{}, command interface{}) *CompileCheckGrammar_CompileDropCheck_Call {
+	return &CompileCheckGrammar_CompileDropCheck_Call{Call: _e.mock.On("CompileDropCheck", blueprint, command)}
+}
+
+func (_c *CompileCheckGrammar_CompileDropCheck_Call) Run(run func(blueprint driver.Blueprint, command *driver.Command)) *CompileCheckGrammar_CompileDropCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(driver.Blueprint), args[1].(*driver.Command))
+	})
+	return _c
+}
+
+func (_c *CompileCheckGrammar_CompileDropCheck_Call) Return(_a0 string) *CompileCheckGrammar_CompileDropCheck_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompileCheckGrammar_CompileDropCheck_Call) RunAndReturn(run func(driver.Blueprint, *driver.Command) string) *CompileCheckGrammar_CompileDropCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompileCheckGrammar creates a new instance of CompileCheckGrammar. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompileCheckGrammar(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompileCheckGrammar {
+	mock := &CompileCheckGrammar{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
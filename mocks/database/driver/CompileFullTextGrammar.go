@@ -0,0 +1,170 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package driver
+
+import (
+	db "github.com/rusmanplatd/goravelframework/contracts/database/db"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompileFullTextGrammar is an autogenerated mock type for the CompileFullTextGrammar type
+type CompileFullTextGrammar struct {
+	mock.Mock
+}
+
+type CompileFullTextGrammar_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompileFullTextGrammar) EXPECT() *CompileFullTextGrammar_Expecter {
+	return &CompileFullTextGrammar_Expecter{mock: &_m.Mock}
+}
+
+// CompileOrderByFullText provides a mock function with given fields: columns, value, options
+func (_m *CompileFullTextGrammar) CompileOrderByFullText(columns []string, value string, options db.FullTextOptions) (string, []interface{}, error) {
+	ret := _m.Called(columns, value, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileOrderByFullText")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]string, string, db.FullTextOptions) (string, []interface{}, error)); ok {
+		return rf(columns, value, options)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string, db.FullTextOptions) string); ok {
+		r0 = rf(columns, value, options)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string, db.FullTextOptions) []interface{}); ok {
+		r1 = rf(columns, value, options)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func([]string, string, db.FullTextOptions) error); ok {
+		r2 = rf(columns, value, options)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CompileFullTextGrammar_CompileOrderByFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileOrderByFullText'
+type CompileFullTextGrammar_CompileOrderByFullText_Call struct {
+	*mock.Call
+}
+
+// CompileOrderByFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options db.FullTextOptions
+func (_e *CompileFullTextGrammar_Expecter) CompileOrderByFullText(columns interface{}, value interface{}, options interface{}) *CompileFullTextGrammar_CompileOrderByFullText_Call {
+	return &CompileFullTextGrammar_CompileOrderByFullText_Call{Call: _e.mock.On("CompileOrderByFullText", columns, value, options)}
+}
+
+func (_c *CompileFullTextGrammar_CompileOrderByFullText_Call) Run(run func(columns []string, value string, options db.FullTextOptions)) *CompileFullTextGrammar_CompileOrderByFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(string), args[2].(db.FullTextOptions))
+	})
+	return _c
+}
+
+func (_c *CompileFullTextGrammar_CompileOrderByFullText_Call) Return(_a0 string, _a1 []interface{}, _a2 error) *CompileFullTextGrammar_CompileOrderByFullText_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CompileFullTextGrammar_CompileOrderByFullText_Call) RunAndReturn(run func([]string, string, db.FullTextOptions) (string, []interface{}, error)) *CompileFullTextGrammar_CompileOrderByFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompileWhereFullText provides a mock function with given fields: columns, value, options
+func (_m *CompileFullTextGrammar) CompileWhereFullText(columns []string, value string, options db.FullTextOptions) (string, []interface{}, error) {
+	ret := _m.Called(columns, value, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileWhereFullText")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]string, string, db.FullTextOptions) (string, []interface{}, error)); ok {
+		return rf(columns, value, options)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string, db.FullTextOptions) string); ok {
+		r0 = rf(columns, value, options)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string, db.FullTextOptions) []interface{}); ok {
+		r1 = rf(columns, value, options)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func([]string, string, db.FullTextOptions) error); ok {
+		r2 = rf(columns, value, options)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CompileFullTextGrammar_CompileWhereFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileWhereFullText'
+type CompileFullTextGrammar_CompileWhereFullText_Call struct {
+	*mock.Call
+}
+
+// CompileWhereFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options db.FullTextOptions
+func (_e *CompileFullTextGrammar_Expecter) CompileWhereFullText(columns interface{}, value interface{}, options interface{}) *CompileFullTextGrammar_CompileWhereFullText_Call {
+	return &CompileFullTextGrammar_CompileWhereFullText_Call{Call: _e.mock.On("CompileWhereFullText", columns, value, options)}
+}
+
+func (_c *CompileFullTextGrammar_CompileWhereFullText_Call) Run(run func(columns []string, value string, options db.FullTextOptions)) *CompileFullTextGrammar_CompileWhereFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(string), args[2].(db.FullTextOptions))
+	})
+	return _c
+}
+
+func (_c *CompileFullTextGrammar_CompileWhereFullText_Call) Return(_a0 string, _a1 []interface{}, _a2 error) *CompileFullTextGrammar_CompileWhereFullText_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CompileFullTextGrammar_CompileWhereFullText_Call) RunAndReturn(run func([]string, string, db.FullTextOptions) (string, []interface{}, error)) *CompileFullTextGrammar_CompileWhereFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompileFullTextGrammar creates a new instance of CompileFullTextGrammar. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompileFullTextGrammar(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompileFullTextGrammar {
+	mock := &CompileFullTextGrammar{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,169 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package driver
+
+import mock "github.com/stretchr/testify/mock"
+
+// CompileUpsertGrammar is an autogenerated mock type for the CompileUpsertGrammar type
+type CompileUpsertGrammar struct {
+	mock.Mock
+}
+
+type CompileUpsertGrammar_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompileUpsertGrammar) EXPECT() *CompileUpsertGrammar_Expecter {
+	return &CompileUpsertGrammar_Expecter{mock: &_m.Mock}
+}
+
+// CompileInsertOrIgnore provides a mock function with given fields: table, columns, values, uniqueBy
+func (_m *CompileUpsertGrammar) CompileInsertOrIgnore(table string, columns []string, values [][]interface{}, uniqueBy []string) (string, []interface{}, error) {
+	ret := _m.Called(table, columns, values, uniqueBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileInsertOrIgnore")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, []string, [][]interface{}, []string) (string, []interface{}, error)); ok {
+		return rf(table, columns, values, uniqueBy)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, [][]interface{}, []string) string); ok {
+		r0 = rf(table, columns, values, uniqueBy)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, [][]interface{}, []string) []interface{}); ok {
+		r1 = rf(table, columns, values, uniqueBy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, []string, [][]interface{}, []string) error); ok {
+		r2 = rf(table, columns, values, uniqueBy)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CompileUpsertGrammar_CompileInsertOrIgnore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileInsertOrIgnore'
+type CompileUpsertGrammar_CompileInsertOrIgnore_Call struct {
+	*mock.Call
+}
+
+// CompileInsertOrIgnore is a helper method to define mock.On call
+//   - table string
+//   - columns []string
+//   - values [][]interface{}
+//   - uniqueBy []string
+func (_e *CompileUpsertGrammar_Expecter) CompileInsertOrIgnore(table interface{}, columns interface{}, values interface{}, uniqueBy interface{}) *CompileUpsertGrammar_CompileInsertOrIgnore_Call {
+	return &CompileUpsertGrammar_CompileInsertOrIgnore_Call{Call: _e.mock.On("CompileInsertOrIgnore", table, columns, values, uniqueBy)}
+}
+
+func (_c *CompileUpsertGrammar_CompileInsertOrIgnore_Call) Run(run func(table string, columns []string, values [][]interface{}, uniqueBy []string)) *CompileUpsertGrammar_CompileInsertOrIgnore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string), args[2].([][]interface{}), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *CompileUpsertGrammar_CompileInsertOrIgnore_Call) Return(_a0 string, _a1 []interface{}, _a2 error) *CompileUpsertGrammar_CompileInsertOrIgnore_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CompileUpsertGrammar_CompileInsertOrIgnore_Call) RunAndReturn(run func(string, []string, [][]interface{}, []string) (string, []interface{}, error)) *CompileUpsertGrammar_CompileInsertOrIgnore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompileUpsert provides a mock function with given fields: table, columns, values, uniqueBy, update
+func (_m *CompileUpsertGrammar) CompileUpsert(table string, columns []string, values [][]interface{}, uniqueBy []string, update []string) (string, []interface{}, error) {
+	ret := _m.Called(table, columns, values, uniqueBy, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileUpsert")
+	}
+
+	var r0 string
+	var r1 []interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, []string, [][]interface{}, []string, []string) (string, []interface{}, error)); ok {
+		return rf(table, columns, values, uniqueBy, update)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, [][]interface{}, []string, []string) string); ok {
+		r0 = rf(table, columns, values, uniqueBy, update)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, [][]interface{}, []string, []string) []interface{}); ok {
+		r1 = rf(table, columns, values, uniqueBy, update)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, []string, [][]interface{}, []string, []string) error); ok {
+		r2 = rf(table, columns, values, uniqueBy, update)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CompileUpsertGrammar_CompileUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileUpsert'
+type CompileUpsertGrammar_CompileUpsert_Call struct {
+	*mock.Call
+}
+
+// CompileUpsert is a helper method to define mock.On call
+//   - table string
+//   - columns []string
+//   - values [][]interface{}
+//   - uniqueBy []string
+//   - update []string
+func (_e *CompileUpsertGrammar_Expecter) CompileUpsert(table interface{}, columns interface{}, values interface{}, uniqueBy interface{}, update interface{}) *CompileUpsertGrammar_CompileUpsert_Call {
+	return &CompileUpsertGrammar_CompileUpsert_Call{Call: _e.mock.On("CompileUpsert", table, columns, values, uniqueBy, update)}
+}
+
+func (_c *CompileUpsertGrammar_CompileUpsert_Call) Run(run func(table string, columns []string, values [][]interface{}, uniqueBy []string, update []string)) *CompileUpsertGrammar_CompileUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string), args[2].([][]interface{}), args[3].([]string), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *CompileUpsertGrammar_CompileUpsert_Call) Return(_a0 string, _a1 []interface{}, _a2 error) *CompileUpsertGrammar_CompileUpsert_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *CompileUpsertGrammar_CompileUpsert_Call) RunAndReturn(run func(string, []string, [][]interface{}, []string, []string) (string, []interface{}, error)) *CompileUpsertGrammar_CompileUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompileUpsertGrammar creates a new instance of CompileUpsertGrammar. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompileUpsertGrammar(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompileUpsertGrammar {
+	mock := &CompileUpsertGrammar{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package driver
+
+import (
+	driver "github.com/rusmanplatd/goravelframework/contracts/database/driver"
+	mock "github.com/stretchr/testify/mock"
+
+	squirrel "github.com/Masterminds/squirrel"
+)
+
+// CompileWithGrammar is an autogenerated mock type for the CompileWithGrammar type
+type CompileWithGrammar struct {
+	mock.Mock
+}
+
+type CompileWithGrammar_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompileWithGrammar) EXPECT() *CompileWithGrammar_Expecter {
+	return &CompileWithGrammar_Expecter{mock: &_m.Mock}
+}
+
+// CompileWith provides a mock function with given fields: builder, conditions
+func (_m *CompileWithGrammar) CompileWith(builder squirrel.SelectBuilder, conditions *driver.Conditions) squirrel.SelectBuilder {
+	ret := _m.Called(builder, conditions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompileWith")
+	}
+
+	var r0 squirrel.SelectBuilder
+	if rf, ok := ret.Get(0).(func(squirrel.SelectBuilder, *driver.Conditions) squirrel.SelectBuilder); ok {
+		r0 = rf(builder, conditions)
+	} else {
+		r0 = ret.Get(0).(squirrel.SelectBuilder)
+	}
+
+	return r0
+}
+
+// CompileWithGrammar_CompileWith_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompileWith'
+type CompileWithGrammar_CompileWith_Call struct {
+	*mock.Call
+}
+
+// CompileWith is a helper method to define mock.On call
+//   - builder squirrel.SelectBuilder
+//   - conditions *driver.Conditions
+func (_e *CompileWithGrammar_Expecter) CompileWith(builder interface{}, conditions interface{}) *CompileWithGrammar_CompileWith_Call {
+	return &CompileWithGrammar_CompileWith_Call{Call: _e.mock.On("CompileWith", builder, conditions)}
+}
+
+func (_c *CompileWithGrammar_CompileWith_Call) Run(run func(builder squirrel.SelectBuilder, conditions *driver.Conditions)) *CompileWithGrammar_CompileWith_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(squirrel.SelectBuilder), args[1].(*driver.Conditions))
+	})
+	return _c
+}
+
+func (_c *CompileWithGrammar_CompileWith_Call) Return(_a0 squirrel.SelectBuilder) *CompileWithGrammar_CompileWith_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompileWithGrammar_CompileWith_Call) RunAndReturn(run func(squirrel.SelectBuilder, *driver.Conditions) squirrel.SelectBuilder) *CompileWithGrammar_CompileWith_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompileWithGrammar creates a new instance of CompileWithGrammar. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompileWithGrammar(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompileWithGrammar {
+	mock := &CompileWithGrammar{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
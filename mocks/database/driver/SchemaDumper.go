@@ -0,0 +1,124 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package driver
+
+import mock "github.com/stretchr/testify/mock"
+
+// SchemaDumper is an autogenerated mock type for the SchemaDumper type
+type SchemaDumper struct {
+	mock.Mock
+}
+
+type SchemaDumper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SchemaDumper) EXPECT() *SchemaDumper_Expecter {
+	return &SchemaDumper_Expecter{mock: &_m.Mock}
+}
+
+// DumpSchema provides a mock function with given fields: path
+func (_m *SchemaDumper) DumpSchema(path string) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DumpSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchemaDumper_DumpSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DumpSchema'
+type SchemaDumper_DumpSchema_Call struct {
+	*mock.Call
+}
+
+// DumpSchema is a helper method to define mock.On call
+//   - path string
+func (_e *SchemaDumper_Expecter) DumpSchema(path interface{}) *SchemaDumper_DumpSchema_Call {
+	return &SchemaDumper_DumpSchema_Call{Call: _e.mock.On("DumpSchema", path)}
+}
+
+func (_c *SchemaDumper_DumpSchema_Call) Run(run func(path string)) *SchemaDumper_DumpSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SchemaDumper_DumpSchema_Call) Return(_a0 error) *SchemaDumper_DumpSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaDumper_DumpSchema_Call) RunAndReturn(run func(string) error) *SchemaDumper_DumpSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadSchema provides a mock function with given fields: path
+func (_m *SchemaDumper) LoadSchema(path string) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchemaDumper_LoadSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadSchema'
+type SchemaDumper_LoadSchema_Call struct {
+	*mock.Call
+}
+
+// LoadSchema is a helper method to define mock.On call
+//   - path string
+func (_e *SchemaDumper_Expecter) LoadSchema(path interface{}) *SchemaDumper_LoadSchema_Call {
+	return &SchemaDumper_LoadSchema_Call{Call: _e.mock.On("LoadSchema", path)}
+}
+
+func (_c *SchemaDumper_LoadSchema_Call) Run(run func(path string)) *SchemaDumper_LoadSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SchemaDumper_LoadSchema_Call) Return(_a0 error) *SchemaDumper_LoadSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaDumper_LoadSchema_Call) RunAndReturn(run func(string) error) *SchemaDumper_LoadSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSchemaDumper creates a new instance of SchemaDumper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSchemaDumper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SchemaDumper {
+	mock := &SchemaDumper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
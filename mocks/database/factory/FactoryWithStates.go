@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package factory
+
+import mock "github.com/stretchr/testify/mock"
+
+// FactoryWithStates is an autogenerated mock type for the FactoryWithStates type
+type FactoryWithStates struct {
+	mock.Mock
+}
+
+type FactoryWithStates_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FactoryWithStates) EXPECT() *FactoryWithStates_Expecter {
+	return &FactoryWithStates_Expecter{mock: &_m.Mock}
+}
+
+// States provides a mock function with no fields
+func (_m *FactoryWithStates) States() map[string]func(map[string]interface{}) map[string]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for States")
+	}
+
+	var r0 map[string]func(map[string]interface{}) map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]func(map[string]interface{}) map[string]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]func(map[string]interface{}) map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// FactoryWithStates_States_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'States'
+type FactoryWithStates_States_Call struct {
+	*mock.Call
+}
+
+// States is a helper method to define mock.On call
+func (_e *FactoryWithStates_Expecter) States() *FactoryWithStates_States_Call {
+	return &FactoryWithStates_States_Call{Call: _e.mock.On("States")}
+}
+
+func (_c *FactoryWithStates_States_Call) Run(run func()) *FactoryWithStates_States_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FactoryWithStates_States_Call) Return(_a0 map[string]func(map[string]interface{}) map[string]interface{}) *FactoryWithStates_States_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FactoryWithStates_States_Call) RunAndReturn(run func() map[string]func(map[string]interface{}) map[string]interface{}) *FactoryWithStates_States_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFactoryWithStates creates a new instance of FactoryWithStates. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFactoryWithStates(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FactoryWithStates {
+	mock := &FactoryWithStates{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package migration
+
+import mock "github.com/stretchr/testify/mock"
+
+// Lock is an autogenerated mock type for the Lock type
+type Lock struct {
+	mock.Mock
+}
+
+type Lock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Lock) EXPECT() *Lock_Expecter {
+	return &Lock_Expecter{mock: &_m.Mock}
+}
+
+// Acquire provides a mock function with given fields: wait
+func (_m *Lock) Acquire(wait bool) (func() error, error) {
+	ret := _m.Called(wait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acquire")
+	}
+
+	var r0 func() error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bool) (func() error, error)); ok {
+		return rf(wait)
+	}
+	if rf, ok := ret.Get(0).(func(bool) func() error); ok {
+		r0 = rf(wait)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func() error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(wait)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lock_Acquire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acquire'
+type Lock_Acquire_Call struct {
+	*mock.Call
+}
+
+// Acquire is a helper method to define mock.On call
+//   - wait bool
+func (_e *Lock_Expecter) Acquire(wait interface{}) *Lock_Acquire_Call {
+	return &Lock_Acquire_Call{Call: _e.mock.On("Acquire", wait)}
+}
+
+func (_c *Lock_Acquire_Call) Run(run func(wait bool)) *Lock_Acquire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *Lock_Acquire_Call) Return(release func() error, err error) *Lock_Acquire_Call {
+	_c.Call.Return(release, err)
+	return _c
+}
+
+func (_c *Lock_Acquire_Call) RunAndReturn(run func(bool) (func() error, error)) *Lock_Acquire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with no fields
+func (_m *Lock) Refresh() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Lock_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type Lock_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *Lock_Expecter) Refresh() *Lock_Refresh_Call {
+	return &Lock_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *Lock_Refresh_Call) Run(run func()) *Lock_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Lock_Refresh_Call) Return(_a0 error) *Lock_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Lock_Refresh_Call) RunAndReturn(run func() error) *Lock_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLock creates a new instance of Lock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Lock {
+	mock := &Lock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
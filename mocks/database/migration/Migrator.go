@@ -76,17 +76,23 @@ func (_c *Migrator_Create_Call) RunAndReturn(run func(string) (string, error)) *
 	return _c
 }
 
-// Fresh provides a mock function with no fields
-func (_m *Migrator) Fresh() error {
-	ret := _m.Called()
+// Fresh provides a mock function with given fields: options
+func (_m *Migrator) Fresh(options ...migration.FreshOptions) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Fresh")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(...migration.FreshOptions) error); ok {
+		r0 = rf(options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,13 +106,21 @@ type Migrator_Fresh_Call struct {
 }
 
 // Fresh is a helper method to define mock.On call
-func (_e *Migrator_Expecter) Fresh() *Migrator_Fresh_Call {
-	return &Migrator_Fresh_Call{Call: _e.mock.On("Fresh")}
+//   - options ...migration.FreshOptions
+func (_e *Migrator_Expecter) Fresh(options ...interface{}) *Migrator_Fresh_Call {
+	return &Migrator_Fresh_Call{Call: _e.mock.On("Fresh",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *Migrator_Fresh_Call) Run(run func()) *Migrator_Fresh_Call {
+func (_c *Migrator_Fresh_Call) Run(run func(options ...migration.FreshOptions)) *Migrator_Fresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]migration.FreshOptions, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(migration.FreshOptions)
+			}
+		}
+		run(variadicArgs...)
 	})
 	return _c
 }
@@ -116,7 +130,194 @@ func (_c *Migrator_Fresh_Call) Return(_a0 error) *Migrator_Fresh_Call {
 	return _c
 }
 
-func (_c *Migrator_Fresh_Call) RunAndReturn(run func() error) *Migrator_Fresh_Call {
+func (_c *Migrator_Fresh_Call) RunAndReturn(run func(...migration.FreshOptions) error) *Migrator_Fresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PretendFresh provides a mock function with given fields: options
+func (_m *Migrator) PretendFresh(options ...migration.FreshOptions) ([]migration.Pretended, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PretendFresh")
+	}
+
+	var r0 []migration.Pretended
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...migration.FreshOptions) ([]migration.Pretended, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...migration.FreshOptions) []migration.Pretended); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]migration.Pretended)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...migration.FreshOptions) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Migrator_PretendFresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PretendFresh'
+type Migrator_PretendFresh_Call struct {
+	*mock.Call
+}
+
+// PretendFresh is a helper method to define mock.On call
+//   - options ...migration.FreshOptions
+func (_e *Migrator_Expecter) PretendFresh(options ...interface{}) *Migrator_PretendFresh_Call {
+	return &Migrator_PretendFresh_Call{Call: _e.mock.On("PretendFresh",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *Migrator_PretendFresh_Call) Run(run func(options ...migration.FreshOptions)) *Migrator_PretendFresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]migration.FreshOptions, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(migration.FreshOptions)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Migrator_PretendFresh_Call) Return(_a0 []migration.Pretended, _a1 error) *Migrator_PretendFresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Migrator_PretendFresh_Call) RunAndReturn(run func(...migration.FreshOptions) ([]migration.Pretended, error)) *Migrator_PretendFresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PretendRollback provides a mock function with given fields: step, batch
+func (_m *Migrator) PretendRollback(step int, batch int) ([]migration.Pretended, error) {
+	ret := _m.Called(step, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PretendRollback")
+	}
+
+	var r0 []migration.Pretended
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]migration.Pretended, error)); ok {
+		return rf(step, batch)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []migration.Pretended); ok {
+		r0 = rf(step, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]migration.Pretended)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(step, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Migrator_PretendRollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PretendRollback'
+type Migrator_PretendRollback_Call struct {
+	*mock.Call
+}
+
+// PretendRollback is a helper method to define mock.On call
+//   - step int
+//   - batch int
+func (_e *Migrator_Expecter) PretendRollback(step interface{}, batch interface{}) *Migrator_PretendRollback_Call {
+	return &Migrator_PretendRollback_Call{Call: _e.mock.On("PretendRollback", step, batch)}
+}
+
+func (_c *Migrator_PretendRollback_Call) Run(run func(step int, batch int)) *Migrator_PretendRollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Migrator_PretendRollback_Call) Return(_a0 []migration.Pretended, _a1 error) *Migrator_PretendRollback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Migrator_PretendRollback_Call) RunAndReturn(run func(int, int) ([]migration.Pretended, error)) *Migrator_PretendRollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PretendRun provides a mock function with no fields
+func (_m *Migrator) PretendRun() ([]migration.Pretended, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PretendRun")
+	}
+
+	var r0 []migration.Pretended
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]migration.Pretended, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []migration.Pretended); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]migration.Pretended)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Migrator_PretendRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PretendRun'
+type Migrator_PretendRun_Call struct {
+	*mock.Call
+}
+
+// PretendRun is a helper method to define mock.On call
+func (_e *Migrator_Expecter) PretendRun() *Migrator_PretendRun_Call {
+	return &Migrator_PretendRun_Call{Call: _e.mock.On("PretendRun")}
+}
+
+func (_c *Migrator_PretendRun_Call) Run(run func()) *Migrator_PretendRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Migrator_PretendRun_Call) Return(_a0 []migration.Pretended, _a1 error) *Migrator_PretendRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Migrator_PretendRun_Call) RunAndReturn(run func() ([]migration.Pretended, error)) *Migrator_PretendRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -258,6 +459,51 @@ func (_c *Migrator_Run_Call) RunAndReturn(run func() error) *Migrator_Run_Call {
 	return _c
 }
 
+// RunIsolated provides a mock function with no fields
+func (_m *Migrator) RunIsolated() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunIsolated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Migrator_RunIsolated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunIsolated'
+type Migrator_RunIsolated_Call struct {
+	*mock.Call
+}
+
+// RunIsolated is a helper method to define mock.On call
+func (_e *Migrator_Expecter) RunIsolated() *Migrator_RunIsolated_Call {
+	return &Migrator_RunIsolated_Call{Call: _e.mock.On("RunIsolated")}
+}
+
+func (_c *Migrator_RunIsolated_Call) Run(run func()) *Migrator_RunIsolated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Migrator_RunIsolated_Call) Return(_a0 error) *Migrator_RunIsolated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Migrator_RunIsolated_Call) RunAndReturn(run func() error) *Migrator_RunIsolated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Status provides a mock function with no fields
 func (_m *Migrator) Status() ([]migration.Status, error) {
 	ret := _m.Called()
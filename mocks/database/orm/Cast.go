@@ -0,0 +1,148 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// Cast is an autogenerated mock type for the Cast type
+type Cast struct {
+	mock.Mock
+}
+
+type Cast_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Cast) EXPECT() *Cast_Expecter {
+	return &Cast_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: value
+func (_m *Cast) Get(value interface{}) (interface{}, error) {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (interface{}, error)); ok {
+		return rf(value)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) interface{}); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Cast_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type Cast_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - value interface{}
+func (_e *Cast_Expecter) Get(value interface{}) *Cast_Get_Call {
+	return &Cast_Get_Call{Call: _e.mock.On("Get", value)}
+}
+
+func (_c *Cast_Get_Call) Run(run func(value interface{})) *Cast_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Cast_Get_Call) Return(_a0 interface{}, _a1 error) *Cast_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Cast_Get_Call) RunAndReturn(run func(interface{}) (interface{}, error)) *Cast_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: value
+func (_m *Cast) Set(value interface{}) (interface{}, error) {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (interface{}, error)); ok {
+		return rf(value)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) interface{}); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Cast_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type Cast_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - value interface{}
+func (_e *Cast_Expecter) Set(value interface{}) *Cast_Set_Call {
+	return &Cast_Set_Call{Call: _e.mock.On("Set", value)}
+}
+
+func (_c *Cast_Set_Call) Run(run func(value interface{})) *Cast_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Cast_Set_Call) Return(_a0 interface{}, _a1 error) *Cast_Set_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Cast_Set_Call) RunAndReturn(run func(interface{}) (interface{}, error)) *Cast_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCast creates a new instance of Cast. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCast(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Cast {
+	mock := &Cast{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -20,6 +20,102 @@ func (_m *Factory) EXPECT() *Factory_Expecter {
 	return &Factory_Expecter{mock: &_m.Mock}
 }
 
+// AfterCreating provides a mock function with given fields: callback
+func (_m *Factory) AfterCreating(callback func(interface{}) error) orm.Factory {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterCreating")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(func(interface{}) error) orm.Factory); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_AfterCreating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterCreating'
+type Factory_AfterCreating_Call struct {
+	*mock.Call
+}
+
+// AfterCreating is a helper method to define mock.On call
+//   - callback func(interface{}) error
+func (_e *Factory_Expecter) AfterCreating(callback interface{}) *Factory_AfterCreating_Call {
+	return &Factory_AfterCreating_Call{Call: _e.mock.On("AfterCreating", callback)}
+}
+
+func (_c *Factory_AfterCreating_Call) Run(run func(callback func(interface{}) error)) *Factory_AfterCreating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(interface{}) error))
+	})
+	return _c
+}
+
+func (_c *Factory_AfterCreating_Call) Return(_a0 orm.Factory) *Factory_AfterCreating_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_AfterCreating_Call) RunAndReturn(run func(func(interface{}) error) orm.Factory) *Factory_AfterCreating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AfterMaking provides a mock function with given fields: callback
+func (_m *Factory) AfterMaking(callback func(interface{}) error) orm.Factory {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterMaking")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(func(interface{}) error) orm.Factory); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_AfterMaking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AfterMaking'
+type Factory_AfterMaking_Call struct {
+	*mock.Call
+}
+
+// AfterMaking is a helper method to define mock.On call
+//   - callback func(interface{}) error
+func (_e *Factory_Expecter) AfterMaking(callback interface{}) *Factory_AfterMaking_Call {
+	return &Factory_AfterMaking_Call{Call: _e.mock.On("AfterMaking", callback)}
+}
+
+func (_c *Factory_AfterMaking_Call) Run(run func(callback func(interface{}) error)) *Factory_AfterMaking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(interface{}) error))
+	})
+	return _c
+}
+
+func (_c *Factory_AfterMaking_Call) Return(_a0 orm.Factory) *Factory_AfterMaking_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_AfterMaking_Call) RunAndReturn(run func(func(interface{}) error) orm.Factory) *Factory_AfterMaking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Count provides a mock function with given fields: count
 func (_m *Factory) Count(count int) orm.Factory {
 	ret := _m.Called(count)
@@ -190,6 +286,104 @@ func (_c *Factory_CreateQuietly_Call) RunAndReturn(run func(interface{}, ...map[
 	return _c
 }
 
+// For provides a mock function with given fields: parent, relation
+func (_m *Factory) For(parent interface{}, relation string) orm.Factory {
+	ret := _m.Called(parent, relation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for For")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(interface{}, string) orm.Factory); ok {
+		r0 = rf(parent, relation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_For_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'For'
+type Factory_For_Call struct {
+	*mock.Call
+}
+
+// For is a helper method to define mock.On call
+//   - parent interface{}
+//   - relation string
+func (_e *Factory_Expecter) For(parent interface{}, relation interface{}) *Factory_For_Call {
+	return &Factory_For_Call{Call: _e.mock.On("For", parent, relation)}
+}
+
+func (_c *Factory_For_Call) Run(run func(parent interface{}, relation string)) *Factory_For_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_For_Call) Return(_a0 orm.Factory) *Factory_For_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_For_Call) RunAndReturn(run func(interface{}, string) orm.Factory) *Factory_For_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Has provides a mock function with given fields: factory, relation
+func (_m *Factory) Has(factory orm.Factory, relation string) orm.Factory {
+	ret := _m.Called(factory, relation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Has")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(orm.Factory, string) orm.Factory); ok {
+		r0 = rf(factory, relation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Has_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Has'
+type Factory_Has_Call struct {
+	*mock.Call
+}
+
+// Has is a helper method to define mock.On call
+//   - factory orm.Factory
+//   - relation string
+func (_e *Factory_Expecter) Has(factory interface{}, relation interface{}) *Factory_Has_Call {
+	return &Factory_Has_Call{Call: _e.mock.On("Has", factory, relation)}
+}
+
+func (_c *Factory_Has_Call) Run(run func(factory orm.Factory, relation string)) *Factory_Has_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(orm.Factory), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_Has_Call) Return(_a0 orm.Factory) *Factory_Has_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Has_Call) RunAndReturn(run func(orm.Factory, string) orm.Factory) *Factory_Has_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Make provides a mock function with given fields: value, attributes
 func (_m *Factory) Make(value interface{}, attributes ...map[string]interface{}) error {
 	_va := make([]interface{}, len(attributes))
@@ -251,6 +445,236 @@ func (_c *Factory_Make_Call) RunAndReturn(run func(interface{}, ...map[string]in
 	return _c
 }
 
+// NamedState provides a mock function with given fields: name
+func (_m *Factory) NamedState(name string) orm.Factory {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NamedState")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(string) orm.Factory); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_NamedState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NamedState'
+type Factory_NamedState_Call struct {
+	*mock.Call
+}
+
+// NamedState is a helper method to define mock.On call
+//   - name string
+func (_e *Factory_Expecter) NamedState(name interface{}) *Factory_NamedState_Call {
+	return &Factory_NamedState_Call{Call: _e.mock.On("NamedState", name)}
+}
+
+func (_c *Factory_NamedState_Call) Run(run func(name string)) *Factory_NamedState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Factory_NamedState_Call) Return(_a0 orm.Factory) *Factory_NamedState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_NamedState_Call) RunAndReturn(run func(string) orm.Factory) *Factory_NamedState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Raw provides a mock function with given fields: value, attributes
+func (_m *Factory) Raw(value interface{}, attributes ...map[string]interface{}) ([]map[string]interface{}, error) {
+	_va := make([]interface{}, len(attributes))
+	for _i := range attributes {
+		_va[_i] = attributes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Raw")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...map[string]interface{}) ([]map[string]interface{}, error)); ok {
+		return rf(value, attributes...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...map[string]interface{}) []map[string]interface{}); ok {
+		r0 = rf(value, attributes...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, ...map[string]interface{}) error); ok {
+		r1 = rf(value, attributes...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Factory_Raw_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Raw'
+type Factory_Raw_Call struct {
+	*mock.Call
+}
+
+// Raw is a helper method to define mock.On call
+//   - value interface{}
+//   - attributes ...map[string]interface{}
+func (_e *Factory_Expecter) Raw(value interface{}, attributes ...interface{}) *Factory_Raw_Call {
+	return &Factory_Raw_Call{Call: _e.mock.On("Raw",
+		append([]interface{}{value}, attributes...)...)}
+}
+
+func (_c *Factory_Raw_Call) Run(run func(value interface{}, attributes ...map[string]interface{})) *Factory_Raw_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Raw_Call) Return(_a0 []map[string]interface{}, _a1 error) *Factory_Raw_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Factory_Raw_Call) RunAndReturn(run func(interface{}, ...map[string]interface{}) ([]map[string]interface{}, error)) *Factory_Raw_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sequence provides a mock function with given fields: sequence
+func (_m *Factory) Sequence(sequence ...map[string]interface{}) orm.Factory {
+	_va := make([]interface{}, len(sequence))
+	for _i := range sequence {
+		_va[_i] = sequence[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sequence")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(...map[string]interface{}) orm.Factory); ok {
+		r0 = rf(sequence...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_Sequence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sequence'
+type Factory_Sequence_Call struct {
+	*mock.Call
+}
+
+// Sequence is a helper method to define mock.On call
+//   - sequence ...map[string]interface{}
+func (_e *Factory_Expecter) Sequence(sequence ...interface{}) *Factory_Sequence_Call {
+	return &Factory_Sequence_Call{Call: _e.mock.On("Sequence",
+		append([]interface{}{}, sequence...)...)}
+}
+
+func (_c *Factory_Sequence_Call) Run(run func(sequence ...map[string]interface{})) *Factory_Sequence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Factory_Sequence_Call) Return(_a0 orm.Factory) *Factory_Sequence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_Sequence_Call) RunAndReturn(run func(...map[string]interface{}) orm.Factory) *Factory_Sequence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// State provides a mock function with given fields: state
+func (_m *Factory) State(state func(map[string]interface{}) map[string]interface{}) orm.Factory {
+	ret := _m.Called(state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for State")
+	}
+
+	var r0 orm.Factory
+	if rf, ok := ret.Get(0).(func(func(map[string]interface{}) map[string]interface{}) orm.Factory); ok {
+		r0 = rf(state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Factory)
+		}
+	}
+
+	return r0
+}
+
+// Factory_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type Factory_State_Call struct {
+	*mock.Call
+}
+
+// State is a helper method to define mock.On call
+//   - state func(map[string]interface{}) map[string]interface{}
+func (_e *Factory_Expecter) State(state interface{}) *Factory_State_Call {
+	return &Factory_State_Call{Call: _e.mock.On("State", state)}
+}
+
+func (_c *Factory_State_Call) Run(run func(state func(map[string]interface{}) map[string]interface{})) *Factory_State_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(map[string]interface{}) map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *Factory_State_Call) Return(_a0 orm.Factory) *Factory_State_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Factory_State_Call) RunAndReturn(run func(func(map[string]interface{}) map[string]interface{}) orm.Factory) *Factory_State_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFactory creates a new instance of Factory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFactory(t interface {
@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import (
+	orm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MassPrunable is an autogenerated mock type for the MassPrunable type
+type MassPrunable struct {
+	mock.Mock
+}
+
+type MassPrunable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MassPrunable) EXPECT() *MassPrunable_Expecter {
+	return &MassPrunable_Expecter{mock: &_m.Mock}
+}
+
+// MassPrunable provides a mock function with no fields
+func (_m *MassPrunable) MassPrunable() orm.Query {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MassPrunable")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func() orm.Query); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// MassPrunable_MassPrunable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MassPrunable'
+type MassPrunable_MassPrunable_Call struct {
+	*mock.Call
+}
+
+// MassPrunable is a helper method to define mock.On call
+func (_e *MassPrunable_Expecter) MassPrunable() *MassPrunable_MassPrunable_Call {
+	return &MassPrunable_MassPrunable_Call{Call: _e.mock.On("MassPrunable")}
+}
+
+func (_c *MassPrunable_MassPrunable_Call) Run(run func()) *MassPrunable_MassPrunable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MassPrunable_MassPrunable_Call) Return(_a0 orm.Query) *MassPrunable_MassPrunable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MassPrunable_MassPrunable_Call) RunAndReturn(run func() orm.Query) *MassPrunable_MassPrunable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMassPrunable creates a new instance of MassPrunable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMassPrunable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MassPrunable {
+	mock := &MassPrunable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// ModelWithAppends is an autogenerated mock type for the ModelWithAppends type
+type ModelWithAppends struct {
+	mock.Mock
+}
+
+type ModelWithAppends_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithAppends) EXPECT() *ModelWithAppends_Expecter {
+	return &ModelWithAppends_Expecter{mock: &_m.Mock}
+}
+
+// Appends provides a mock function with no fields
+func (_m *ModelWithAppends) Appends() map[string]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Appends")
+	}
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// ModelWithAppends_Appends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Appends'
+type ModelWithAppends_Appends_Call struct {
+	*mock.Call
+}
+
+// Appends is a helper method to define mock.On call
+func (_e *ModelWithAppends_Expecter) Appends() *ModelWithAppends_Appends_Call {
+	return &ModelWithAppends_Appends_Call{Call: _e.mock.On("Appends")}
+}
+
+func (_c *ModelWithAppends_Appends_Call) Run(run func()) *ModelWithAppends_Appends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithAppends_Appends_Call) Return(_a0 map[string]interface{}) *ModelWithAppends_Appends_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithAppends_Appends_Call) RunAndReturn(run func() map[string]interface{}) *ModelWithAppends_Appends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithAppends creates a new instance of ModelWithAppends. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithAppends(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithAppends {
+	mock := &ModelWithAppends{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// ModelWithCascadeDeletes is an autogenerated mock type for the ModelWithCascadeDeletes type
+type ModelWithCascadeDeletes struct {
+	mock.Mock
+}
+
+type ModelWithCascadeDeletes_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithCascadeDeletes) EXPECT() *ModelWithCascadeDeletes_Expecter {
+	return &ModelWithCascadeDeletes_Expecter{mock: &_m.Mock}
+}
+
+// CascadeDeletes provides a mock function with no fields
+func (_m *ModelWithCascadeDeletes) CascadeDeletes() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CascadeDeletes")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// ModelWithCascadeDeletes_CascadeDeletes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CascadeDeletes'
+type ModelWithCascadeDeletes_CascadeDeletes_Call struct {
+	*mock.Call
+}
+
+// CascadeDeletes is a helper method to define mock.On call
+func (_e *ModelWithCascadeDeletes_Expecter) CascadeDeletes() *ModelWithCascadeDeletes_CascadeDeletes_Call {
+	return &ModelWithCascadeDeletes_CascadeDeletes_Call{Call: _e.mock.On("CascadeDeletes")}
+}
+
+func (_c *ModelWithCascadeDeletes_CascadeDeletes_Call) Run(run func()) *ModelWithCascadeDeletes_CascadeDeletes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithCascadeDeletes_CascadeDeletes_Call) Return(_a0 []string) *ModelWithCascadeDeletes_CascadeDeletes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithCascadeDeletes_CascadeDeletes_Call) RunAndReturn(run func() []string) *ModelWithCascadeDeletes_CascadeDeletes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithCascadeDeletes creates a new instance of ModelWithCascadeDeletes. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithCascadeDeletes(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithCascadeDeletes {
+	mock := &ModelWithCascadeDeletes{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import (
+	orm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ModelWithCasts is an autogenerated mock type for the ModelWithCasts type
+type ModelWithCasts struct {
+	mock.Mock
+}
+
+type ModelWithCasts_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithCasts) EXPECT() *ModelWithCasts_Expecter {
+	return &ModelWithCasts_Expecter{mock: &_m.Mock}
+}
+
+// Casts provides a mock function with no fields
+func (_m *ModelWithCasts) Casts() map[string]orm.Cast {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Casts")
+	}
+
+	var r0 map[string]orm.Cast
+	if rf, ok := ret.Get(0).(func() map[string]orm.Cast); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]orm.Cast)
+		}
+	}
+
+	return r0
+}
+
+// ModelWithCasts_Casts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Casts'
+type ModelWithCasts_Casts_Call struct {
+	*mock.Call
+}
+
+// Casts is a helper method to define mock.On call
+func (_e *ModelWithCasts_Expecter) Casts() *ModelWithCasts_Casts_Call {
+	return &ModelWithCasts_Casts_Call{Call: _e.mock.On("Casts")}
+}
+
+func (_c *ModelWithCasts_Casts_Call) Run(run func()) *ModelWithCasts_Casts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithCasts_Casts_Call) Return(_a0 map[string]orm.Cast) *ModelWithCasts_Casts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithCasts_Casts_Call) RunAndReturn(run func() map[string]orm.Cast) *ModelWithCasts_Casts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithCasts creates a new instance of ModelWithCasts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithCasts(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithCasts {
+	mock := &ModelWithCasts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// ModelWithHidden is an autogenerated mock type for the ModelWithHidden type
+type ModelWithHidden struct {
+	mock.Mock
+}
+
+type ModelWithHidden_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithHidden) EXPECT() *ModelWithHidden_Expecter {
+	return &ModelWithHidden_Expecter{mock: &_m.Mock}
+}
+
+// Hidden provides a mock function with no fields
+func (_m *ModelWithHidden) Hidden() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hidden")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// ModelWithHidden_Hidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hidden'
+type ModelWithHidden_Hidden_Call struct {
+	*mock.Call
+}
+
+// Hidden is a helper method to define mock.On call
+func (_e *ModelWithHidden_Expecter) Hidden() *ModelWithHidden_Hidden_Call {
+	return &ModelWithHidden_Hidden_Call{Call: _e.mock.On("Hidden")}
+}
+
+func (_c *ModelWithHidden_Hidden_Call) Run(run func()) *ModelWithHidden_Hidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithHidden_Hidden_Call) Return(_a0 []string) *ModelWithHidden_Hidden_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithHidden_Hidden_Call) RunAndReturn(run func() []string) *ModelWithHidden_Hidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithHidden creates a new instance of ModelWithHidden. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithHidden(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithHidden {
+	mock := &ModelWithHidden{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
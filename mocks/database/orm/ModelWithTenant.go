@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// ModelWithTenant is an autogenerated mock type for the ModelWithTenant type
+type ModelWithTenant struct {
+	mock.Mock
+}
+
+type ModelWithTenant_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithTenant) EXPECT() *ModelWithTenant_Expecter {
+	return &ModelWithTenant_Expecter{mock: &_m.Mock}
+}
+
+// TenantColumn provides a mock function with no fields
+func (_m *ModelWithTenant) TenantColumn() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantColumn")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ModelWithTenant_TenantColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantColumn'
+type ModelWithTenant_TenantColumn_Call struct {
+	*mock.Call
+}
+
+// TenantColumn is a helper method to define mock.On call
+func (_e *ModelWithTenant_Expecter) TenantColumn() *ModelWithTenant_TenantColumn_Call {
+	return &ModelWithTenant_TenantColumn_Call{Call: _e.mock.On("TenantColumn")}
+}
+
+func (_c *ModelWithTenant_TenantColumn_Call) Run(run func()) *ModelWithTenant_TenantColumn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithTenant_TenantColumn_Call) Return(_a0 string) *ModelWithTenant_TenantColumn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithTenant_TenantColumn_Call) RunAndReturn(run func() string) *ModelWithTenant_TenantColumn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithTenant creates a new instance of ModelWithTenant. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithTenant(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithTenant {
+	mock := &ModelWithTenant{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// ModelWithVersion is an autogenerated mock type for the ModelWithVersion type
+type ModelWithVersion struct {
+	mock.Mock
+}
+
+type ModelWithVersion_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithVersion) EXPECT() *ModelWithVersion_Expecter {
+	return &ModelWithVersion_Expecter{mock: &_m.Mock}
+}
+
+// VersionColumn provides a mock function with no fields
+func (_m *ModelWithVersion) VersionColumn() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VersionColumn")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ModelWithVersion_VersionColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VersionColumn'
+type ModelWithVersion_VersionColumn_Call struct {
+	*mock.Call
+}
+
+// VersionColumn is a helper method to define mock.On call
+func (_e *ModelWithVersion_Expecter) VersionColumn() *ModelWithVersion_VersionColumn_Call {
+	return &ModelWithVersion_VersionColumn_Call{Call: _e.mock.On("VersionColumn")}
+}
+
+func (_c *ModelWithVersion_VersionColumn_Call) Run(run func()) *ModelWithVersion_VersionColumn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithVersion_VersionColumn_Call) Return(_a0 string) *ModelWithVersion_VersionColumn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithVersion_VersionColumn_Call) RunAndReturn(run func() string) *ModelWithVersion_VersionColumn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithVersion creates a new instance of ModelWithVersion. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithVersion(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithVersion {
+	mock := &ModelWithVersion{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
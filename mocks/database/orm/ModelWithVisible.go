@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import mock "github.com/stretchr/testify/mock"
+
+// ModelWithVisible is an autogenerated mock type for the ModelWithVisible type
+type ModelWithVisible struct {
+	mock.Mock
+}
+
+type ModelWithVisible_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ModelWithVisible) EXPECT() *ModelWithVisible_Expecter {
+	return &ModelWithVisible_Expecter{mock: &_m.Mock}
+}
+
+// Visible provides a mock function with no fields
+func (_m *ModelWithVisible) Visible() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Visible")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// ModelWithVisible_Visible_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Visible'
+type ModelWithVisible_Visible_Call struct {
+	*mock.Call
+}
+
+// Visible is a helper method to define mock.On call
+func (_e *ModelWithVisible_Expecter) Visible() *ModelWithVisible_Visible_Call {
+	return &ModelWithVisible_Visible_Call{Call: _e.mock.On("Visible")}
+}
+
+func (_c *ModelWithVisible_Visible_Call) Run(run func()) *ModelWithVisible_Visible_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ModelWithVisible_Visible_Call) Return(_a0 []string) *ModelWithVisible_Visible_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ModelWithVisible_Visible_Call) RunAndReturn(run func() []string) *ModelWithVisible_Visible_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewModelWithVisible creates a new instance of ModelWithVisible. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModelWithVisible(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModelWithVisible {
+	mock := &ModelWithVisible{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
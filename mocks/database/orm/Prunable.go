@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package orm
+
+import (
+	orm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Prunable is an autogenerated mock type for the Prunable type
+type Prunable struct {
+	mock.Mock
+}
+
+type Prunable_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Prunable) EXPECT() *Prunable_Expecter {
+	return &Prunable_Expecter{mock: &_m.Mock}
+}
+
+// Prunable provides a mock function with no fields
+func (_m *Prunable) Prunable() orm.Query {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prunable")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func() orm.Query); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Prunable_Prunable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prunable'
+type Prunable_Prunable_Call struct {
+	*mock.Call
+}
+
+// Prunable is a helper method to define mock.On call
+func (_e *Prunable_Expecter) Prunable() *Prunable_Prunable_Call {
+	return &Prunable_Prunable_Call{Call: _e.mock.On("Prunable")}
+}
+
+func (_c *Prunable_Prunable_Call) Run(run func()) *Prunable_Prunable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Prunable_Prunable_Call) Return(_a0 orm.Query) *Prunable_Prunable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Prunable_Prunable_Call) RunAndReturn(run func() orm.Query) *Prunable_Prunable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrunable creates a new instance of Prunable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrunable(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Prunable {
+	mock := &Prunable{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
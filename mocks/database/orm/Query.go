@@ -9,6 +9,8 @@ import (
 	orm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
 
 	sql "database/sql"
+
+	time "time"
 )
 
 // Query is an autogenerated mock type for the Query type
@@ -233,6 +235,101 @@ func (_c *Query_BeginTransaction_Call) RunAndReturn(run func() (orm.Query, error
 	return _c
 }
 
+// Chunk provides a mock function with given fields: size, callback
+func (_m *Query) Chunk(size int, callback func(interface{}) error) error {
+	ret := _m.Called(size, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Chunk")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, func(interface{}) error) error); ok {
+		r0 = rf(size, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Query_Chunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chunk'
+type Query_Chunk_Call struct {
+	*mock.Call
+}
+
+// Chunk is a helper method to define mock.On call
+//   - size int
+//   - callback func(interface{}) error
+func (_e *Query_Expecter) Chunk(size interface{}, callback interface{}) *Query_Chunk_Call {
+	return &Query_Chunk_Call{Call: _e.mock.On("Chunk", size, callback)}
+}
+
+func (_c *Query_Chunk_Call) Run(run func(size int, callback func(interface{}) error)) *Query_Chunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(func(interface{}) error))
+	})
+	return _c
+}
+
+func (_c *Query_Chunk_Call) Return(_a0 error) *Query_Chunk_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Chunk_Call) RunAndReturn(run func(int, func(interface{}) error) error) *Query_Chunk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChunkByID provides a mock function with given fields: size, column, callback
+func (_m *Query) ChunkByID(size int, column string, callback func(interface{}) error) error {
+	ret := _m.Called(size, column, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChunkByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, func(interface{}) error) error); ok {
+		r0 = rf(size, column, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Query_ChunkByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChunkByID'
+type Query_ChunkByID_Call struct {
+	*mock.Call
+}
+
+// ChunkByID is a helper method to define mock.On call
+//   - size int
+//   - column string
+//   - callback func(interface{}) error
+func (_e *Query_Expecter) ChunkByID(size interface{}, column interface{}, callback interface{}) *Query_ChunkByID_Call {
+	return &Query_ChunkByID_Call{Call: _e.mock.On("ChunkByID", size, column, callback)}
+}
+
+func (_c *Query_ChunkByID_Call) Run(run func(size int, column string, callback func(interface{}) error)) *Query_ChunkByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(func(interface{}) error))
+	})
+	return _c
+}
+
+func (_c *Query_ChunkByID_Call) Return(_a0 error) *Query_ChunkByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_ChunkByID_Call) RunAndReturn(run func(int, string, func(interface{}) error) error) *Query_ChunkByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Commit provides a mock function with no fields
 func (_m *Query) Commit() error {
 	ret := _m.Called()
@@ -426,6 +523,71 @@ func (_c *Query_Cursor_Call) RunAndReturn(run func() chan db.Row) *Query_Cursor_
 	return _c
 }
 
+// CursorPaginate provides a mock function with given fields: limit, cursor, dest
+func (_m *Query) CursorPaginate(limit int, cursor string, dest interface{}) (string, string, error) {
+	ret := _m.Called(limit, cursor, dest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CursorPaginate")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, string, interface{}) (string, string, error)); ok {
+		return rf(limit, cursor, dest)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, interface{}) string); ok {
+		r0 = rf(limit, cursor, dest)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, interface{}) string); ok {
+		r1 = rf(limit, cursor, dest)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, string, interface{}) error); ok {
+		r2 = rf(limit, cursor, dest)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Query_CursorPaginate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CursorPaginate'
+type Query_CursorPaginate_Call struct {
+	*mock.Call
+}
+
+// CursorPaginate is a helper method to define mock.On call
+//   - limit int
+//   - cursor string
+//   - dest interface{}
+func (_e *Query_Expecter) CursorPaginate(limit interface{}, cursor interface{}, dest interface{}) *Query_CursorPaginate_Call {
+	return &Query_CursorPaginate_Call{Call: _e.mock.On("CursorPaginate", limit, cursor, dest)}
+}
+
+func (_c *Query_CursorPaginate_Call) Run(run func(limit int, cursor string, dest interface{})) *Query_CursorPaginate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Query_CursorPaginate_Call) Return(next string, prev string, err error) *Query_CursorPaginate_Call {
+	_c.Call.Return(next, prev, err)
+	return _c
+}
+
+func (_c *Query_CursorPaginate_Call) RunAndReturn(run func(int, string, interface{}) (string, string, error)) *Query_CursorPaginate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DB provides a mock function with no fields
 func (_m *Query) DB() (*sql.DB, error) {
 	ret := _m.Called()
@@ -656,6 +818,52 @@ func (_c *Query_Driver_Call) RunAndReturn(run func() string) *Query_Driver_Call
 	return _c
 }
 
+// Each provides a mock function with given fields: callback
+func (_m *Query) Each(callback func(interface{}) error) error {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Each")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(interface{}) error) error); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Query_Each_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Each'
+type Query_Each_Call struct {
+	*mock.Call
+}
+
+// Each is a helper method to define mock.On call
+//   - callback func(interface{}) error
+func (_e *Query_Expecter) Each(callback interface{}) *Query_Each_Call {
+	return &Query_Each_Call{Call: _e.mock.On("Each", callback)}
+}
+
+func (_c *Query_Each_Call) Run(run func(callback func(interface{}) error)) *Query_Each_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(interface{}) error))
+	})
+	return _c
+}
+
+func (_c *Query_Each_Call) Return(_a0 error) *Query_Each_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Each_Call) RunAndReturn(run func(func(interface{}) error) error) *Query_Each_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exec provides a mock function with given fields: _a0, values
 func (_m *Query) Exec(_a0 string, values ...interface{}) (*db.Result, error) {
 	var _ca []interface{}
@@ -1521,6 +1729,64 @@ func (_c *Query_InTransaction_Call) RunAndReturn(run func() bool) *Query_InTrans
 	return _c
 }
 
+// InsertOrIgnore provides a mock function with given fields: value
+func (_m *Query) InsertOrIgnore(value interface{}) (*db.Result, error) {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertOrIgnore")
+	}
+
+	var r0 *db.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}) (*db.Result, error)); ok {
+		return rf(value)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) *db.Result); ok {
+		r0 = rf(value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) error); ok {
+		r1 = rf(value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_InsertOrIgnore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertOrIgnore'
+type Query_InsertOrIgnore_Call struct {
+	*mock.Call
+}
+
+// InsertOrIgnore is a helper method to define mock.On call
+//   - value interface{}
+func (_e *Query_Expecter) InsertOrIgnore(value interface{}) *Query_InsertOrIgnore_Call {
+	return &Query_InsertOrIgnore_Call{Call: _e.mock.On("InsertOrIgnore", value)}
+}
+
+func (_c *Query_InsertOrIgnore_Call) Run(run func(value interface{})) *Query_InsertOrIgnore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Query_InsertOrIgnore_Call) Return(_a0 *db.Result, _a1 error) *Query_InsertOrIgnore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_InsertOrIgnore_Call) RunAndReturn(run func(interface{}) (*db.Result, error)) *Query_InsertOrIgnore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Join provides a mock function with given fields: query, args
 func (_m *Query) Join(query string, args ...interface{}) orm.Query {
 	var _ca []interface{}
@@ -2042,6 +2308,53 @@ func (_c *Query_Omit_Call) RunAndReturn(run func(...string) orm.Query) *Query_Om
 	return _c
 }
 
+// OnlyTrashed provides a mock function with no fields
+func (_m *Query) OnlyTrashed() orm.Query {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnlyTrashed")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func() orm.Query); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_OnlyTrashed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnlyTrashed'
+type Query_OnlyTrashed_Call struct {
+	*mock.Call
+}
+
+// OnlyTrashed is a helper method to define mock.On call
+func (_e *Query_Expecter) OnlyTrashed() *Query_OnlyTrashed_Call {
+	return &Query_OnlyTrashed_Call{Call: _e.mock.On("OnlyTrashed")}
+}
+
+func (_c *Query_OnlyTrashed_Call) Run(run func()) *Query_OnlyTrashed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Query_OnlyTrashed_Call) Return(_a0 orm.Query) *Query_OnlyTrashed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_OnlyTrashed_Call) RunAndReturn(run func() orm.Query) *Query_OnlyTrashed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrWhere provides a mock function with given fields: query, args
 func (_m *Query) OrWhere(query interface{}, args ...interface{}) orm.Query {
 	var _ca []interface{}
@@ -2151,6 +2464,70 @@ func (_c *Query_OrWhereBetween_Call) RunAndReturn(run func(string, interface{},
 	return _c
 }
 
+// OrWhereFullText provides a mock function with given fields: columns, value, options
+func (_m *Query) OrWhereFullText(columns []string, value string, options ...db.FullTextOptions) orm.Query {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, columns, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrWhereFullText")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func([]string, string, ...db.FullTextOptions) orm.Query); ok {
+		r0 = rf(columns, value, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_OrWhereFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrWhereFullText'
+type Query_OrWhereFullText_Call struct {
+	*mock.Call
+}
+
+// OrWhereFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options ...db.FullTextOptions
+func (_e *Query_Expecter) OrWhereFullText(columns interface{}, value interface{}, options ...interface{}) *Query_OrWhereFullText_Call {
+	return &Query_OrWhereFullText_Call{Call: _e.mock.On("OrWhereFullText",
+		append([]interface{}{columns, value}, options...)...)}
+}
+
+func (_c *Query_OrWhereFullText_Call) Run(run func(columns []string, value string, options ...db.FullTextOptions)) *Query_OrWhereFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]db.FullTextOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(db.FullTextOptions)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_OrWhereFullText_Call) Return(_a0 orm.Query) *Query_OrWhereFullText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_OrWhereFullText_Call) RunAndReturn(run func([]string, string, ...db.FullTextOptions) orm.Query) *Query_OrWhereFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrWhereIn provides a mock function with given fields: column, values
 func (_m *Query) OrWhereIn(column string, values []interface{}) orm.Query {
 	ret := _m.Called(column, values)
@@ -2749,6 +3126,70 @@ func (_c *Query_OrderByDesc_Call) RunAndReturn(run func(string) orm.Query) *Quer
 	return _c
 }
 
+// OrderByFullText provides a mock function with given fields: columns, value, options
+func (_m *Query) OrderByFullText(columns []string, value string, options ...db.FullTextOptions) orm.Query {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, columns, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderByFullText")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func([]string, string, ...db.FullTextOptions) orm.Query); ok {
+		r0 = rf(columns, value, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_OrderByFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderByFullText'
+type Query_OrderByFullText_Call struct {
+	*mock.Call
+}
+
+// OrderByFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options ...db.FullTextOptions
+func (_e *Query_Expecter) OrderByFullText(columns interface{}, value interface{}, options ...interface{}) *Query_OrderByFullText_Call {
+	return &Query_OrderByFullText_Call{Call: _e.mock.On("OrderByFullText",
+		append([]interface{}{columns, value}, options...)...)}
+}
+
+func (_c *Query_OrderByFullText_Call) Run(run func(columns []string, value string, options ...db.FullTextOptions)) *Query_OrderByFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]db.FullTextOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(db.FullTextOptions)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_OrderByFullText_Call) Return(_a0 orm.Query) *Query_OrderByFullText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_OrderByFullText_Call) RunAndReturn(run func([]string, string, ...db.FullTextOptions) orm.Query) *Query_OrderByFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrderByRaw provides a mock function with given fields: raw
 func (_m *Query) OrderByRaw(raw string) orm.Query {
 	ret := _m.Called(raw)
@@ -2952,6 +3393,69 @@ func (_c *Query_Raw_Call) RunAndReturn(run func(string, ...interface{}) orm.Quer
 	return _c
 }
 
+// Remember provides a mock function with given fields: ttl, key
+func (_m *Query) Remember(ttl time.Duration, key ...string) orm.Query {
+	_va := make([]interface{}, len(key))
+	for _i := range key {
+		_va[_i] = key[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ttl)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remember")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func(time.Duration, ...string) orm.Query); ok {
+		r0 = rf(ttl, key...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_Remember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remember'
+type Query_Remember_Call struct {
+	*mock.Call
+}
+
+// Remember is a helper method to define mock.On call
+//   - ttl time.Duration
+//   - key ...string
+func (_e *Query_Expecter) Remember(ttl interface{}, key ...interface{}) *Query_Remember_Call {
+	return &Query_Remember_Call{Call: _e.mock.On("Remember",
+		append([]interface{}{ttl}, key...)...)}
+}
+
+func (_c *Query_Remember_Call) Run(run func(ttl time.Duration, key ...string)) *Query_Remember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(time.Duration), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_Remember_Call) Return(_a0 orm.Query) *Query_Remember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Remember_Call) RunAndReturn(run func(time.Duration, ...string) orm.Query) *Query_Remember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Restore provides a mock function with given fields: model
 func (_m *Query) Restore(model ...interface{}) (*db.Result, error) {
 	var _ca []interface{}
@@ -3747,6 +4251,66 @@ func (_c *Query_UpdateOrCreate_Call) RunAndReturn(run func(interface{}, interfac
 	return _c
 }
 
+// Upsert provides a mock function with given fields: value, uniqueBy, update
+func (_m *Query) Upsert(value interface{}, uniqueBy []string, update []string) (*db.Result, error) {
+	ret := _m.Called(value, uniqueBy, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 *db.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, []string, []string) (*db.Result, error)); ok {
+		return rf(value, uniqueBy, update)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, []string, []string) *db.Result); ok {
+		r0 = rf(value, uniqueBy, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, []string, []string) error); ok {
+		r1 = rf(value, uniqueBy, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type Query_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - value interface{}
+//   - uniqueBy []string
+//   - update []string
+func (_e *Query_Expecter) Upsert(value interface{}, uniqueBy interface{}, update interface{}) *Query_Upsert_Call {
+	return &Query_Upsert_Call{Call: _e.mock.On("Upsert", value, uniqueBy, update)}
+}
+
+func (_c *Query_Upsert_Call) Run(run func(value interface{}, uniqueBy []string, update []string)) *Query_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].([]string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *Query_Upsert_Call) Return(_a0 *db.Result, _a1 error) *Query_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_Upsert_Call) RunAndReturn(run func(interface{}, []string, []string) (*db.Result, error)) *Query_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Where provides a mock function with given fields: query, args
 func (_m *Query) Where(query interface{}, args ...interface{}) orm.Query {
 	var _ca []interface{}
@@ -3974,6 +4538,70 @@ func (_c *Query_WhereBetween_Call) RunAndReturn(run func(string, interface{}, in
 	return _c
 }
 
+// WhereFullText provides a mock function with given fields: columns, value, options
+func (_m *Query) WhereFullText(columns []string, value string, options ...db.FullTextOptions) orm.Query {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, columns, value)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WhereFullText")
+	}
+
+	var r0 orm.Query
+	if rf, ok := ret.Get(0).(func([]string, string, ...db.FullTextOptions) orm.Query); ok {
+		r0 = rf(columns, value, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(orm.Query)
+		}
+	}
+
+	return r0
+}
+
+// Query_WhereFullText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WhereFullText'
+type Query_WhereFullText_Call struct {
+	*mock.Call
+}
+
+// WhereFullText is a helper method to define mock.On call
+//   - columns []string
+//   - value string
+//   - options ...db.FullTextOptions
+func (_e *Query_Expecter) WhereFullText(columns interface{}, value interface{}, options ...interface{}) *Query_WhereFullText_Call {
+	return &Query_WhereFullText_Call{Call: _e.mock.On("WhereFullText",
+		append([]interface{}{columns, value}, options...)...)}
+}
+
+func (_c *Query_WhereFullText_Call) Run(run func(columns []string, value string, options ...db.FullTextOptions)) *Query_WhereFullText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]db.FullTextOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(db.FullTextOptions)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Query_WhereFullText_Call) Return(_a0 orm.Query) *Query_WhereFullText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_WhereFullText_Call) RunAndReturn(run func([]string, string, ...db.FullTextOptions) orm.Query) *Query_WhereFullText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WhereIn provides a mock function with given fields: column, values
 func (_m *Query) WhereIn(column string, values []interface{}) orm.Query {
 	ret := _m.Called(column, values)
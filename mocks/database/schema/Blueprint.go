@@ -278,6 +278,40 @@ func (_c *Blueprint_Char_Call) RunAndReturn(run func(string, ...int) driver.Colu
 	return _c
 }
 
+// Check provides a mock function with given fields: name, expression
+func (_m *Blueprint) Check(name string, expression string) {
+	_m.Called(name, expression)
+}
+
+// Blueprint_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type Blueprint_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - name string
+//   - expression string
+func (_e *Blueprint_Expecter) Check(name interface{}, expression interface{}) *Blueprint_Check_Call {
+	return &Blueprint_Check_Call{Call: _e.mock.On("Check", name, expression)}
+}
+
+func (_c *Blueprint_Check_Call) Run(run func(name string, expression string)) *Blueprint_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Blueprint_Check_Call) Return() *Blueprint_Check_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Blueprint_Check_Call) RunAndReturn(run func(string, string)) *Blueprint_Check_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Column provides a mock function with given fields: column, ttype
 func (_m *Blueprint) Column(column string, ttype string) driver.ColumnDefinition {
 	ret := _m.Called(column, ttype)
@@ -740,6 +774,39 @@ func (_c *Blueprint_Drop_Call) RunAndReturn(run func()) *Blueprint_Drop_Call {
 	return _c
 }
 
+// DropCheck provides a mock function with given fields: name
+func (_m *Blueprint) DropCheck(name string) {
+	_m.Called(name)
+}
+
+// Blueprint_DropCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCheck'
+type Blueprint_DropCheck_Call struct {
+	*mock.Call
+}
+
+// DropCheck is a helper method to define mock.On call
+//   - name string
+func (_e *Blueprint_Expecter) DropCheck(name interface{}) *Blueprint_DropCheck_Call {
+	return &Blueprint_DropCheck_Call{Call: _e.mock.On("DropCheck", name)}
+}
+
+func (_c *Blueprint_DropCheck_Call) Run(run func(name string)) *Blueprint_DropCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Blueprint_DropCheck_Call) Return() *Blueprint_DropCheck_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Blueprint_DropCheck_Call) RunAndReturn(run func(string)) *Blueprint_DropCheck_Call {
+	_c.Run(run)
+	return _c
+}
+
 // DropColumn provides a mock function with given fields: column
 func (_m *Blueprint) DropColumn(column ...string) {
 	_va := make([]interface{}, len(column))
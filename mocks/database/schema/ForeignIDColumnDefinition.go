@@ -211,6 +211,102 @@ func (_c *ForeignIDColumnDefinition_Change_Call) RunAndReturn(run func() driver.
 	return _c
 }
 
+// Charset provides a mock function with given fields: charset
+func (_m *ForeignIDColumnDefinition) Charset(charset string) driver.ColumnDefinition {
+	ret := _m.Called(charset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Charset")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(charset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_Charset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charset'
+type ForeignIDColumnDefinition_Charset_Call struct {
+	*mock.Call
+}
+
+// Charset is a helper method to define mock.On call
+//   - charset string
+func (_e *ForeignIDColumnDefinition_Expecter) Charset(charset interface{}) *ForeignIDColumnDefinition_Charset_Call {
+	return &ForeignIDColumnDefinition_Charset_Call{Call: _e.mock.On("Charset", charset)}
+}
+
+func (_c *ForeignIDColumnDefinition_Charset_Call) Run(run func(charset string)) *ForeignIDColumnDefinition_Charset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_Charset_Call) Return(_a0 driver.ColumnDefinition) *ForeignIDColumnDefinition_Charset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_Charset_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ForeignIDColumnDefinition_Charset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Collation provides a mock function with given fields: collation
+func (_m *ForeignIDColumnDefinition) Collation(collation string) driver.ColumnDefinition {
+	ret := _m.Called(collation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Collation")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(collation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_Collation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Collation'
+type ForeignIDColumnDefinition_Collation_Call struct {
+	*mock.Call
+}
+
+// Collation is a helper method to define mock.On call
+//   - collation string
+func (_e *ForeignIDColumnDefinition_Expecter) Collation(collation interface{}) *ForeignIDColumnDefinition_Collation_Call {
+	return &ForeignIDColumnDefinition_Collation_Call{Call: _e.mock.On("Collation", collation)}
+}
+
+func (_c *ForeignIDColumnDefinition_Collation_Call) Run(run func(collation string)) *ForeignIDColumnDefinition_Collation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_Collation_Call) Return(_a0 driver.ColumnDefinition) *ForeignIDColumnDefinition_Collation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_Collation_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ForeignIDColumnDefinition_Collation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Comment provides a mock function with given fields: comment
 func (_m *ForeignIDColumnDefinition) Comment(comment string) driver.ColumnDefinition {
 	ret := _m.Called(comment)
@@ -602,6 +698,96 @@ func (_c *ForeignIDColumnDefinition_GetAutoIncrement_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetCharset provides a mock function with no fields
+func (_m *ForeignIDColumnDefinition) GetCharset() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCharset")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_GetCharset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCharset'
+type ForeignIDColumnDefinition_GetCharset_Call struct {
+	*mock.Call
+}
+
+// GetCharset is a helper method to define mock.On call
+func (_e *ForeignIDColumnDefinition_Expecter) GetCharset() *ForeignIDColumnDefinition_GetCharset_Call {
+	return &ForeignIDColumnDefinition_GetCharset_Call{Call: _e.mock.On("GetCharset")}
+}
+
+func (_c *ForeignIDColumnDefinition_GetCharset_Call) Run(run func()) *ForeignIDColumnDefinition_GetCharset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetCharset_Call) Return(_a0 string) *ForeignIDColumnDefinition_GetCharset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetCharset_Call) RunAndReturn(run func() string) *ForeignIDColumnDefinition_GetCharset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollation provides a mock function with no fields
+func (_m *ForeignIDColumnDefinition) GetCollation() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollation")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_GetCollation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollation'
+type ForeignIDColumnDefinition_GetCollation_Call struct {
+	*mock.Call
+}
+
+// GetCollation is a helper method to define mock.On call
+func (_e *ForeignIDColumnDefinition_Expecter) GetCollation() *ForeignIDColumnDefinition_GetCollation_Call {
+	return &ForeignIDColumnDefinition_GetCollation_Call{Call: _e.mock.On("GetCollation")}
+}
+
+func (_c *ForeignIDColumnDefinition_GetCollation_Call) Run(run func()) *ForeignIDColumnDefinition_GetCollation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetCollation_Call) Return(_a0 string) *ForeignIDColumnDefinition_GetCollation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetCollation_Call) RunAndReturn(run func() string) *ForeignIDColumnDefinition_GetCollation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComment provides a mock function with no fields
 func (_m *ForeignIDColumnDefinition) GetComment() string {
 	ret := _m.Called()
@@ -1011,6 +1197,51 @@ func (_c *ForeignIDColumnDefinition_GetPrecision_Call) RunAndReturn(run func() i
 	return _c
 }
 
+// GetStoredAs provides a mock function with no fields
+func (_m *ForeignIDColumnDefinition) GetStoredAs() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStoredAs")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_GetStoredAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStoredAs'
+type ForeignIDColumnDefinition_GetStoredAs_Call struct {
+	*mock.Call
+}
+
+// GetStoredAs is a helper method to define mock.On call
+func (_e *ForeignIDColumnDefinition_Expecter) GetStoredAs() *ForeignIDColumnDefinition_GetStoredAs_Call {
+	return &ForeignIDColumnDefinition_GetStoredAs_Call{Call: _e.mock.On("GetStoredAs")}
+}
+
+func (_c *ForeignIDColumnDefinition_GetStoredAs_Call) Run(run func()) *ForeignIDColumnDefinition_GetStoredAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetStoredAs_Call) Return(_a0 string) *ForeignIDColumnDefinition_GetStoredAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetStoredAs_Call) RunAndReturn(run func() string) *ForeignIDColumnDefinition_GetStoredAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotal provides a mock function with no fields
 func (_m *ForeignIDColumnDefinition) GetTotal() int {
 	ret := _m.Called()
@@ -1236,6 +1467,51 @@ func (_c *ForeignIDColumnDefinition_GetUseCurrentOnUpdate_Call) RunAndReturn(run
 	return _c
 }
 
+// GetVirtualAs provides a mock function with no fields
+func (_m *ForeignIDColumnDefinition) GetVirtualAs() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVirtualAs")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_GetVirtualAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVirtualAs'
+type ForeignIDColumnDefinition_GetVirtualAs_Call struct {
+	*mock.Call
+}
+
+// GetVirtualAs is a helper method to define mock.On call
+func (_e *ForeignIDColumnDefinition_Expecter) GetVirtualAs() *ForeignIDColumnDefinition_GetVirtualAs_Call {
+	return &ForeignIDColumnDefinition_GetVirtualAs_Call{Call: _e.mock.On("GetVirtualAs")}
+}
+
+func (_c *ForeignIDColumnDefinition_GetVirtualAs_Call) Run(run func()) *ForeignIDColumnDefinition_GetVirtualAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetVirtualAs_Call) Return(_a0 string) *ForeignIDColumnDefinition_GetVirtualAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_GetVirtualAs_Call) RunAndReturn(run func() string) *ForeignIDColumnDefinition_GetVirtualAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsAlways provides a mock function with no fields
 func (_m *ForeignIDColumnDefinition) IsAlways() bool {
 	ret := _m.Called()
@@ -1653,6 +1929,54 @@ func (_c *ForeignIDColumnDefinition_References_Call) RunAndReturn(run func(strin
 	return _c
 }
 
+// StoredAs provides a mock function with given fields: expression
+func (_m *ForeignIDColumnDefinition) StoredAs(expression string) driver.ColumnDefinition {
+	ret := _m.Called(expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoredAs")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(expression)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_StoredAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoredAs'
+type ForeignIDColumnDefinition_StoredAs_Call struct {
+	*mock.Call
+}
+
+// StoredAs is a helper method to define mock.On call
+//   - expression string
+func (_e *ForeignIDColumnDefinition_Expecter) StoredAs(expression interface{}) *ForeignIDColumnDefinition_StoredAs_Call {
+	return &ForeignIDColumnDefinition_StoredAs_Call{Call: _e.mock.On("StoredAs", expression)}
+}
+
+func (_c *ForeignIDColumnDefinition_StoredAs_Call) Run(run func(expression string)) *ForeignIDColumnDefinition_StoredAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_StoredAs_Call) Return(_a0 driver.ColumnDefinition) *ForeignIDColumnDefinition_StoredAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_StoredAs_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ForeignIDColumnDefinition_StoredAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Total provides a mock function with given fields: total
 func (_m *ForeignIDColumnDefinition) Total(total int) driver.ColumnDefinition {
 	ret := _m.Called(total)
@@ -1842,6 +2166,54 @@ func (_c *ForeignIDColumnDefinition_UseCurrentOnUpdate_Call) RunAndReturn(run fu
 	return _c
 }
 
+// VirtualAs provides a mock function with given fields: expression
+func (_m *ForeignIDColumnDefinition) VirtualAs(expression string) driver.ColumnDefinition {
+	ret := _m.Called(expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VirtualAs")
+	}
+
+	var r0 driver.ColumnDefinition
+	if rf, ok := ret.Get(0).(func(string) driver.ColumnDefinition); ok {
+		r0 = rf(expression)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(driver.ColumnDefinition)
+		}
+	}
+
+	return r0
+}
+
+// ForeignIDColumnDefinition_VirtualAs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VirtualAs'
+type ForeignIDColumnDefinition_VirtualAs_Call struct {
+	*mock.Call
+}
+
+// VirtualAs is a helper method to define mock.On call
+//   - expression string
+func (_e *ForeignIDColumnDefinition_Expecter) VirtualAs(expression interface{}) *ForeignIDColumnDefinition_VirtualAs_Call {
+	return &ForeignIDColumnDefinition_VirtualAs_Call{Call: _e.mock.On("VirtualAs", expression)}
+}
+
+func (_c *ForeignIDColumnDefinition_VirtualAs_Call) Run(run func(expression string)) *ForeignIDColumnDefinition_VirtualAs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_VirtualAs_Call) Return(_a0 driver.ColumnDefinition) *ForeignIDColumnDefinition_VirtualAs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ForeignIDColumnDefinition_VirtualAs_Call) RunAndReturn(run func(string) driver.ColumnDefinition) *ForeignIDColumnDefinition_VirtualAs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewForeignIDColumnDefinition creates a new instance of ForeignIDColumnDefinition. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewForeignIDColumnDefinition(t interface {
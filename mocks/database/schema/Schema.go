@@ -119,6 +119,115 @@ func (_c *Schema_Create_Call) RunAndReturn(run func(string, func(schema.Blueprin
 	return _c
 }
 
+// CreateMaterializedView provides a mock function with given fields: name, query
+func (_m *Schema) CreateMaterializedView(name string, query interface{}) error {
+	ret := _m.Called(name, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMaterializedView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) error); ok {
+		r0 = rf(name, query)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_CreateMaterializedView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMaterializedView'
+type Schema_CreateMaterializedView_Call struct {
+	*mock.Call
+}
+
+// CreateMaterializedView is a helper method to define mock.On call
+//   - name string
+//   - query interface{}
+func (_e *Schema_Expecter) CreateMaterializedView(name interface{}, query interface{}) *Schema_CreateMaterializedView_Call {
+	return &Schema_CreateMaterializedView_Call{Call: _e.mock.On("CreateMaterializedView", name, query)}
+}
+
+func (_c *Schema_CreateMaterializedView_Call) Run(run func(name string, query interface{})) *Schema_CreateMaterializedView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Schema_CreateMaterializedView_Call) Return(_a0 error) *Schema_CreateMaterializedView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_CreateMaterializedView_Call) RunAndReturn(run func(string, interface{}) error) *Schema_CreateMaterializedView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateView provides a mock function with given fields: name, query, orReplace
+func (_m *Schema) CreateView(name string, query interface{}, orReplace ...bool) error {
+	_va := make([]interface{}, len(orReplace))
+	for _i := range orReplace {
+		_va[_i] = orReplace[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, query)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}, ...bool) error); ok {
+		r0 = rf(name, query, orReplace...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_CreateView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateView'
+type Schema_CreateView_Call struct {
+	*mock.Call
+}
+
+// CreateView is a helper method to define mock.On call
+//   - name string
+//   - query interface{}
+//   - orReplace ...bool
+func (_e *Schema_Expecter) CreateView(name interface{}, query interface{}, orReplace ...interface{}) *Schema_CreateView_Call {
+	return &Schema_CreateView_Call{Call: _e.mock.On("CreateView",
+		append([]interface{}{name, query}, orReplace...)...)}
+}
+
+func (_c *Schema_CreateView_Call) Run(run func(name string, query interface{}, orReplace ...bool)) *Schema_CreateView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]bool, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(bool)
+			}
+		}
+		run(args[0].(string), args[1].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Schema_CreateView_Call) Return(_a0 error) *Schema_CreateView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_CreateView_Call) RunAndReturn(run func(string, interface{}, ...bool) error) *Schema_CreateView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Drop provides a mock function with given fields: table
 func (_m *Schema) Drop(table string) error {
 	ret := _m.Called(table)
@@ -393,6 +502,145 @@ func (_c *Schema_DropIfExists_Call) RunAndReturn(run func(string) error) *Schema
 	return _c
 }
 
+// DropMaterializedView provides a mock function with given fields: name
+func (_m *Schema) DropMaterializedView(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropMaterializedView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_DropMaterializedView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropMaterializedView'
+type Schema_DropMaterializedView_Call struct {
+	*mock.Call
+}
+
+// DropMaterializedView is a helper method to define mock.On call
+//   - name string
+func (_e *Schema_Expecter) DropMaterializedView(name interface{}) *Schema_DropMaterializedView_Call {
+	return &Schema_DropMaterializedView_Call{Call: _e.mock.On("DropMaterializedView", name)}
+}
+
+func (_c *Schema_DropMaterializedView_Call) Run(run func(name string)) *Schema_DropMaterializedView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_DropMaterializedView_Call) Return(_a0 error) *Schema_DropMaterializedView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_DropMaterializedView_Call) RunAndReturn(run func(string) error) *Schema_DropMaterializedView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropView provides a mock function with given fields: name
+func (_m *Schema) DropView(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_DropView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropView'
+type Schema_DropView_Call struct {
+	*mock.Call
+}
+
+// DropView is a helper method to define mock.On call
+//   - name string
+func (_e *Schema_Expecter) DropView(name interface{}) *Schema_DropView_Call {
+	return &Schema_DropView_Call{Call: _e.mock.On("DropView", name)}
+}
+
+func (_c *Schema_DropView_Call) Run(run func(name string)) *Schema_DropView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_DropView_Call) Return(_a0 error) *Schema_DropView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_DropView_Call) RunAndReturn(run func(string) error) *Schema_DropView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dump provides a mock function with given fields: path, migrationTable
+func (_m *Schema) Dump(path string, migrationTable string) error {
+	ret := _m.Called(path, migrationTable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dump")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, migrationTable)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_Dump_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dump'
+type Schema_Dump_Call struct {
+	*mock.Call
+}
+
+// Dump is a helper method to define mock.On call
+//   - path string
+//   - migrationTable string
+func (_e *Schema_Expecter) Dump(path interface{}, migrationTable interface{}) *Schema_Dump_Call {
+	return &Schema_Dump_Call{Call: _e.mock.On("Dump", path, migrationTable)}
+}
+
+func (_c *Schema_Dump_Call) Run(run func(path string, migrationTable string)) *Schema_Dump_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_Dump_Call) Return(_a0 error) *Schema_Dump_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_Dump_Call) RunAndReturn(run func(string, string) error) *Schema_Dump_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Extend provides a mock function with given fields: extend
 func (_m *Schema) Extend(extend schema.Extension) schema.Schema {
 	ret := _m.Called(extend)
@@ -441,6 +689,64 @@ func (_c *Schema_Extend_Call) RunAndReturn(run func(schema.Extension) schema.Sch
 	return _c
 }
 
+// GetChecks provides a mock function with given fields: table
+func (_m *Schema) GetChecks(table string) ([]driver.Check, error) {
+	ret := _m.Called(table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecks")
+	}
+
+	var r0 []driver.Check
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]driver.Check, error)); ok {
+		return rf(table)
+	}
+	if rf, ok := ret.Get(0).(func(string) []driver.Check); ok {
+		r0 = rf(table)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]driver.Check)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(table)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Schema_GetChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChecks'
+type Schema_GetChecks_Call struct {
+	*mock.Call
+}
+
+// GetChecks is a helper method to define mock.On call
+//   - table string
+func (_e *Schema_Expecter) GetChecks(table interface{}) *Schema_GetChecks_Call {
+	return &Schema_GetChecks_Call{Call: _e.mock.On("GetChecks", table)}
+}
+
+func (_c *Schema_GetChecks_Call) Run(run func(table string)) *Schema_GetChecks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_GetChecks_Call) Return(_a0 []driver.Check, _a1 error) *Schema_GetChecks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Schema_GetChecks_Call) RunAndReturn(run func(string) ([]driver.Check, error)) *Schema_GetChecks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetColumnListing provides a mock function with given fields: table
 func (_m *Schema) GetColumnListing(table string) []string {
 	ret := _m.Called(table)
@@ -1300,6 +1606,52 @@ func (_c *Schema_HasView_Call) RunAndReturn(run func(string) bool) *Schema_HasVi
 	return _c
 }
 
+// Load provides a mock function with given fields: path
+func (_m *Schema) Load(path string) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type Schema_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - path string
+func (_e *Schema_Expecter) Load(path interface{}) *Schema_Load_Call {
+	return &Schema_Load_Call{Call: _e.mock.On("Load", path)}
+}
+
+func (_c *Schema_Load_Call) Run(run func(path string)) *Schema_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Schema_Load_Call) Return(_a0 error) *Schema_Load_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_Load_Call) RunAndReturn(run func(string) error) *Schema_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Migrations provides a mock function with no fields
 func (_m *Schema) Migrations() []schema.Migration {
 	ret := _m.Called()
@@ -1394,6 +1746,64 @@ func (_c *Schema_Orm_Call) RunAndReturn(run func() orm.Orm) *Schema_Orm_Call {
 	return _c
 }
 
+// Pretend provides a mock function with given fields: callback
+func (_m *Schema) Pretend(callback func() error) ([]string, error) {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pretend")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(func() error) ([]string, error)); ok {
+		return rf(callback)
+	}
+	if rf, ok := ret.Get(0).(func(func() error) []string); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(func() error) error); ok {
+		r1 = rf(callback)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Schema_Pretend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pretend'
+type Schema_Pretend_Call struct {
+	*mock.Call
+}
+
+// Pretend is a helper method to define mock.On call
+//   - callback func() error
+func (_e *Schema_Expecter) Pretend(callback interface{}) *Schema_Pretend_Call {
+	return &Schema_Pretend_Call{Call: _e.mock.On("Pretend", callback)}
+}
+
+func (_c *Schema_Pretend_Call) Run(run func(callback func() error)) *Schema_Pretend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func() error))
+	})
+	return _c
+}
+
+func (_c *Schema_Pretend_Call) Return(_a0 []string, _a1 error) *Schema_Pretend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Schema_Pretend_Call) RunAndReturn(run func(func() error) ([]string, error)) *Schema_Pretend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Prune provides a mock function with no fields
 func (_m *Schema) Prune() error {
 	ret := _m.Called()
@@ -1439,6 +1849,67 @@ func (_c *Schema_Prune_Call) RunAndReturn(run func() error) *Schema_Prune_Call {
 	return _c
 }
 
+// RefreshMaterializedView provides a mock function with given fields: name, concurrently
+func (_m *Schema) RefreshMaterializedView(name string, concurrently ...bool) error {
+	_va := make([]interface{}, len(concurrently))
+	for _i := range concurrently {
+		_va[_i] = concurrently[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshMaterializedView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...bool) error); ok {
+		r0 = rf(name, concurrently...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Schema_RefreshMaterializedView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshMaterializedView'
+type Schema_RefreshMaterializedView_Call struct {
+	*mock.Call
+}
+
+// RefreshMaterializedView is a helper method to define mock.On call
+//   - name string
+//   - concurrently ...bool
+func (_e *Schema_Expecter) RefreshMaterializedView(name interface{}, concurrently ...interface{}) *Schema_RefreshMaterializedView_Call {
+	return &Schema_RefreshMaterializedView_Call{Call: _e.mock.On("RefreshMaterializedView",
+		append([]interface{}{name}, concurrently...)...)}
+}
+
+func (_c *Schema_RefreshMaterializedView_Call) Run(run func(name string, concurrently ...bool)) *Schema_RefreshMaterializedView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]bool, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(bool)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Schema_RefreshMaterializedView_Call) Return(_a0 error) *Schema_RefreshMaterializedView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Schema_RefreshMaterializedView_Call) RunAndReturn(run func(string, ...bool) error) *Schema_RefreshMaterializedView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: _a0
 func (_m *Schema) Register(_a0 []schema.Migration) {
 	_m.Called(_a0)
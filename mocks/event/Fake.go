@@ -0,0 +1,1038 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package event
+
+import (
+	event "github.com/rusmanplatd/goravelframework/contracts/event"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Fake is an autogenerated mock type for the Fake type
+type Fake struct {
+	mock.Mock
+}
+
+type Fake_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Fake) EXPECT() *Fake_Expecter {
+	return &Fake_Expecter{mock: &_m.Mock}
+}
+
+// AssertDispatched provides a mock function with given fields: _a0, callback
+func (_m *Fake) AssertDispatched(_a0 interface{}, callback ...func([]interface{}) bool) bool {
+	_va := make([]interface{}, len(callback))
+	for _i := range callback {
+		_va[_i] = callback[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertDispatched")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}, ...func([]interface{}) bool) bool); ok {
+		r0 = rf(_a0, callback...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertDispatched'
+type Fake_AssertDispatched_Call struct {
+	*mock.Call
+}
+
+// AssertDispatched is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - callback ...func([]interface{}) bool
+func (_e *Fake_Expecter) AssertDispatched(_a0 interface{}, callback ...interface{}) *Fake_AssertDispatched_Call {
+	return &Fake_AssertDispatched_Call{Call: _e.mock.On("AssertDispatched",
+		append([]interface{}{_a0}, callback...)...)}
+}
+
+func (_c *Fake_AssertDispatched_Call) Run(run func(_a0 interface{}, callback ...func([]interface{}) bool)) *Fake_AssertDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func([]interface{}) bool, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(func([]interface{}) bool)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_AssertDispatched_Call) Return(_a0 bool) *Fake_AssertDispatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertDispatched_Call) RunAndReturn(run func(interface{}, ...func([]interface{}) bool) bool) *Fake_AssertDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertDispatchedTimes provides a mock function with given fields: _a0, times
+func (_m *Fake) AssertDispatchedTimes(_a0 interface{}, times int) bool {
+	ret := _m.Called(_a0, times)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertDispatchedTimes")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}, int) bool); ok {
+		r0 = rf(_a0, times)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertDispatchedTimes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertDispatchedTimes'
+type Fake_AssertDispatchedTimes_Call struct {
+	*mock.Call
+}
+
+// AssertDispatchedTimes is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - times int
+func (_e *Fake_Expecter) AssertDispatchedTimes(_a0 interface{}, times interface{}) *Fake_AssertDispatchedTimes_Call {
+	return &Fake_AssertDispatchedTimes_Call{Call: _e.mock.On("AssertDispatchedTimes", _a0, times)}
+}
+
+func (_c *Fake_AssertDispatchedTimes_Call) Run(run func(_a0 interface{}, times int)) *Fake_AssertDispatchedTimes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertDispatchedTimes_Call) Return(_a0 bool) *Fake_AssertDispatchedTimes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertDispatchedTimes_Call) RunAndReturn(run func(interface{}, int) bool) *Fake_AssertDispatchedTimes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertListening provides a mock function with given fields: _a0, listener
+func (_m *Fake) AssertListening(_a0 interface{}, listener interface{}) bool {
+	ret := _m.Called(_a0, listener)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertListening")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}, interface{}) bool); ok {
+		r0 = rf(_a0, listener)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertListening_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertListening'
+type Fake_AssertListening_Call struct {
+	*mock.Call
+}
+
+// AssertListening is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - listener interface{}
+func (_e *Fake_Expecter) AssertListening(_a0 interface{}, listener interface{}) *Fake_AssertListening_Call {
+	return &Fake_AssertListening_Call{Call: _e.mock.On("AssertListening", _a0, listener)}
+}
+
+func (_c *Fake_AssertListening_Call) Run(run func(_a0 interface{}, listener interface{})) *Fake_AssertListening_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Fake_AssertListening_Call) Return(_a0 bool) *Fake_AssertListening_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertListening_Call) RunAndReturn(run func(interface{}, interface{}) bool) *Fake_AssertListening_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNotDispatched provides a mock function with given fields: _a0, callback
+func (_m *Fake) AssertNotDispatched(_a0 interface{}, callback ...func([]interface{}) bool) bool {
+	_va := make([]interface{}, len(callback))
+	for _i := range callback {
+		_va[_i] = callback[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNotDispatched")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}, ...func([]interface{}) bool) bool); ok {
+		r0 = rf(_a0, callback...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNotDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNotDispatched'
+type Fake_AssertNotDispatched_Call struct {
+	*mock.Call
+}
+
+// AssertNotDispatched is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - callback ...func([]interface{}) bool
+func (_e *Fake_Expecter) AssertNotDispatched(_a0 interface{}, callback ...interface{}) *Fake_AssertNotDispatched_Call {
+	return &Fake_AssertNotDispatched_Call{Call: _e.mock.On("AssertNotDispatched",
+		append([]interface{}{_a0}, callback...)...)}
+}
+
+func (_c *Fake_AssertNotDispatched_Call) Run(run func(_a0 interface{}, callback ...func([]interface{}) bool)) *Fake_AssertNotDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func([]interface{}) bool, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(func([]interface{}) bool)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNotDispatched_Call) Return(_a0 bool) *Fake_AssertNotDispatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNotDispatched_Call) RunAndReturn(run func(interface{}, ...func([]interface{}) bool) bool) *Fake_AssertNotDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssertNothingDispatched provides a mock function with no fields
+func (_m *Fake) AssertNothingDispatched() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssertNothingDispatched")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_AssertNothingDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssertNothingDispatched'
+type Fake_AssertNothingDispatched_Call struct {
+	*mock.Call
+}
+
+// AssertNothingDispatched is a helper method to define mock.On call
+func (_e *Fake_Expecter) AssertNothingDispatched() *Fake_AssertNothingDispatched_Call {
+	return &Fake_AssertNothingDispatched_Call{Call: _e.mock.On("AssertNothingDispatched")}
+}
+
+func (_c *Fake_AssertNothingDispatched_Call) Run(run func()) *Fake_AssertNothingDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_AssertNothingDispatched_Call) Return(_a0 bool) *Fake_AssertNothingDispatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_AssertNothingDispatched_Call) RunAndReturn(run func() bool) *Fake_AssertNothingDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function with given fields: _a0, payload
+func (_m *Fake) Dispatch(_a0 interface{}, payload ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, payload...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(_a0, payload...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(_a0, payload...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, ...interface{}) error); ok {
+		r1 = rf(_a0, payload...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Fake_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type Fake_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - payload ...interface{}
+func (_e *Fake_Expecter) Dispatch(_a0 interface{}, payload ...interface{}) *Fake_Dispatch_Call {
+	return &Fake_Dispatch_Call{Call: _e.mock.On("Dispatch",
+		append([]interface{}{_a0}, payload...)...)}
+}
+
+func (_c *Fake_Dispatch_Call) Run(run func(_a0 interface{}, payload ...interface{})) *Fake_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Dispatch_Call) Return(_a0 []interface{}, _a1 error) *Fake_Dispatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Fake_Dispatch_Call) RunAndReturn(run func(interface{}, ...interface{}) ([]interface{}, error)) *Fake_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatched provides a mock function with given fields: _a0, callback
+func (_m *Fake) Dispatched(_a0 interface{}, callback ...func([]interface{}) bool) [][]interface{} {
+	_va := make([]interface{}, len(callback))
+	for _i := range callback {
+		_va[_i] = callback[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatched")
+	}
+
+	var r0 [][]interface{}
+	if rf, ok := ret.Get(0).(func(interface{}, ...func([]interface{}) bool) [][]interface{}); ok {
+		r0 = rf(_a0, callback...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]interface{})
+		}
+	}
+
+	return r0
+}
+
+// Fake_Dispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatched'
+type Fake_Dispatched_Call struct {
+	*mock.Call
+}
+
+// Dispatched is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - callback ...func([]interface{}) bool
+func (_e *Fake_Expecter) Dispatched(_a0 interface{}, callback ...interface{}) *Fake_Dispatched_Call {
+	return &Fake_Dispatched_Call{Call: _e.mock.On("Dispatched",
+		append([]interface{}{_a0}, callback...)...)}
+}
+
+func (_c *Fake_Dispatched_Call) Run(run func(_a0 interface{}, callback ...func([]interface{}) bool)) *Fake_Dispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func([]interface{}) bool, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(func([]interface{}) bool)
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Dispatched_Call) Return(_a0 [][]interface{}) *Fake_Dispatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Dispatched_Call) RunAndReturn(run func(interface{}, ...func([]interface{}) bool) [][]interface{}) *Fake_Dispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flush provides a mock function with given fields: _a0
+func (_m *Fake) Flush(_a0 string) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flush")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type Fake_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+//   - _a0 string
+func (_e *Fake_Expecter) Flush(_a0 interface{}) *Fake_Flush_Call {
+	return &Fake_Flush_Call{Call: _e.mock.On("Flush", _a0)}
+}
+
+func (_c *Fake_Flush_Call) Run(run func(_a0 string)) *Fake_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Fake_Flush_Call) Return(_a0 error) *Fake_Flush_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Flush_Call) RunAndReturn(run func(string) error) *Fake_Flush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Forget provides a mock function with given fields: _a0
+func (_m *Fake) Forget(_a0 string) {
+	_m.Called(_a0)
+}
+
+// Fake_Forget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forget'
+type Fake_Forget_Call struct {
+	*mock.Call
+}
+
+// Forget is a helper method to define mock.On call
+//   - _a0 string
+func (_e *Fake_Expecter) Forget(_a0 interface{}) *Fake_Forget_Call {
+	return &Fake_Forget_Call{Call: _e.mock.On("Forget", _a0)}
+}
+
+func (_c *Fake_Forget_Call) Run(run func(_a0 string)) *Fake_Forget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Fake_Forget_Call) Return() *Fake_Forget_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fake_Forget_Call) RunAndReturn(run func(string)) *Fake_Forget_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetEvents provides a mock function with no fields
+func (_m *Fake) GetEvents() map[event.Event][]event.Listener {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 map[event.Event][]event.Listener
+	if rf, ok := ret.Get(0).(func() map[event.Event][]event.Listener); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[event.Event][]event.Listener)
+		}
+	}
+
+	return r0
+}
+
+// Fake_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type Fake_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+func (_e *Fake_Expecter) GetEvents() *Fake_GetEvents_Call {
+	return &Fake_GetEvents_Call{Call: _e.mock.On("GetEvents")}
+}
+
+func (_c *Fake_GetEvents_Call) Run(run func()) *Fake_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_GetEvents_Call) Return(_a0 map[event.Event][]event.Listener) *Fake_GetEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_GetEvents_Call) RunAndReturn(run func() map[event.Event][]event.Listener) *Fake_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListeners provides a mock function with no fields
+func (_m *Fake) GetListeners() map[string][]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListeners")
+	}
+
+	var r0 map[string][]interface{}
+	if rf, ok := ret.Get(0).(func() map[string][]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]interface{})
+		}
+	}
+
+	return r0
+}
+
+// Fake_GetListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListeners'
+type Fake_GetListeners_Call struct {
+	*mock.Call
+}
+
+// GetListeners is a helper method to define mock.On call
+func (_e *Fake_Expecter) GetListeners() *Fake_GetListeners_Call {
+	return &Fake_GetListeners_Call{Call: _e.mock.On("GetListeners")}
+}
+
+func (_c *Fake_GetListeners_Call) Run(run func()) *Fake_GetListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_GetListeners_Call) Return(_a0 map[string][]interface{}) *Fake_GetListeners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_GetListeners_Call) RunAndReturn(run func() map[string][]interface{}) *Fake_GetListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscribers provides a mock function with no fields
+func (_m *Fake) GetSubscribers() []event.RegisteredSubscriber {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscribers")
+	}
+
+	var r0 []event.RegisteredSubscriber
+	if rf, ok := ret.Get(0).(func() []event.RegisteredSubscriber); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]event.RegisteredSubscriber)
+		}
+	}
+
+	return r0
+}
+
+// Fake_GetSubscribers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscribers'
+type Fake_GetSubscribers_Call struct {
+	*mock.Call
+}
+
+// GetSubscribers is a helper method to define mock.On call
+func (_e *Fake_Expecter) GetSubscribers() *Fake_GetSubscribers_Call {
+	return &Fake_GetSubscribers_Call{Call: _e.mock.On("GetSubscribers")}
+}
+
+func (_c *Fake_GetSubscribers_Call) Run(run func()) *Fake_GetSubscribers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Fake_GetSubscribers_Call) Return(_a0 []event.RegisteredSubscriber) *Fake_GetSubscribers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_GetSubscribers_Call) RunAndReturn(run func() []event.RegisteredSubscriber) *Fake_GetSubscribers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasDispatched provides a mock function with given fields: _a0
+func (_m *Fake) HasDispatched(_a0 interface{}) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasDispatched")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_HasDispatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasDispatched'
+type Fake_HasDispatched_Call struct {
+	*mock.Call
+}
+
+// HasDispatched is a helper method to define mock.On call
+//   - _a0 interface{}
+func (_e *Fake_Expecter) HasDispatched(_a0 interface{}) *Fake_HasDispatched_Call {
+	return &Fake_HasDispatched_Call{Call: _e.mock.On("HasDispatched", _a0)}
+}
+
+func (_c *Fake_HasDispatched_Call) Run(run func(_a0 interface{})) *Fake_HasDispatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Fake_HasDispatched_Call) Return(_a0 bool) *Fake_HasDispatched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_HasDispatched_Call) RunAndReturn(run func(interface{}) bool) *Fake_HasDispatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasListeners provides a mock function with given fields: _a0
+func (_m *Fake) HasListeners(_a0 string) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasListeners")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fake_HasListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasListeners'
+type Fake_HasListeners_Call struct {
+	*mock.Call
+}
+
+// HasListeners is a helper method to define mock.On call
+//   - _a0 string
+func (_e *Fake_Expecter) HasListeners(_a0 interface{}) *Fake_HasListeners_Call {
+	return &Fake_HasListeners_Call{Call: _e.mock.On("HasListeners", _a0)}
+}
+
+func (_c *Fake_HasListeners_Call) Run(run func(_a0 string)) *Fake_HasListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Fake_HasListeners_Call) Return(_a0 bool) *Fake_HasListeners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_HasListeners_Call) RunAndReturn(run func(string) bool) *Fake_HasListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Job provides a mock function with given fields: _a0, args
+func (_m *Fake) Job(_a0 event.Event, args []event.Arg) event.Task {
+	ret := _m.Called(_a0, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Job")
+	}
+
+	var r0 event.Task
+	if rf, ok := ret.Get(0).(func(event.Event, []event.Arg) event.Task); ok {
+		r0 = rf(_a0, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(event.Task)
+		}
+	}
+
+	return r0
+}
+
+// Fake_Job_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Job'
+type Fake_Job_Call struct {
+	*mock.Call
+}
+
+// Job is a helper method to define mock.On call
+//   - _a0 event.Event
+//   - args []event.Arg
+func (_e *Fake_Expecter) Job(_a0 interface{}, args interface{}) *Fake_Job_Call {
+	return &Fake_Job_Call{Call: _e.mock.On("Job", _a0, args)}
+}
+
+func (_c *Fake_Job_Call) Run(run func(_a0 event.Event, args []event.Arg)) *Fake_Job_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(event.Event), args[1].([]event.Arg))
+	})
+	return _c
+}
+
+func (_c *Fake_Job_Call) Return(_a0 event.Task) *Fake_Job_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Job_Call) RunAndReturn(run func(event.Event, []event.Arg) event.Task) *Fake_Job_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Listen provides a mock function with given fields: _a0, listeners
+func (_m *Fake) Listen(_a0 interface{}, listeners ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, listeners...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) error); ok {
+		r0 = rf(_a0, listeners...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_Listen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Listen'
+type Fake_Listen_Call struct {
+	*mock.Call
+}
+
+// Listen is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - listeners ...interface{}
+func (_e *Fake_Expecter) Listen(_a0 interface{}, listeners ...interface{}) *Fake_Listen_Call {
+	return &Fake_Listen_Call{Call: _e.mock.On("Listen",
+		append([]interface{}{_a0}, listeners...)...)}
+}
+
+func (_c *Fake_Listen_Call) Run(run func(_a0 interface{}, listeners ...interface{})) *Fake_Listen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Listen_Call) Return(_a0 error) *Fake_Listen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Listen_Call) RunAndReturn(run func(interface{}, ...interface{}) error) *Fake_Listen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Push provides a mock function with given fields: _a0, payload
+func (_m *Fake) Push(_a0 string, payload ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, payload...)
+	_m.Called(_ca...)
+}
+
+// Fake_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type Fake_Push_Call struct {
+	*mock.Call
+}
+
+// Push is a helper method to define mock.On call
+//   - _a0 string
+//   - payload ...interface{}
+func (_e *Fake_Expecter) Push(_a0 interface{}, payload ...interface{}) *Fake_Push_Call {
+	return &Fake_Push_Call{Call: _e.mock.On("Push",
+		append([]interface{}{_a0}, payload...)...)}
+}
+
+func (_c *Fake_Push_Call) Run(run func(_a0 string, payload ...interface{})) *Fake_Push_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Push_Call) Return() *Fake_Push_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fake_Push_Call) RunAndReturn(run func(string, ...interface{})) *Fake_Push_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: _a0
+func (_m *Fake) Register(_a0 map[event.Event][]event.Listener) {
+	_m.Called(_a0)
+}
+
+// Fake_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type Fake_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - _a0 map[event.Event][]event.Listener
+func (_e *Fake_Expecter) Register(_a0 interface{}) *Fake_Register_Call {
+	return &Fake_Register_Call{Call: _e.mock.On("Register", _a0)}
+}
+
+func (_c *Fake_Register_Call) Run(run func(_a0 map[event.Event][]event.Listener)) *Fake_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[event.Event][]event.Listener))
+	})
+	return _c
+}
+
+func (_c *Fake_Register_Call) Return() *Fake_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Fake_Register_Call) RunAndReturn(run func(map[event.Event][]event.Listener)) *Fake_Register_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: subscriber
+func (_m *Fake) Subscribe(subscriber event.Subscriber) error {
+	ret := _m.Called(subscriber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(event.Subscriber) error); ok {
+		r0 = rf(subscriber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Fake_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type Fake_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - subscriber event.Subscriber
+func (_e *Fake_Expecter) Subscribe(subscriber interface{}) *Fake_Subscribe_Call {
+	return &Fake_Subscribe_Call{Call: _e.mock.On("Subscribe", subscriber)}
+}
+
+func (_c *Fake_Subscribe_Call) Run(run func(subscriber event.Subscriber)) *Fake_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(event.Subscriber))
+	})
+	return _c
+}
+
+func (_c *Fake_Subscribe_Call) Return(_a0 error) *Fake_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Fake_Subscribe_Call) RunAndReturn(run func(event.Subscriber) error) *Fake_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Until provides a mock function with given fields: _a0, payload
+func (_m *Fake) Until(_a0 interface{}, payload ...interface{}) (interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, payload...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Until")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) (interface{}, error)); ok {
+		return rf(_a0, payload...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) interface{}); ok {
+		r0 = rf(_a0, payload...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, ...interface{}) error); ok {
+		r1 = rf(_a0, payload...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Fake_Until_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Until'
+type Fake_Until_Call struct {
+	*mock.Call
+}
+
+// Until is a helper method to define mock.On call
+//   - _a0 interface{}
+//   - payload ...interface{}
+func (_e *Fake_Expecter) Until(_a0 interface{}, payload ...interface{}) *Fake_Until_Call {
+	return &Fake_Until_Call{Call: _e.mock.On("Until",
+		append([]interface{}{_a0}, payload...)...)}
+}
+
+func (_c *Fake_Until_Call) Run(run func(_a0 interface{}, payload ...interface{})) *Fake_Until_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Fake_Until_Call) Return(_a0 interface{}, _a1 error) *Fake_Until_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Fake_Until_Call) RunAndReturn(run func(interface{}, ...interface{}) (interface{}, error)) *Fake_Until_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFake creates a new instance of Fake. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFake(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Fake {
+	mock := &Fake{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
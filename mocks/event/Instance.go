@@ -215,6 +215,100 @@ func (_c *Instance_GetEvents_Call) RunAndReturn(run func() map[event.Event][]eve
 	return _c
 }
 
+// GetListeners provides a mock function with no fields
+func (_m *Instance) GetListeners() map[string][]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListeners")
+	}
+
+	var r0 map[string][]interface{}
+	if rf, ok := ret.Get(0).(func() map[string][]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]interface{})
+		}
+	}
+
+	return r0
+}
+
+// Instance_GetListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListeners'
+type Instance_GetListeners_Call struct {
+	*mock.Call
+}
+
+// GetListeners is a helper method to define mock.On call
+func (_e *Instance_Expecter) GetListeners() *Instance_GetListeners_Call {
+	return &Instance_GetListeners_Call{Call: _e.mock.On("GetListeners")}
+}
+
+func (_c *Instance_GetListeners_Call) Run(run func()) *Instance_GetListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Instance_GetListeners_Call) Return(_a0 map[string][]interface{}) *Instance_GetListeners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Instance_GetListeners_Call) RunAndReturn(run func() map[string][]interface{}) *Instance_GetListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscribers provides a mock function with no fields
+func (_m *Instance) GetSubscribers() []event.RegisteredSubscriber {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscribers")
+	}
+
+	var r0 []event.RegisteredSubscriber
+	if rf, ok := ret.Get(0).(func() []event.RegisteredSubscriber); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]event.RegisteredSubscriber)
+		}
+	}
+
+	return r0
+}
+
+// Instance_GetSubscribers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscribers'
+type Instance_GetSubscribers_Call struct {
+	*mock.Call
+}
+
+// GetSubscribers is a helper method to define mock.On call
+func (_e *Instance_Expecter) GetSubscribers() *Instance_GetSubscribers_Call {
+	return &Instance_GetSubscribers_Call{Call: _e.mock.On("GetSubscribers")}
+}
+
+func (_c *Instance_GetSubscribers_Call) Run(run func()) *Instance_GetSubscribers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Instance_GetSubscribers_Call) Return(_a0 []event.RegisteredSubscriber) *Instance_GetSubscribers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Instance_GetSubscribers_Call) RunAndReturn(run func() []event.RegisteredSubscriber) *Instance_GetSubscribers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasListeners provides a mock function with given fields: _a0
 func (_m *Instance) HasListeners(_a0 string) bool {
 	ret := _m.Called(_a0)
@@ -44,6 +44,8 @@ import (
 
 	orm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
 
+	outbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+
 	pipeline "github.com/rusmanplatd/goravelframework/contracts/pipeline"
 
 	process "github.com/rusmanplatd/goravelframework/contracts/process"
@@ -1877,6 +1879,53 @@ func (_c *Application_MakeOrm_Call) RunAndReturn(run func() orm.Orm) *Applicatio
 	return _c
 }
 
+// MakeOutbox provides a mock function with no fields
+func (_m *Application) MakeOutbox() outbox.Outbox {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeOutbox")
+	}
+
+	var r0 outbox.Outbox
+	if rf, ok := ret.Get(0).(func() outbox.Outbox); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.Outbox)
+		}
+	}
+
+	return r0
+}
+
+// Application_MakeOutbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeOutbox'
+type Application_MakeOutbox_Call struct {
+	*mock.Call
+}
+
+// MakeOutbox is a helper method to define mock.On call
+func (_e *Application_Expecter) MakeOutbox() *Application_MakeOutbox_Call {
+	return &Application_MakeOutbox_Call{Call: _e.mock.On("MakeOutbox")}
+}
+
+func (_c *Application_MakeOutbox_Call) Run(run func()) *Application_MakeOutbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MakeOutbox_Call) Return(_a0 outbox.Outbox) *Application_MakeOutbox_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MakeOutbox_Call) RunAndReturn(run func() outbox.Outbox) *Application_MakeOutbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakePipeline provides a mock function with no fields
 func (_m *Application) MakePipeline() pipeline.Pipeline {
 	ret := _m.Called()
@@ -353,6 +353,54 @@ func (_c *ApplicationBuilder_WithGrpcServerInterceptors_Call) RunAndReturn(run f
 	return _c
 }
 
+// WithListeners provides a mock function with given fields: eventToListeners
+func (_m *ApplicationBuilder) WithListeners(eventToListeners map[interface{}][]interface{}) foundation.ApplicationBuilder {
+	ret := _m.Called(eventToListeners)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithListeners")
+	}
+
+	var r0 foundation.ApplicationBuilder
+	if rf, ok := ret.Get(0).(func(map[interface{}][]interface{}) foundation.ApplicationBuilder); ok {
+		r0 = rf(eventToListeners)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(foundation.ApplicationBuilder)
+		}
+	}
+
+	return r0
+}
+
+// ApplicationBuilder_WithListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithListeners'
+type ApplicationBuilder_WithListeners_Call struct {
+	*mock.Call
+}
+
+// WithListeners is a helper method to define mock.On call
+//   - eventToListeners map[interface{}][]interface{}
+func (_e *ApplicationBuilder_Expecter) WithListeners(eventToListeners interface{}) *ApplicationBuilder_WithListeners_Call {
+	return &ApplicationBuilder_WithListeners_Call{Call: _e.mock.On("WithListeners", eventToListeners)}
+}
+
+func (_c *ApplicationBuilder_WithListeners_Call) Run(run func(eventToListeners map[interface{}][]interface{})) *ApplicationBuilder_WithListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[interface{}][]interface{}))
+	})
+	return _c
+}
+
+func (_c *ApplicationBuilder_WithListeners_Call) Return(_a0 foundation.ApplicationBuilder) *ApplicationBuilder_WithListeners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApplicationBuilder_WithListeners_Call) RunAndReturn(run func(map[interface{}][]interface{}) foundation.ApplicationBuilder) *ApplicationBuilder_WithListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithMiddleware provides a mock function with given fields: fn
 func (_m *ApplicationBuilder) WithMiddleware(fn func(configuration.Middleware)) foundation.ApplicationBuilder {
 	ret := _m.Called(fn)
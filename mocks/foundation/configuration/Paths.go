@@ -68,6 +68,54 @@ func (_c *Paths_Bootstrap_Call) RunAndReturn(run func(string) configuration.Path
 	return _c
 }
 
+// Cast provides a mock function with given fields: path
+func (_m *Paths) Cast(path string) configuration.Paths {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cast")
+	}
+
+	var r0 configuration.Paths
+	if rf, ok := ret.Get(0).(func(string) configuration.Paths); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(configuration.Paths)
+		}
+	}
+
+	return r0
+}
+
+// Paths_Cast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cast'
+type Paths_Cast_Call struct {
+	*mock.Call
+}
+
+// Cast is a helper method to define mock.On call
+//   - path string
+func (_e *Paths_Expecter) Cast(path interface{}) *Paths_Cast_Call {
+	return &Paths_Cast_Call{Call: _e.mock.On("Cast", path)}
+}
+
+func (_c *Paths_Cast_Call) Run(run func(path string)) *Paths_Cast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Paths_Cast_Call) Return(_a0 configuration.Paths) *Paths_Cast_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Paths_Cast_Call) RunAndReturn(run func(string) configuration.Paths) *Paths_Cast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Command provides a mock function with given fields: path
 func (_m *Paths) Command(path string) configuration.Paths {
 	ret := _m.Called(path)
@@ -836,6 +884,54 @@ func (_c *Paths_Request_Call) RunAndReturn(run func(string) configuration.Paths)
 	return _c
 }
 
+// Resource provides a mock function with given fields: path
+func (_m *Paths) Resource(path string) configuration.Paths {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resource")
+	}
+
+	var r0 configuration.Paths
+	if rf, ok := ret.Get(0).(func(string) configuration.Paths); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(configuration.Paths)
+		}
+	}
+
+	return r0
+}
+
+// Paths_Resource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resource'
+type Paths_Resource_Call struct {
+	*mock.Call
+}
+
+// Resource is a helper method to define mock.On call
+//   - path string
+func (_e *Paths_Expecter) Resource(path interface{}) *Paths_Resource_Call {
+	return &Paths_Resource_Call{Call: _e.mock.On("Resource", path)}
+}
+
+func (_c *Paths_Resource_Call) Run(run func(path string)) *Paths_Resource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Paths_Resource_Call) Return(_a0 configuration.Paths) *Paths_Resource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Paths_Resource_Call) RunAndReturn(run func(string) configuration.Paths) *Paths_Resource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Rule provides a mock function with given fields: path
 func (_m *Paths) Rule(path string) configuration.Paths {
 	ret := _m.Called(path)
@@ -0,0 +1,228 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package outbox
+
+import (
+	orm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	outbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Outbox is an autogenerated mock type for the Outbox type
+type Outbox struct {
+	mock.Mock
+}
+
+type Outbox_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Outbox) EXPECT() *Outbox_Expecter {
+	return &Outbox_Expecter{mock: &_m.Mock}
+}
+
+// Register provides a mock function with given fields: events
+func (_m *Outbox) Register(events ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, events...)
+	_m.Called(_ca...)
+}
+
+// Outbox_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type Outbox_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - events ...interface{}
+func (_e *Outbox_Expecter) Register(events ...interface{}) *Outbox_Register_Call {
+	return &Outbox_Register_Call{Call: _e.mock.On("Register",
+		append([]interface{}{}, events...)...)}
+}
+
+func (_c *Outbox_Register_Call) Run(run func(events ...interface{})) *Outbox_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Outbox_Register_Call) Return() *Outbox_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Outbox_Register_Call) RunAndReturn(run func(...interface{})) *Outbox_Register_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Relay provides a mock function with no fields
+func (_m *Outbox) Relay() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relay")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Outbox_Relay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Relay'
+type Outbox_Relay_Call struct {
+	*mock.Call
+}
+
+// Relay is a helper method to define mock.On call
+func (_e *Outbox_Expecter) Relay() *Outbox_Relay_Call {
+	return &Outbox_Relay_Call{Call: _e.mock.On("Relay")}
+}
+
+func (_c *Outbox_Relay_Call) Run(run func()) *Outbox_Relay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Outbox_Relay_Call) Return(_a0 int, _a1 error) *Outbox_Relay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Outbox_Relay_Call) RunAndReturn(run func() (int, error)) *Outbox_Relay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Relayer provides a mock function with no fields
+func (_m *Outbox) Relayer() outbox.Relayer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relayer")
+	}
+
+	var r0 outbox.Relayer
+	if rf, ok := ret.Get(0).(func() outbox.Relayer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.Relayer)
+		}
+	}
+
+	return r0
+}
+
+// Outbox_Relayer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Relayer'
+type Outbox_Relayer_Call struct {
+	*mock.Call
+}
+
+// Relayer is a helper method to define mock.On call
+func (_e *Outbox_Expecter) Relayer() *Outbox_Relayer_Call {
+	return &Outbox_Relayer_Call{Call: _e.mock.On("Relayer")}
+}
+
+func (_c *Outbox_Relayer_Call) Run(run func()) *Outbox_Relayer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Outbox_Relayer_Call) Return(_a0 outbox.Relayer) *Outbox_Relayer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Outbox_Relayer_Call) RunAndReturn(run func() outbox.Relayer) *Outbox_Relayer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tx provides a mock function with given fields: tx
+func (_m *Outbox) Tx(tx orm.Query) outbox.Publisher {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tx")
+	}
+
+	var r0 outbox.Publisher
+	if rf, ok := ret.Get(0).(func(orm.Query) outbox.Publisher); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.Publisher)
+		}
+	}
+
+	return r0
+}
+
+// Outbox_Tx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tx'
+type Outbox_Tx_Call struct {
+	*mock.Call
+}
+
+// Tx is a helper method to define mock.On call
+//   - tx orm.Query
+func (_e *Outbox_Expecter) Tx(tx interface{}) *Outbox_Tx_Call {
+	return &Outbox_Tx_Call{Call: _e.mock.On("Tx", tx)}
+}
+
+func (_c *Outbox_Tx_Call) Run(run func(tx orm.Query)) *Outbox_Tx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(orm.Query))
+	})
+	return _c
+}
+
+func (_c *Outbox_Tx_Call) Return(_a0 outbox.Publisher) *Outbox_Tx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Outbox_Tx_Call) RunAndReturn(run func(orm.Query) outbox.Publisher) *Outbox_Tx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutbox creates a new instance of Outbox. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutbox(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Outbox {
+	mock := &Outbox{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
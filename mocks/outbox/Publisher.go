@@ -0,0 +1,204 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package outbox
+
+import (
+	outbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+	queue "github.com/rusmanplatd/goravelframework/contracts/queue"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Publisher is an autogenerated mock type for the Publisher type
+type Publisher struct {
+	mock.Mock
+}
+
+type Publisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Publisher) EXPECT() *Publisher_Expecter {
+	return &Publisher_Expecter{mock: &_m.Mock}
+}
+
+// Dispatch provides a mock function with given fields: event, payload
+func (_m *Publisher) Dispatch(event interface{}, payload ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, event)
+	_ca = append(_ca, payload...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) error); ok {
+		r0 = rf(event, payload...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Publisher_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type Publisher_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - event interface{}
+//   - payload ...interface{}
+func (_e *Publisher_Expecter) Dispatch(event interface{}, payload ...interface{}) *Publisher_Dispatch_Call {
+	return &Publisher_Dispatch_Call{Call: _e.mock.On("Dispatch",
+		append([]interface{}{event}, payload...)...)}
+}
+
+func (_c *Publisher_Dispatch_Call) Run(run func(event interface{}, payload ...interface{})) *Publisher_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Publisher_Dispatch_Call) Return(_a0 error) *Publisher_Dispatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Publisher_Dispatch_Call) RunAndReturn(run func(interface{}, ...interface{}) error) *Publisher_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Job provides a mock function with given fields: job, args
+func (_m *Publisher) Job(job queue.Job, args ...[]queue.Arg) outbox.PendingJob {
+	_va := make([]interface{}, len(args))
+	for _i := range args {
+		_va[_i] = args[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, job)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Job")
+	}
+
+	var r0 outbox.PendingJob
+	if rf, ok := ret.Get(0).(func(queue.Job, ...[]queue.Arg) outbox.PendingJob); ok {
+		r0 = rf(job, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.PendingJob)
+		}
+	}
+
+	return r0
+}
+
+// Publisher_Job_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Job'
+type Publisher_Job_Call struct {
+	*mock.Call
+}
+
+// Job is a helper method to define mock.On call
+//   - job queue.Job
+//   - args ...[]queue.Arg
+func (_e *Publisher_Expecter) Job(job interface{}, args ...interface{}) *Publisher_Job_Call {
+	return &Publisher_Job_Call{Call: _e.mock.On("Job",
+		append([]interface{}{job}, args...)...)}
+}
+
+func (_c *Publisher_Job_Call) Run(run func(job queue.Job, args ...[]queue.Arg)) *Publisher_Job_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([][]queue.Arg, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.([]queue.Arg)
+			}
+		}
+		run(args[0].(queue.Job), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Publisher_Job_Call) Return(_a0 outbox.PendingJob) *Publisher_Job_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Publisher_Job_Call) RunAndReturn(run func(queue.Job, ...[]queue.Arg) outbox.PendingJob) *Publisher_Job_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Key provides a mock function with given fields: key
+func (_m *Publisher) Key(key string) outbox.Publisher {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Key")
+	}
+
+	var r0 outbox.Publisher
+	if rf, ok := ret.Get(0).(func(string) outbox.Publisher); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.Publisher)
+		}
+	}
+
+	return r0
+}
+
+// Publisher_Key_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Key'
+type Publisher_Key_Call struct {
+	*mock.Call
+}
+
+// Key is a helper method to define mock.On call
+//   - key string
+func (_e *Publisher_Expecter) Key(key interface{}) *Publisher_Key_Call {
+	return &Publisher_Key_Call{Call: _e.mock.On("Key", key)}
+}
+
+func (_c *Publisher_Key_Call) Run(run func(key string)) *Publisher_Key_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Publisher_Key_Call) Return(_a0 outbox.Publisher) *Publisher_Key_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Publisher_Key_Call) RunAndReturn(run func(string) outbox.Publisher) *Publisher_Key_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPublisher creates a new instance of Publisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Publisher {
+	mock := &Publisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
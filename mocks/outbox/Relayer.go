@@ -0,0 +1,122 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package outbox
+
+import mock "github.com/stretchr/testify/mock"
+
+// Relayer is an autogenerated mock type for the Relayer type
+type Relayer struct {
+	mock.Mock
+}
+
+type Relayer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Relayer) EXPECT() *Relayer_Expecter {
+	return &Relayer_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function with no fields
+func (_m *Relayer) Run() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Relayer_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Relayer_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+func (_e *Relayer_Expecter) Run() *Relayer_Run_Call {
+	return &Relayer_Run_Call{Call: _e.mock.On("Run")}
+}
+
+func (_c *Relayer_Run_Call) Run(run func()) *Relayer_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Relayer_Run_Call) Return(_a0 error) *Relayer_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Relayer_Run_Call) RunAndReturn(run func() error) *Relayer_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Shutdown provides a mock function with no fields
+func (_m *Relayer) Shutdown() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Shutdown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Relayer_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type Relayer_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+func (_e *Relayer_Expecter) Shutdown() *Relayer_Shutdown_Call {
+	return &Relayer_Shutdown_Call{Call: _e.mock.On("Shutdown")}
+}
+
+func (_c *Relayer_Shutdown_Call) Run(run func()) *Relayer_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Relayer_Shutdown_Call) Return(_a0 error) *Relayer_Shutdown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Relayer_Shutdown_Call) RunAndReturn(run func() error) *Relayer_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRelayer creates a new instance of Relayer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRelayer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Relayer {
+	mock := &Relayer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
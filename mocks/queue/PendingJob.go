@@ -3,10 +3,12 @@
 package queue
 
 import (
-	time "time"
+	context "context"
 
 	queue "github.com/rusmanplatd/goravelframework/contracts/queue"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // PendingJob is an autogenerated mock type for the PendingJob type
@@ -256,6 +258,102 @@ func (_c *PendingJob_OnQueue_Call) RunAndReturn(run func(string) queue.PendingJo
 	return _c
 }
 
+// OnTenant provides a mock function with given fields: tenant
+func (_m *PendingJob) OnTenant(tenant string) queue.PendingJob {
+	ret := _m.Called(tenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnTenant")
+	}
+
+	var r0 queue.PendingJob
+	if rf, ok := ret.Get(0).(func(string) queue.PendingJob); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingJob)
+		}
+	}
+
+	return r0
+}
+
+// PendingJob_OnTenant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnTenant'
+type PendingJob_OnTenant_Call struct {
+	*mock.Call
+}
+
+// OnTenant is a helper method to define mock.On call
+//   - tenant string
+func (_e *PendingJob_Expecter) OnTenant(tenant interface{}) *PendingJob_OnTenant_Call {
+	return &PendingJob_OnTenant_Call{Call: _e.mock.On("OnTenant", tenant)}
+}
+
+func (_c *PendingJob_OnTenant_Call) Run(run func(tenant string)) *PendingJob_OnTenant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PendingJob_OnTenant_Call) Return(_a0 queue.PendingJob) *PendingJob_OnTenant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingJob_OnTenant_Call) RunAndReturn(run func(string) queue.PendingJob) *PendingJob_OnTenant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *PendingJob) WithContext(ctx context.Context) queue.PendingJob {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 queue.PendingJob
+	if rf, ok := ret.Get(0).(func(context.Context) queue.PendingJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingJob)
+		}
+	}
+
+	return r0
+}
+
+// PendingJob_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type PendingJob_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PendingJob_Expecter) WithContext(ctx interface{}) *PendingJob_WithContext_Call {
+	return &PendingJob_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *PendingJob_WithContext_Call) Run(run func(ctx context.Context)) *PendingJob_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PendingJob_WithContext_Call) Return(_a0 queue.PendingJob) *PendingJob_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PendingJob_WithContext_Call) RunAndReturn(run func(context.Context) queue.PendingJob) *PendingJob_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPendingJob creates a new instance of PendingJob. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPendingJob(t interface {
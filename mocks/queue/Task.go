@@ -3,6 +3,8 @@
 package queue
 
 import (
+	context "context"
+
 	queue "github.com/rusmanplatd/goravelframework/contracts/queue"
 	mock "github.com/stretchr/testify/mock"
 
@@ -256,6 +258,102 @@ func (_c *Task_OnQueue_Call) RunAndReturn(run func(string) queue.PendingJob) *Ta
 	return _c
 }
 
+// OnTenant provides a mock function with given fields: _a0
+func (_m *Task) OnTenant(_a0 string) queue.PendingJob {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnTenant")
+	}
+
+	var r0 queue.PendingJob
+	if rf, ok := ret.Get(0).(func(string) queue.PendingJob); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingJob)
+		}
+	}
+
+	return r0
+}
+
+// Task_OnTenant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnTenant'
+type Task_OnTenant_Call struct {
+	*mock.Call
+}
+
+// OnTenant is a helper method to define mock.On call
+//   - _a0 string
+func (_e *Task_Expecter) OnTenant(_a0 interface{}) *Task_OnTenant_Call {
+	return &Task_OnTenant_Call{Call: _e.mock.On("OnTenant", _a0)}
+}
+
+func (_c *Task_OnTenant_Call) Run(run func(_a0 string)) *Task_OnTenant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Task_OnTenant_Call) Return(_a0 queue.PendingJob) *Task_OnTenant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_OnTenant_Call) RunAndReturn(run func(string) queue.PendingJob) *Task_OnTenant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *Task) WithContext(ctx context.Context) queue.PendingJob {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 queue.PendingJob
+	if rf, ok := ret.Get(0).(func(context.Context) queue.PendingJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(queue.PendingJob)
+		}
+	}
+
+	return r0
+}
+
+// Task_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Task_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Task_Expecter) WithContext(ctx interface{}) *Task_WithContext_Call {
+	return &Task_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Task_WithContext_Call) Run(run func(ctx context.Context)) *Task_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Task_WithContext_Call) Return(_a0 queue.PendingJob) *Task_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_WithContext_Call) RunAndReturn(run func(context.Context) queue.PendingJob) *Task_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTask creates a new instance of Task. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTask(t interface {
@@ -1533,6 +1533,63 @@ func (_c *Event_GetSkipIfStillRunning_Call) RunAndReturn(run func() bool) *Event
 	return _c
 }
 
+// GetTenants provides a mock function with no fields
+func (_m *Event) GetTenants() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenants")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Event_GetTenants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTenants'
+type Event_GetTenants_Call struct {
+	*mock.Call
+}
+
+// GetTenants is a helper method to define mock.On call
+func (_e *Event_Expecter) GetTenants() *Event_GetTenants_Call {
+	return &Event_GetTenants_Call{Call: _e.mock.On("GetTenants")}
+}
+
+func (_c *Event_GetTenants_Call) Run(run func()) *Event_GetTenants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Event_GetTenants_Call) Return(_a0 []string, _a1 error) *Event_GetTenants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Event_GetTenants_Call) RunAndReturn(run func() ([]string, error)) *Event_GetTenants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Hourly provides a mock function with no fields
 func (_m *Event) Hourly() schedule.Event {
 	ret := _m.Called()
@@ -1673,6 +1730,51 @@ func (_c *Event_IsOnOneServer_Call) RunAndReturn(run func() bool) *Event_IsOnOne
 	return _c
 }
 
+// IsOnTenants provides a mock function with no fields
+func (_m *Event) IsOnTenants() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsOnTenants")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Event_IsOnTenants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsOnTenants'
+type Event_IsOnTenants_Call struct {
+	*mock.Call
+}
+
+// IsOnTenants is a helper method to define mock.On call
+func (_e *Event_Expecter) IsOnTenants() *Event_IsOnTenants_Call {
+	return &Event_IsOnTenants_Call{Call: _e.mock.On("IsOnTenants")}
+}
+
+func (_c *Event_IsOnTenants_Call) Run(run func()) *Event_IsOnTenants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Event_IsOnTenants_Call) Return(_a0 bool) *Event_IsOnTenants_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Event_IsOnTenants_Call) RunAndReturn(run func() bool) *Event_IsOnTenants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Mondays provides a mock function with no fields
 func (_m *Event) Mondays() schedule.Event {
 	ret := _m.Called()
@@ -1862,6 +1964,67 @@ func (_c *Event_OnOneServer_Call) RunAndReturn(run func() schedule.Event) *Event
 	return _c
 }
 
+// OnTenants provides a mock function with given fields: tenants
+func (_m *Event) OnTenants(tenants ...string) schedule.Event {
+	_va := make([]interface{}, len(tenants))
+	for _i := range tenants {
+		_va[_i] = tenants[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnTenants")
+	}
+
+	var r0 schedule.Event
+	if rf, ok := ret.Get(0).(func(...string) schedule.Event); ok {
+		r0 = rf(tenants...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(schedule.Event)
+		}
+	}
+
+	return r0
+}
+
+// Event_OnTenants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnTenants'
+type Event_OnTenants_Call struct {
+	*mock.Call
+}
+
+// OnTenants is a helper method to define mock.On call
+//   - tenants ...string
+func (_e *Event_Expecter) OnTenants(tenants ...interface{}) *Event_OnTenants_Call {
+	return &Event_OnTenants_Call{Call: _e.mock.On("OnTenants",
+		append([]interface{}{}, tenants...)...)}
+}
+
+func (_c *Event_OnTenants_Call) Run(run func(tenants ...string)) *Event_OnTenants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Event_OnTenants_Call) Return(_a0 schedule.Event) *Event_OnTenants_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Event_OnTenants_Call) RunAndReturn(run func(...string) schedule.Event) *Event_OnTenants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Quarterly provides a mock function with no fields
 func (_m *Event) Quarterly() schedule.Event {
 	ret := _m.Called()
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package tenancy
+
+import (
+	http "github.com/rusmanplatd/goravelframework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Resolver is an autogenerated mock type for the Resolver type
+type Resolver struct {
+	mock.Mock
+}
+
+type Resolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Resolver) EXPECT() *Resolver_Expecter {
+	return &Resolver_Expecter{mock: &_m.Mock}
+}
+
+// Resolve provides a mock function with given fields: ctx
+func (_m *Resolver) Resolve(ctx http.Context) string {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(http.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Resolver_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type Resolver_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx http.Context
+func (_e *Resolver_Expecter) Resolve(ctx interface{}) *Resolver_Resolve_Call {
+	return &Resolver_Resolve_Call{Call: _e.mock.On("Resolve", ctx)}
+}
+
+func (_c *Resolver_Resolve_Call) Run(run func(ctx http.Context)) *Resolver_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Context))
+	})
+	return _c
+}
+
+func (_c *Resolver_Resolve_Call) Return(_a0 string) *Resolver_Resolve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Resolver_Resolve_Call) RunAndReturn(run func(http.Context) string) *Resolver_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResolver creates a new instance of Resolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Resolver {
+	mock := &Resolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
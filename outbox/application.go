@@ -0,0 +1,269 @@
+package outbox
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	contractsconfig "github.com/rusmanplatd/goravelframework/contracts/config"
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	contractsevent "github.com/rusmanplatd/goravelframework/contracts/event"
+	contractsfoundation "github.com/rusmanplatd/goravelframework/contracts/foundation"
+	contractslog "github.com/rusmanplatd/goravelframework/contracts/log"
+	contractsoutbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+	contractsqueue "github.com/rusmanplatd/goravelframework/contracts/queue"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/queue/utils"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+var _ contractsoutbox.Outbox = &Application{}
+
+type Application struct {
+	config contractsconfig.Config
+	event  contractsevent.Instance
+	json   contractsfoundation.Json
+	log    contractslog.Log
+	orm    contractsorm.Orm
+	queue  contractsqueue.Queue
+
+	table  string
+	batch  int
+	tries  int
+	lease  int
+	events map[string]reflect.Type
+	mu     sync.RWMutex
+}
+
+func NewApplication(config contractsconfig.Config, event contractsevent.Instance, json contractsfoundation.Json, log contractslog.Log, orm contractsorm.Orm, queue contractsqueue.Queue) *Application {
+	if connection := config.GetString("outbox.connection"); connection != "" {
+		orm = orm.Connection(connection)
+	}
+
+	return &Application{
+		config: config,
+		event:  event,
+		json:   json,
+		log:    log,
+		orm:    orm,
+		queue:  queue,
+
+		table:  config.GetString("outbox.table", "outbox"),
+		batch:  config.GetInt("outbox.relay.batch", 100),
+		tries:  config.GetInt("outbox.relay.tries", 3),
+		lease:  config.GetInt("outbox.relay.lease", 60),
+		events: make(map[string]reflect.Type),
+	}
+}
+
+func (r *Application) Tx(tx contractsorm.Query) contractsoutbox.Publisher {
+	return NewPublisher(r, tx)
+}
+
+func (r *Application) Register(events ...any) {
+	for _, event := range events {
+		r.register(event)
+	}
+}
+
+// Relay relays the pending messages in the id order. The messages are reserved in a short transaction and
+// dispatched after it's committed, so several relayers can run at the same time without holding the row locks
+// during the dispatch, a reservation expires after the lease in case the relayer stops. A failed message is retried
+// by the next relay until it runs out of tries, the later messages with the same aggregate key are held back while
+// an earlier one is pending, reserved or dead to keep their order, so a dead message blocks its aggregate key
+// until it's removed or its attempts are reset.
+func (r *Application) Relay() (int, error) {
+	messages, err := r.reserve()
+	if err != nil {
+		return 0, errors.OutboxRelayFailed.Args(err).SetModule(errors.ModuleOutbox)
+	}
+
+	var relayed int
+	blockedKeys := make(map[string]bool)
+	for _, message := range messages {
+		if message.AggregateKey != "" && blockedKeys[message.AggregateKey] {
+			// Release the message, so it's relayed after the earlier one by the next relay.
+			if _, err := r.orm.Query().Table(r.table).Where("id", message.ID).Update("reserved_at", nil); err != nil {
+				return relayed, errors.OutboxRelayFailed.Args(err).SetModule(errors.ModuleOutbox)
+			}
+
+			continue
+		}
+
+		if err := r.relay(message); err != nil {
+			if message.AggregateKey != "" {
+				blockedKeys[message.AggregateKey] = true
+			}
+
+			r.log.Error(errors.OutboxRelayFailed.Args(err).SetModule(errors.ModuleOutbox))
+
+			if _, err := r.orm.Query().Table(r.table).Where("id", message.ID).Update(map[string]any{
+				"attempts":    message.Attempts + 1,
+				"last_error":  err.Error(),
+				"reserved_at": nil,
+			}); err != nil {
+				return relayed, errors.OutboxRelayFailed.Args(err).SetModule(errors.ModuleOutbox)
+			}
+
+			continue
+		}
+
+		if _, err := r.orm.Query().Table(r.table).Where("id", message.ID).Update(map[string]any{
+			"attempts":     message.Attempts + 1,
+			"processed_at": carbon.NewDateTime(carbon.Now()),
+			"reserved_at":  nil,
+		}); err != nil {
+			return relayed, errors.OutboxRelayFailed.Args(err).SetModule(errors.ModuleOutbox)
+		}
+
+		relayed++
+	}
+
+	return relayed, nil
+}
+
+func (r *Application) Relayer() contractsoutbox.Relayer {
+	return NewRelayer(r, r.log, r.config.GetInt("outbox.relay.interval", 1))
+}
+
+func (r *Application) relay(message Message) error {
+	switch message.Type {
+	case MessageTypeEvent:
+		return r.relayEvent(message)
+	case MessageTypeJob:
+		return r.relayJob(message)
+	default:
+		return errors.OutboxInvalidMessageType.Args(message.Type)
+	}
+}
+
+func (r *Application) relayEvent(message Message) error {
+	r.mu.RLock()
+	eventType, ok := r.events[message.Name]
+	r.mu.RUnlock()
+
+	if !ok {
+		// The payload of a string event is a list of args, otherwise it's an event whose type isn't registered.
+		var args []contractsqueue.Arg
+		if err := r.json.UnmarshalString(message.Payload, &args); err != nil {
+			return errors.OutboxEventNotRegistered.Args(message.Name)
+		}
+
+		_, err := r.event.Dispatch(message.Name, utils.ConvertArgs(args)...)
+
+		return err
+	}
+
+	isPointer := eventType.Kind() == reflect.Pointer
+	if isPointer {
+		eventType = eventType.Elem()
+	}
+
+	event := reflect.New(eventType)
+	if err := r.json.UnmarshalString(message.Payload, event.Interface()); err != nil {
+		return err
+	}
+
+	if isPointer {
+		_, err := r.event.Dispatch(event.Interface())
+		return err
+	}
+
+	_, err := r.event.Dispatch(event.Elem().Interface())
+
+	return err
+}
+
+func (r *Application) relayJob(message Message) error {
+	job, err := r.queue.GetJob(message.Name)
+	if err != nil {
+		return err
+	}
+
+	var args []contractsqueue.Arg
+	if err := r.json.UnmarshalString(message.Payload, &args); err != nil {
+		return err
+	}
+
+	values := utils.ConvertArgs(args)
+	for i := range args {
+		args[i].Value = values[i]
+	}
+
+	pendingJob := r.queue.Job(job, args)
+	if message.Connection != "" {
+		pendingJob = pendingJob.OnConnection(message.Connection)
+	}
+	if message.Queue != "" {
+		pendingJob = pendingJob.OnQueue(message.Queue)
+	}
+	if message.AvailableAt != nil && message.AvailableAt.IsFuture() {
+		pendingJob = pendingJob.Delay(message.AvailableAt.StdTime())
+	}
+
+	return pendingJob.Dispatch()
+}
+
+// reserve selects the pending messages that aren't reserved by another relayer and reserves them. A message is
+// skipped if an earlier message with the same aggregate key is reserved or has run out of tries, the earlier
+// messages that can be relayed are selected before it because of the id order.
+func (r *Application) reserve() ([]Message, error) {
+	var messages []Message
+
+	err := r.orm.Transaction(func(tx contractsorm.Query) error {
+		now := carbon.Now()
+		expiredAt := carbon.NewDateTime(now.SubSeconds(r.lease))
+
+		if err := tx.Table(r.table).
+			WhereNull("processed_at").
+			Where("attempts < ?", r.tries).
+			Where("(reserved_at IS NULL OR reserved_at <= ?)", expiredAt).
+			Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %[1]s AS earlier WHERE earlier.aggregate_key = %[1]s.aggregate_key "+
+				"AND earlier.aggregate_key <> '' AND earlier.id < %[1]s.id AND earlier.processed_at IS NULL "+
+				"AND (earlier.attempts >= ? OR earlier.reserved_at > ?))", r.table), r.tries, expiredAt).
+			OrderBy("id").
+			Limit(r.batch).
+			LockForUpdate().
+			Get(&messages); err != nil {
+			return err
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		ids := make([]any, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		_, err := tx.Table(r.table).WhereIn("id", ids).Update("reserved_at", carbon.NewDateTime(now))
+
+		return err
+	})
+
+	return messages, err
+}
+
+// register registers the type of the event and returns the name that is stored in the outbox table.
+func (r *Application) register(event any) string {
+	eventType := reflect.TypeOf(event)
+	name := typeName(eventType)
+
+	r.mu.Lock()
+	r.events[name] = eventType
+	r.mu.Unlock()
+
+	return name
+}
+
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		return "*" + typeName(t.Elem())
+	}
+
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
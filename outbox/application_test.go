@@ -0,0 +1,221 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	contractsqueue "github.com/rusmanplatd/goravelframework/contracts/queue"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/foundation/json"
+	mocksconfig "github.com/rusmanplatd/goravelframework/mocks/config"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+	mocksevent "github.com/rusmanplatd/goravelframework/mocks/event"
+	mockslog "github.com/rusmanplatd/goravelframework/mocks/log"
+	mocksqueue "github.com/rusmanplatd/goravelframework/mocks/queue"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+type OrderShipped struct {
+	ID     uint   `json:"id"`
+	Status string `json:"status"`
+}
+
+type SendInvoice struct {
+}
+
+func (r *SendInvoice) Signature() string {
+	return "send_invoice"
+}
+
+func (r *SendInvoice) Handle(args ...any) error {
+	return nil
+}
+
+type ApplicationTestSuite struct {
+	suite.Suite
+	mockConfig *mocksconfig.Config
+	mockEvent  *mocksevent.Instance
+	mockLog    *mockslog.Log
+	mockOrm    *mocksorm.Orm
+	mockQuery  *mocksorm.Query
+	mockQueue  *mocksqueue.Queue
+	outbox     *Application
+}
+
+func TestApplicationTestSuite(t *testing.T) {
+	suite.Run(t, new(ApplicationTestSuite))
+}
+
+func (s *ApplicationTestSuite) SetupTest() {
+	s.mockConfig = mocksconfig.NewConfig(s.T())
+	s.mockEvent = mocksevent.NewInstance(s.T())
+	s.mockLog = mockslog.NewLog(s.T())
+	s.mockOrm = mocksorm.NewOrm(s.T())
+	s.mockQuery = mocksorm.NewQuery(s.T())
+	s.mockQueue = mocksqueue.NewQueue(s.T())
+
+	s.mockConfig.EXPECT().GetString("outbox.connection").Return("").Once()
+	s.mockConfig.EXPECT().GetString("outbox.table", "outbox").Return("outbox").Once()
+	s.mockConfig.EXPECT().GetInt("outbox.relay.batch", 100).Return(100).Once()
+	s.mockConfig.EXPECT().GetInt("outbox.relay.tries", 3).Return(3).Once()
+	s.mockConfig.EXPECT().GetInt("outbox.relay.lease", 60).Return(60).Once()
+
+	s.outbox = NewApplication(s.mockConfig, s.mockEvent, json.New(), s.mockLog, s.mockOrm, s.mockQueue)
+}
+
+func (s *ApplicationTestSuite) TestTxDispatch() {
+	s.Run("struct event", func() {
+		s.mockQuery.EXPECT().Table("outbox").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Create(mock.MatchedBy(func(message *Message) bool {
+			return message.AggregateKey == "order-1" &&
+				message.Type == MessageTypeEvent &&
+				message.Name == "*github.com/rusmanplatd/goravelframework/outbox.OrderShipped" &&
+				message.Payload == `{"id":1,"status":"shipped"}` &&
+				message.CreatedAt != nil
+		})).Return(nil).Once()
+
+		s.NoError(s.outbox.Tx(s.mockQuery).Key("order-1").Dispatch(&OrderShipped{ID: 1, Status: "shipped"}))
+		s.Contains(s.outbox.events, "*github.com/rusmanplatd/goravelframework/outbox.OrderShipped")
+	})
+
+	s.Run("string event", func() {
+		s.mockQuery.EXPECT().Table("outbox").Return(s.mockQuery).Once()
+		s.mockQuery.EXPECT().Create(mock.MatchedBy(func(message *Message) bool {
+			return message.AggregateKey == "" &&
+				message.Name == "order.shipped" &&
+				message.Payload == `[{"value":1,"type":"int"},{"value":"shipped","type":"string"}]`
+		})).Return(nil).Once()
+
+		s.NoError(s.outbox.Tx(s.mockQuery).Dispatch("order.shipped", 1, "shipped"))
+	})
+}
+
+func (s *ApplicationTestSuite) TestTxJob() {
+	delay := time.Now().Add(time.Hour)
+
+	s.mockQuery.EXPECT().Table("outbox").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Create(mock.MatchedBy(func(message *Message) bool {
+		return message.AggregateKey == "order-1" &&
+			message.Type == MessageTypeJob &&
+			message.Name == "send_invoice" &&
+			message.Payload == `[{"value":1,"type":"uint"}]` &&
+			message.Connection == "redis" &&
+			message.Queue == "invoices" &&
+			message.AvailableAt.StdTime().Unix() == delay.Unix()
+	})).Return(nil).Once()
+
+	s.NoError(s.outbox.Tx(s.mockQuery).Key("order-1").
+		Job(&SendInvoice{}, []contractsqueue.Arg{{Value: uint(1), Type: "uint"}}).
+		OnConnection("redis").
+		OnQueue("invoices").
+		Delay(delay).
+		Dispatch())
+}
+
+func (s *ApplicationTestSuite) TestRelay() {
+	s.outbox.Register(&OrderShipped{})
+
+	messages := []Message{
+		{ID: 1, AggregateKey: "order-1", Type: MessageTypeEvent, Name: "*github.com/rusmanplatd/goravelframework/outbox.OrderShipped", Payload: `{"id":1,"status":"shipped"}`},
+		{ID: 2, AggregateKey: "order-2", Type: MessageTypeJob, Name: "send_invoice", Payload: `[{"value":2,"type":"uint"}]`, Queue: "invoices"},
+		{ID: 3, AggregateKey: "order-2", Type: MessageTypeEvent, Name: "order.paid", Payload: `[]`},
+		{ID: 4, Type: MessageTypeEvent, Name: "order.created", Payload: `[{"value":3,"type":"int"}]`},
+	}
+
+	// The messages are reserved in a transaction.
+	mockTx := mocksorm.NewQuery(s.T())
+	s.mockOrm.EXPECT().Transaction(mock.Anything).RunAndReturn(func(txFunc func(contractsorm.Query) error) error {
+		return txFunc(mockTx)
+	}).Once()
+	mockTx.EXPECT().Table("outbox").Return(mockTx).Twice()
+	mockTx.EXPECT().WhereNull("processed_at").Return(mockTx).Once()
+	mockTx.EXPECT().Where("attempts < ?", 3).Return(mockTx).Once()
+	mockTx.EXPECT().Where("(reserved_at IS NULL OR reserved_at <= ?)", mock.Anything).Return(mockTx).Once()
+	mockTx.EXPECT().Where("NOT EXISTS (SELECT 1 FROM outbox AS earlier WHERE earlier.aggregate_key = outbox.aggregate_key "+
+		"AND earlier.aggregate_key <> '' AND earlier.id < outbox.id AND earlier.processed_at IS NULL "+
+		"AND (earlier.attempts >= ? OR earlier.reserved_at > ?))", 3, mock.Anything).Return(mockTx).Once()
+	mockTx.EXPECT().OrderBy("id").Return(mockTx).Once()
+	mockTx.EXPECT().Limit(100).Return(mockTx).Once()
+	mockTx.EXPECT().LockForUpdate().Return(mockTx).Once()
+	mockTx.EXPECT().Get(mock.Anything).Run(func(dest any) {
+		*dest.(*[]Message) = messages
+	}).Return(nil).Once()
+	mockTx.EXPECT().WhereIn("id", []any{uint(1), uint(2), uint(3), uint(4)}).Return(mockTx).Once()
+	mockTx.EXPECT().Update("reserved_at", mock.Anything).Return(nil, nil).Once()
+
+	// The messages are dispatched after the transaction is committed.
+	s.mockOrm.EXPECT().Query().Return(s.mockQuery)
+	s.mockQuery.EXPECT().Table("outbox").Return(s.mockQuery)
+
+	s.mockEvent.EXPECT().Dispatch(&OrderShipped{ID: 1, Status: "shipped"}).Return(nil, nil).Once()
+	s.mockQuery.EXPECT().Where("id", uint(1)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Update(mock.MatchedBy(func(values map[string]any) bool {
+		return values["attempts"] == 1 && values["processed_at"] != nil && values["reserved_at"] == nil
+	})).Return(nil, nil).Once()
+
+	// The job can't be found, so the next message with the same key is held back.
+	s.mockQueue.EXPECT().GetJob("send_invoice").Return(nil, assert.AnError).Once()
+	s.mockLog.EXPECT().Error(errors.OutboxRelayFailed.Args(assert.AnError).SetModule(errors.ModuleOutbox)).Once()
+	s.mockQuery.EXPECT().Where("id", uint(2)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Update(map[string]any{
+		"attempts":    1,
+		"last_error":  assert.AnError.Error(),
+		"reserved_at": nil,
+	}).Return(nil, nil).Once()
+
+	// The held back message is released.
+	s.mockQuery.EXPECT().Where("id", uint(3)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Update("reserved_at", nil).Return(nil, nil).Once()
+
+	s.mockEvent.EXPECT().Dispatch("order.created", 3).Return(nil, nil).Once()
+	s.mockQuery.EXPECT().Where("id", uint(4)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Update(mock.MatchedBy(func(values map[string]any) bool {
+		return values["attempts"] == 1 && values["processed_at"] != nil && values["reserved_at"] == nil
+	})).Return(nil, nil).Once()
+
+	relayed, err := s.outbox.Relay()
+	s.NoError(err)
+	s.Equal(2, relayed)
+}
+
+func (s *ApplicationTestSuite) TestRelayJob() {
+	delay := time.Now().Add(time.Hour)
+	mockPendingJob := mocksqueue.NewPendingJob(s.T())
+
+	s.mockQueue.EXPECT().GetJob("send_invoice").Return(&SendInvoice{}, nil).Once()
+	s.mockQueue.EXPECT().Job(&SendInvoice{}, []contractsqueue.Arg{{Value: uint(2), Type: "uint"}}).Return(mockPendingJob).Once()
+	mockPendingJob.EXPECT().OnConnection("redis").Return(mockPendingJob).Once()
+	mockPendingJob.EXPECT().OnQueue("invoices").Return(mockPendingJob).Once()
+	mockPendingJob.EXPECT().Delay(mock.MatchedBy(func(t time.Time) bool {
+		return t.Unix() == delay.Unix()
+	})).Return(mockPendingJob).Once()
+	mockPendingJob.EXPECT().Dispatch().Return(nil).Once()
+
+	s.NoError(s.outbox.relay(Message{
+		Type:        MessageTypeJob,
+		Name:        "send_invoice",
+		Payload:     `[{"value":2,"type":"uint"}]`,
+		Connection:  "redis",
+		Queue:       "invoices",
+		AvailableAt: carbon.NewDateTime(carbon.FromStdTime(delay)),
+	}))
+}
+
+func (s *ApplicationTestSuite) TestRelayUnregisteredEvent() {
+	err := s.outbox.relay(Message{
+		Type:    MessageTypeEvent,
+		Name:    "*events.OrderCancelled",
+		Payload: `{"id":1}`,
+	})
+
+	s.Equal(errors.OutboxEventNotRegistered.Args("*events.OrderCancelled"), err)
+}
+
+func (s *ApplicationTestSuite) TestRelayInvalidMessageType() {
+	s.Equal(errors.OutboxInvalidMessageType.Args("mail"), s.outbox.relay(Message{Type: "mail"}))
+}
@@ -0,0 +1,77 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/contracts/outbox"
+)
+
+type OutboxRelayCommand struct {
+	outbox outbox.Outbox
+}
+
+func NewOutboxRelayCommand(outbox outbox.Outbox) *OutboxRelayCommand {
+	return &OutboxRelayCommand{
+		outbox: outbox,
+	}
+}
+
+// Signature The name and signature of the console command.
+func (r *OutboxRelayCommand) Signature() string {
+	return "outbox:relay"
+}
+
+// Description The console command description.
+func (r *OutboxRelayCommand) Description() string {
+	return "Relay the pending outbox messages to the event dispatcher and the queue"
+}
+
+// Extend The console command extend.
+func (r *OutboxRelayCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "outbox",
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:  "once",
+				Usage: "Relay a single batch of the pending messages and exit",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *OutboxRelayCommand) Handle(ctx console.Context) error {
+	if ctx.OptionBool("once") {
+		relayed, err := r.outbox.Relay()
+		if err != nil {
+			ctx.Error(err.Error())
+			return nil
+		}
+
+		ctx.Success(fmt.Sprintf("%d outbox messages relayed", relayed))
+
+		return nil
+	}
+
+	relayer := r.outbox.Relayer()
+	signalCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-signalCtx.Done()
+		_ = relayer.Shutdown()
+	}()
+
+	ctx.Info("Relaying the outbox messages, press Ctrl+C to stop")
+
+	if err := relayer.Run(); err != nil {
+		ctx.Error(err.Error())
+	}
+
+	return nil
+}
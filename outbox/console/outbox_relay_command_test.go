@@ -0,0 +1,65 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksoutbox "github.com/rusmanplatd/goravelframework/mocks/outbox"
+)
+
+func TestOutboxRelayCommand(t *testing.T) {
+	var (
+		mockCtx     *mocksconsole.Context
+		mockOutbox  *mocksoutbox.Outbox
+		mockRelayer *mocksoutbox.Relayer
+	)
+
+	beforeEach := func() {
+		mockCtx = mocksconsole.NewContext(t)
+		mockOutbox = mocksoutbox.NewOutbox(t)
+		mockRelayer = mocksoutbox.NewRelayer(t)
+	}
+
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "relay once",
+			setup: func() {
+				mockCtx.EXPECT().OptionBool("once").Return(true).Once()
+				mockOutbox.EXPECT().Relay().Return(2, nil).Once()
+				mockCtx.EXPECT().Success("2 outbox messages relayed").Once()
+			},
+		},
+		{
+			name: "relay once failed",
+			setup: func() {
+				mockCtx.EXPECT().OptionBool("once").Return(true).Once()
+				mockOutbox.EXPECT().Relay().Return(0, assert.AnError).Once()
+				mockCtx.EXPECT().Error(assert.AnError.Error()).Once()
+			},
+		},
+		{
+			name: "relay until shutdown",
+			setup: func() {
+				mockCtx.EXPECT().OptionBool("once").Return(false).Once()
+				mockOutbox.EXPECT().Relayer().Return(mockRelayer).Once()
+				mockCtx.EXPECT().Info("Relaying the outbox messages, press Ctrl+C to stop").Once()
+				mockRelayer.EXPECT().Run().Return(nil).Once()
+				mockRelayer.EXPECT().Shutdown().Return(nil).Maybe()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beforeEach()
+			test.setup()
+
+			assert.NoError(t, NewOutboxRelayCommand(mockOutbox).Handle(mockCtx))
+		})
+	}
+}
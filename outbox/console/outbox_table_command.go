@@ -0,0 +1,86 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/contracts/foundation"
+	"github.com/rusmanplatd/goravelframework/database/migration"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/packages/match"
+	"github.com/rusmanplatd/goravelframework/packages/modify"
+	"github.com/rusmanplatd/goravelframework/support"
+	supportconsole "github.com/rusmanplatd/goravelframework/support/console"
+	"github.com/rusmanplatd/goravelframework/support/env"
+	"github.com/rusmanplatd/goravelframework/support/file"
+	"github.com/rusmanplatd/goravelframework/support/str"
+)
+
+type OutboxTableCommand struct {
+	app foundation.Application
+}
+
+func NewOutboxTableCommand(app foundation.Application) *OutboxTableCommand {
+	return &OutboxTableCommand{app: app}
+}
+
+// Signature The name and signature of the console command.
+func (r *OutboxTableCommand) Signature() string {
+	return "make:outbox-table"
+}
+
+// Description The console command description.
+func (r *OutboxTableCommand) Description() string {
+	return "Create a migration for the outbox database table"
+}
+
+// Extend The console command extend.
+func (r *OutboxTableCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "make",
+	}
+}
+
+// Handle Execute the console command.
+func (r *OutboxTableCommand) Handle(ctx console.Context) error {
+	table := "outbox"
+	if config := r.app.MakeConfig(); config != nil {
+		table = config.GetString("outbox.table", "outbox")
+	}
+
+	name := fmt.Sprintf("create_%s_table", table)
+	make, err := supportconsole.NewMake(ctx, "migration", name, support.Config.Paths.Migration)
+	if err != nil {
+		ctx.Error(err.Error())
+		return nil
+	}
+
+	creator := migration.NewCreator()
+	fileName := creator.GetFileName(make.GetName())
+	if err := file.PutContent(creator.GetPath(fileName), creator.PopulateStub(Stubs{}.Table(), fileName, table)); err != nil {
+		ctx.Error(errors.MigrationCreateFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success(fmt.Sprintf("Created Migration: %s", make.GetName()))
+
+	structName := str.Of(fileName).Prepend("m_").Studly().String()
+	if env.IsBootstrapSetup() {
+		err = modify.AddMigration(make.GetPackageImportPath(), fmt.Sprintf("&%s.%s{}", make.GetPackageName(), structName))
+	} else {
+		err = modify.GoFile(r.app.DatabasePath("kernel.go")).
+			Find(match.Imports()).Modify(modify.AddImport(make.GetPackageImportPath())).
+			Find(match.Migrations()).Modify(modify.Register(fmt.Sprintf("&migrations.%s{}", structName))).
+			Apply()
+	}
+
+	if err != nil {
+		ctx.Error(errors.MigrationRegisterFailed.Args(err).Error())
+		return nil
+	}
+
+	ctx.Success("Migration registered successfully")
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package console
+
+type Stubs struct {
+}
+
+func (r Stubs) Table() string {
+	return `package migrations
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/facades"
+)
+
+type DummyMigration struct{}
+
+// Signature The unique signature for the migration.
+func (r *DummyMigration) Signature() string {
+	return "DummySignature"
+}
+
+// Up Run the migrations.
+func (r *DummyMigration) Up() error {
+	if !facades.Schema().HasTable("DummyTable") {
+		return facades.Schema().Create("DummyTable", func(table schema.Blueprint) {
+			table.BigIncrements("id")
+			table.String("aggregate_key").Default("")
+			table.String("type")
+			table.String("name")
+			table.LongText("payload")
+			table.String("connection").Default("")
+			table.String("queue").Default("")
+			table.UnsignedInteger("attempts").Default(0)
+			table.Text("last_error").Nullable()
+			table.Timestamp("available_at").Nullable()
+			table.Timestamp("reserved_at").Nullable()
+			table.Timestamp("processed_at").Nullable()
+			table.Timestamp("created_at").Nullable()
+			table.Index("processed_at", "id")
+			table.Index("aggregate_key", "id")
+		})
+	}
+
+	return nil
+}
+
+// Down Reverse the migrations.
+func (r *DummyMigration) Down() error {
+	return facades.Schema().DropIfExists("DummyTable")
+}
+`
+}
@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+const (
+	MessageTypeEvent = "event"
+	MessageTypeJob   = "job"
+)
+
+// Message is a row of the outbox table.
+type Message struct {
+	ID           uint             `gorm:"primaryKey"`
+	AggregateKey string           `gorm:"column:aggregate_key"`
+	Type         string           `gorm:"column:type"`
+	Name         string           `gorm:"column:name"`
+	Payload      string           `gorm:"column:payload"`
+	Connection   string           `gorm:"column:connection"`
+	Queue        string           `gorm:"column:queue"`
+	Attempts     int              `gorm:"column:attempts"`
+	LastError    string           `gorm:"column:last_error"`
+	AvailableAt  *carbon.DateTime `gorm:"column:available_at"`
+	ReservedAt   *carbon.DateTime `gorm:"column:reserved_at"`
+	ProcessedAt  *carbon.DateTime `gorm:"column:processed_at"`
+	CreatedAt    *carbon.DateTime `gorm:"column:created_at"`
+}
@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	contractsoutbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+	contractsqueue "github.com/rusmanplatd/goravelframework/contracts/queue"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+var (
+	_ contractsoutbox.Publisher  = &Publisher{}
+	_ contractsoutbox.PendingJob = &PendingJob{}
+)
+
+type Publisher struct {
+	outbox *Application
+	tx     contractsorm.Query
+	key    string
+}
+
+func NewPublisher(outbox *Application, tx contractsorm.Query) *Publisher {
+	return &Publisher{
+		outbox: outbox,
+		tx:     tx,
+	}
+}
+
+func (r *Publisher) Key(key string) contractsoutbox.Publisher {
+	return &Publisher{
+		outbox: r.outbox,
+		tx:     r.tx,
+		key:    key,
+	}
+}
+
+func (r *Publisher) Dispatch(event any, payload ...any) error {
+	message := Message{
+		AggregateKey: r.key,
+		Type:         MessageTypeEvent,
+	}
+
+	if name, ok := event.(string); ok {
+		content, err := r.outbox.json.MarshalString(toArgs(payload))
+		if err != nil {
+			return err
+		}
+
+		message.Name = name
+		message.Payload = content
+	} else {
+		content, err := r.outbox.json.MarshalString(event)
+		if err != nil {
+			return err
+		}
+
+		message.Name = r.outbox.register(event)
+		message.Payload = content
+	}
+
+	return r.store(message)
+}
+
+func (r *Publisher) Job(job contractsqueue.Job, args ...[]contractsqueue.Arg) contractsoutbox.PendingJob {
+	pendingJob := &PendingJob{
+		publisher: r,
+		job:       job,
+	}
+	if len(args) > 0 {
+		pendingJob.args = args[0]
+	}
+
+	return pendingJob
+}
+
+func (r *Publisher) store(message Message) error {
+	now := carbon.NewDateTime(carbon.Now())
+	message.CreatedAt = now
+	if message.AvailableAt == nil {
+		message.AvailableAt = now
+	}
+
+	return r.tx.Table(r.outbox.table).Create(&message)
+}
+
+type PendingJob struct {
+	publisher  *Publisher
+	job        contractsqueue.Job
+	args       []contractsqueue.Arg
+	connection string
+	queue      string
+	delay      time.Time
+}
+
+func (r *PendingJob) Delay(delay time.Time) contractsoutbox.PendingJob {
+	r.delay = delay
+
+	return r
+}
+
+func (r *PendingJob) Dispatch() error {
+	content, err := r.publisher.outbox.json.MarshalString(r.args)
+	if err != nil {
+		return err
+	}
+
+	message := Message{
+		AggregateKey: r.publisher.key,
+		Type:         MessageTypeJob,
+		Name:         r.job.Signature(),
+		Payload:      content,
+		Connection:   r.connection,
+		Queue:        r.queue,
+	}
+	if !r.delay.IsZero() {
+		message.AvailableAt = carbon.NewDateTime(carbon.FromStdTime(r.delay))
+	}
+
+	return r.publisher.store(message)
+}
+
+func (r *PendingJob) OnConnection(connection string) contractsoutbox.PendingJob {
+	r.connection = connection
+
+	return r
+}
+
+func (r *PendingJob) OnQueue(queue string) contractsoutbox.PendingJob {
+	r.queue = queue
+
+	return r
+}
+
+// toArgs keeps the type of each payload value, so the relay can restore them after decoding the JSON payload.
+func toArgs(payload []any) []contractsqueue.Arg {
+	args := make([]contractsqueue.Arg, 0, len(payload))
+	for _, value := range payload {
+		args = append(args, contractsqueue.Arg{
+			Value: value,
+			Type:  fmt.Sprintf("%T", value),
+		})
+	}
+
+	return args
+}
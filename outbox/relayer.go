@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"sync"
+	"time"
+
+	contractslog "github.com/rusmanplatd/goravelframework/contracts/log"
+	contractsoutbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+)
+
+var _ contractsoutbox.Relayer = &Relayer{}
+
+type Relayer struct {
+	outbox   contractsoutbox.Outbox
+	log      contractslog.Log
+	interval time.Duration
+	shutdown chan struct{}
+	once     sync.Once
+}
+
+func NewRelayer(outbox contractsoutbox.Outbox, log contractslog.Log, interval int) *Relayer {
+	if interval <= 0 {
+		interval = 1
+	}
+
+	return &Relayer{
+		outbox:   outbox,
+		log:      log,
+		interval: time.Duration(interval) * time.Second,
+		shutdown: make(chan struct{}),
+	}
+}
+
+// Run relays the pending messages, it waits for the interval only when there is nothing to relay or the relay fails.
+func (r *Relayer) Run() error {
+	for {
+		select {
+		case <-r.shutdown:
+			return nil
+		default:
+		}
+
+		relayed, err := r.outbox.Relay()
+		if err != nil {
+			r.log.Error(err)
+		}
+
+		if err == nil && relayed > 0 {
+			continue
+		}
+
+		select {
+		case <-r.shutdown:
+			return nil
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+func (r *Relayer) Shutdown() error {
+	r.once.Do(func() {
+		close(r.shutdown)
+	})
+
+	return nil
+}
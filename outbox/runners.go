@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/config"
+	contractsoutbox "github.com/rusmanplatd/goravelframework/contracts/outbox"
+)
+
+type RelayRunner struct {
+	config  config.Config
+	relayer contractsoutbox.Relayer
+}
+
+func NewRelayRunner(config config.Config, outbox contractsoutbox.Outbox) *RelayRunner {
+	var relayer contractsoutbox.Relayer
+	if outbox != nil {
+		relayer = outbox.Relayer()
+	}
+
+	return &RelayRunner{
+		config:  config,
+		relayer: relayer,
+	}
+}
+
+func (r *RelayRunner) ShouldRun() bool {
+	return r.relayer != nil && r.config.GetBool("outbox.relay.enabled")
+}
+
+func (r *RelayRunner) Run() error {
+	return r.relayer.Run()
+}
+
+func (r *RelayRunner) Shutdown() error {
+	return r.relayer.Shutdown()
+}
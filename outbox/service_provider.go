@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/binding"
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/foundation"
+	"github.com/rusmanplatd/goravelframework/errors"
+	outboxconsole "github.com/rusmanplatd/goravelframework/outbox/console"
+)
+
+type ServiceProvider struct {
+}
+
+func (r *ServiceProvider) Relationship() binding.Relationship {
+	return binding.Relationship{
+		Bindings: []string{
+			binding.Outbox,
+		},
+		Dependencies: binding.Bindings[binding.Outbox].Dependencies,
+		ProvideFor:   []string{},
+	}
+}
+
+func (r *ServiceProvider) Register(app foundation.Application) {
+	app.Singleton(binding.Outbox, func(app foundation.Application) (any, error) {
+		config := app.MakeConfig()
+		if config == nil {
+			return nil, errors.ConfigFacadeNotSet.SetModule(errors.ModuleOutbox)
+		}
+
+		event := app.MakeEvent()
+		if event == nil {
+			return nil, errors.EventFacadeNotSet.SetModule(errors.ModuleOutbox)
+		}
+
+		log := app.MakeLog()
+		if log == nil {
+			return nil, errors.LogFacadeNotSet.SetModule(errors.ModuleOutbox)
+		}
+
+		orm := app.MakeOrm()
+		if orm == nil {
+			return nil, errors.OrmFacadeNotSet.SetModule(errors.ModuleOutbox)
+		}
+
+		queue := app.MakeQueue()
+		if queue == nil {
+			return nil, errors.QueueFacadeNotSet.SetModule(errors.ModuleOutbox)
+		}
+
+		return NewApplication(config, event, app.GetJson(), log, orm, queue), nil
+	})
+}
+
+func (r *ServiceProvider) Boot(app foundation.Application) {
+	app.Commands([]console.Command{
+		outboxconsole.NewOutboxTableCommand(app),
+		outboxconsole.NewOutboxRelayCommand(app.MakeOutbox()),
+	})
+}
+
+func (r *ServiceProvider) Runners(app foundation.Application) []foundation.Runner {
+	return []foundation.Runner{NewRelayRunner(app.MakeConfig(), app.MakeOutbox())}
+}
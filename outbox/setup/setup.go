@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+
+	"github.com/rusmanplatd/goravelframework/packages"
+	"github.com/rusmanplatd/goravelframework/packages/modify"
+	"github.com/rusmanplatd/goravelframework/support/path"
+)
+
+func main() {
+	stubs := Stubs{}
+	modulePath := packages.GetModulePath()
+	outboxServiceProvider := "&outbox.ServiceProvider{}"
+	configPath := path.Config("outbox.go")
+	outboxFacade := "Outbox"
+	outboxFacadePath := path.Facades("outbox.go")
+
+	packages.Setup(os.Args).
+		Install(
+			modify.WhenFacade(outboxFacade,
+				// Add the outbox service provider to the providers array in bootstrap/providers.go
+				modify.AddProviderApply(modulePath, outboxServiceProvider),
+
+				// Create config/outbox.go
+				modify.File(configPath).Overwrite(stubs.Config(packages.GetModuleNameFromArgs(os.Args))),
+
+				// Add the Outbox facade
+				modify.File(outboxFacadePath).Overwrite(stubs.OutboxFacade()),
+			),
+		).
+		Uninstall(
+			// Remove the Outbox facade
+			modify.WhenFacade(outboxFacade, modify.File(outboxFacadePath).Remove()),
+
+			modify.WhenNoFacades([]string{outboxFacade},
+				// Remove config/outbox.go
+				modify.File(configPath).Remove(),
+
+				// Remove the outbox service provider from the providers array in bootstrap/providers.go
+				modify.RemoveProviderApply(modulePath, outboxServiceProvider),
+			),
+		).
+		Execute()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+)
+
+type Stubs struct{}
+
+func (s Stubs) Config(module string) string {
+	content := `package config
+
+import (
+	"DummyModule/app/facades"
+)
+
+func init() {
+	config := facades.Config()
+	config.Add("outbox", map[string]any{
+		// Outbox Database Connection
+		//
+		// The database connection that the relay reads the outbox table from, it should be the
+		// connection of the transactions passed to facades.Outbox().Tx(). Empty means the default one.
+		"connection": config.Env("OUTBOX_CONNECTION", ""),
+
+		// Outbox Table
+		//
+		// The table that stores the messages, run "make:outbox-table" to create its migration.
+		"table": "outbox",
+
+		// Outbox Relay
+		//
+		// The relay runs with the application and forwards the stored messages to the event
+		// dispatcher and the queue. A message is retried until it runs out of tries. The messages
+		// are reserved for the lease seconds while they are dispatched, then they can be relayed by
+		// another relayer if the relayer stops.
+		"relay": map[string]any{
+			"enabled":  config.Env("OUTBOX_RELAY_ENABLED", true),
+			"interval": 1,
+			"batch":    100,
+			"tries":    3,
+			"lease":    60,
+		},
+	})
+}
+`
+
+	return strings.ReplaceAll(content, "DummyModule", module)
+}
+
+func (s Stubs) OutboxFacade() string {
+	return `package facades
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/outbox"
+)
+
+func Outbox() outbox.Outbox {
+	return App().MakeOutbox()
+}
+`
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/packages/match"
+	supportfile "github.com/rusmanplatd/goravelframework/support/file"
 	"github.com/rusmanplatd/goravelframework/support/path/internals"
 )
 
@@ -315,6 +316,44 @@ func AddSeeder(pkg, seeder string) error {
 	return handler.AddItem(pkg, seeder)
 }
 
+// AddListeners writes the event listeners to the listeners.go file in the bootstrap directory and adds
+// WithListeners(Listeners()) to the foundation.Setup() chain if it doesn't exist.
+// The listeners.go file is overwritten every time, it's expected to be generated by the event:discover command.
+//
+// Parameters:
+//   - content: Content of the listeners.go file, it should define the Listeners() map[any][]any function
+//
+// Example usage:
+//
+//	AddListeners(content)
+//
+// This transforms (when WithListeners doesn't exist):
+//
+//	foundation.Setup().WithConfig(config.Boot).Run()
+//
+// Into:
+//
+//	foundation.Setup().WithListeners(Listeners()).WithConfig(config.Boot).Run()
+func AddListeners(content string) error {
+	config := withSliceConfig{
+		fileName:       "listeners.go",
+		withMethodName: "WithListeners",
+		helperFuncName: "Listeners",
+	}
+
+	handler := newWithSliceHandler(config)
+	if err := supportfile.PutContent(handler.filePath, content); err != nil {
+		return err
+	}
+
+	withMethodExists, err := handler.checkWithMethodExists()
+	if err != nil || withMethodExists {
+		return err
+	}
+
+	return GoFile(handler.appFilePath).Find(match.FoundationSetup()).Modify(handler.setupWithFunction()).Apply()
+}
+
 // ExprExists checks if an expression exists in a slice of expressions.
 // It uses structural equality comparison via ExprIndex.
 //
@@ -504,6 +543,28 @@ func MustParseExpr(x string) (node dst.Node) {
 	return WrapNewline(expr)
 }
 
+// RemoveMigration removes the migration from the foundation.Setup() chain in the Boot function, it's the reverse of
+// AddMigration, the migration is removed from the Migrations() function if the migrations.go file exists, otherwise
+// from the inline array of WithMigrations, and the package import is removed if it's no longer used.
+//
+// Example usage:
+//
+//	RemoveMigration("goravel/database/migrations", "&migrations.M20240915060148CreateUsersTable{}")
+func RemoveMigration(pkg, migration string) error {
+	config := withSliceConfig{
+		fileName:       "migrations.go",
+		withMethodName: "WithMigrations",
+		helperFuncName: "Migrations",
+		typePackage:    "schema",
+		typeName:       "Migration",
+		typeImportPath: "github.com/rusmanplatd/goravelframework/contracts/database/schema",
+		matcherFunc:    match.Migrations,
+	}
+
+	handler := newWithSliceHandler(config)
+	return handler.RemoveItem(pkg, migration)
+}
+
 // RemoveProvider removes a service provider from the foundation.Setup() chain in the Boot function.
 // If providers.go exists, it removes the provider from the Providers() function in that file.
 // If providers.go doesn't exist, it removes the provider from the inline array in app.go.
@@ -1524,6 +1524,131 @@ func Providers() []foundation.ServiceProvider {
 	}
 }
 
+func TestRemoveMigration(t *testing.T) {
+	tests := []struct {
+		name               string
+		appContent         string
+		migrationsContent  string // empty if file doesn't exist
+		expectedApp        string
+		expectedMigrations string // expected content after removal, empty if file doesn't exist
+	}{
+		{
+			name: "remove migration from migrations.go",
+			appContent: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithMigrations(Migrations()).Run()
+}
+`,
+			migrationsContent: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+
+	"goravel/database/migrations"
+)
+
+func Migrations() []schema.Migration {
+	return []schema.Migration{
+		&migrations.M20240915060148CreateUsersTable{},
+		&migrations.M20240915060149CreatePostsTable{},
+	}
+}
+`,
+			expectedApp: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithMigrations(Migrations()).Run()
+}
+`,
+			expectedMigrations: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+
+	"goravel/database/migrations"
+)
+
+func Migrations() []schema.Migration {
+	return []schema.Migration{
+		&migrations.M20240915060149CreatePostsTable{},
+	}
+}
+`,
+		},
+		{
+			name: "remove the last migration from the inline array",
+			appContent: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/foundation"
+	"goravel/database/migrations"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithMigrations([]schema.Migration{
+			&migrations.M20240915060148CreateUsersTable{},
+		}).Run()
+}
+`,
+			expectedApp: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/contracts/database/schema"
+	"github.com/rusmanplatd/goravelframework/foundation"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithMigrations([]schema.Migration{}).Run()
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bootstrapDir := support.Config.Paths.Bootstrap
+			appFile := filepath.Join(bootstrapDir, "app.go")
+			migrationsFile := filepath.Join(bootstrapDir, "migrations.go")
+
+			require.NoError(t, supportfile.PutContent(appFile, tt.appContent))
+			defer func() {
+				require.NoError(t, supportfile.Remove(bootstrapDir))
+			}()
+
+			if tt.migrationsContent != "" {
+				require.NoError(t, supportfile.PutContent(migrationsFile, tt.migrationsContent))
+			}
+
+			err := RemoveMigration("goravel/database/migrations", "&migrations.M20240915060148CreateUsersTable{}")
+			require.NoError(t, err)
+
+			appContent, err := supportfile.GetContent(appFile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedApp, appContent)
+
+			if tt.expectedMigrations != "" {
+				migrationsContent, err := supportfile.GetContent(migrationsFile)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedMigrations, migrationsContent)
+			}
+		})
+	}
+}
+
 func TestAddSeeder(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -1790,6 +1915,97 @@ func Seeders() []seeder.Seeder {
 	}
 }
 
+func TestAddListeners(t *testing.T) {
+	listenersContent := `package bootstrap
+
+func Listeners() map[any][]any {
+	return map[any][]any{}
+}
+`
+	tests := []struct {
+		name        string
+		appContent  string
+		expectedApp string
+	}{
+		{
+			name: "add listeners when WithListeners doesn't exist",
+			appContent: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+	"goravel/config"
+)
+
+func Boot() {
+	foundation.Setup().WithConfig(config.Boot).Run()
+}
+`,
+			expectedApp: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+	"goravel/config"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithListeners(Listeners()).WithConfig(config.Boot).Run()
+}
+`,
+		},
+		{
+			name: "add listeners when WithListeners exists",
+			appContent: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+	"goravel/config"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithListeners(Listeners()).WithConfig(config.Boot).Run()
+}
+`,
+			expectedApp: `package bootstrap
+
+import (
+	"github.com/rusmanplatd/goravelframework/foundation"
+	"goravel/config"
+)
+
+func Boot() {
+	foundation.Setup().
+		WithListeners(Listeners()).WithConfig(config.Boot).Run()
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bootstrapDir := support.Config.Paths.Bootstrap
+			appFile := filepath.Join(bootstrapDir, "app.go")
+			listenersFile := filepath.Join(bootstrapDir, "listeners.go")
+
+			assert.NoError(t, supportfile.PutContent(appFile, tt.appContent))
+			defer func() {
+				assert.NoError(t, supportfile.Remove(bootstrapDir))
+			}()
+
+			assert.NoError(t, AddListeners(listenersContent))
+
+			appContent, err := supportfile.GetContent(appFile)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedApp, appContent)
+
+			content, err := supportfile.GetContent(listenersFile)
+			assert.NoError(t, err)
+			assert.Equal(t, listenersContent, content)
+		})
+	}
+}
+
 func TestExprExists(t *testing.T) {
 	assert.NotPanics(t, func() {
 		t.Run("expr exists", func(t *testing.T) {
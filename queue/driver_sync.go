@@ -1,10 +1,12 @@
 package queue
 
 import (
+	"context"
 	"time"
 
 	"github.com/rusmanplatd/goravelframework/contracts/queue"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 var (
@@ -29,13 +31,13 @@ func (r *Sync) Pop(_ string) (queue.ReservedJob, error) {
 }
 
 func (r *Sync) Push(task queue.Task, _ string) error {
-	if err := push(task.ChainJob); err != nil {
+	if err := push(task.ChainJob, task.Tenant); err != nil {
 		return err
 	}
 
 	if len(task.Chain) > 0 {
 		for _, chain := range task.Chain {
-			if err := push(chain); err != nil {
+			if err := push(chain, task.Tenant); err != nil {
 				return err
 			}
 		}
@@ -44,7 +46,7 @@ func (r *Sync) Push(task queue.Task, _ string) error {
 	return nil
 }
 
-func push(job queue.ChainJob) error {
+func push(job queue.ChainJob, tenant string) error {
 	if !job.Delay.IsZero() {
 		time.Sleep(carbon.FromStdTime(job.Delay).DiffAbsInDuration())
 	}
@@ -54,9 +56,9 @@ func push(job queue.ChainJob) error {
 		realArgs = append(realArgs, arg.Value)
 	}
 
-	if err := job.Job.Handle(realArgs...); err != nil {
-		return err
+	if jobWithContext, ok := job.Job.(queue.JobWithContext); ok {
+		return jobWithContext.HandleWithContext(tenancy.WithTenant(context.Background(), tenant), realArgs...)
 	}
 
-	return nil
+	return job.Job.Handle(realArgs...)
 }
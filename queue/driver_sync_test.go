@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -10,11 +11,13 @@ import (
 	"github.com/rusmanplatd/goravelframework/contracts/queue"
 	mocksqueue "github.com/rusmanplatd/goravelframework/mocks/queue"
 	"github.com/rusmanplatd/goravelframework/queue/utils"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 var (
-	testJobOne []any
-	testJobTwo []any
+	testJobOne         []any
+	testJobTwo         []any
+	testJobWithContext string
 )
 
 type SyncTestSuite struct {
@@ -41,6 +44,7 @@ func (s *SyncTestSuite) SetupSuite() {
 func (s *SyncTestSuite) SetupTest() {
 	testJobOne = nil
 	testJobTwo = nil
+	testJobWithContext = ""
 
 	s.mockConfig.EXPECT().DefaultConnection().Return("sync").Once()
 	s.mockConfig.EXPECT().DefaultQueue().Return("default").Once()
@@ -57,6 +61,11 @@ func (s *SyncTestSuite) TestDispatch() {
 	s.Equal(utils.ConvertArgs(testArgs), testJobOne)
 }
 
+func (s *SyncTestSuite) TestDispatchWithTenant() {
+	s.Nil(s.app.Job(&TestJobWithContext{}, testArgs).OnTenant("acme").Dispatch())
+	s.Equal("acme", testJobWithContext)
+}
+
 func (s *SyncTestSuite) TestChainDispatch() {
 	argsOne := []queue.Arg{
 		{
@@ -195,6 +204,26 @@ func (r *TestJobTwo) Handle(args ...any) error {
 	return nil
 }
 
+type TestJobWithContext struct {
+}
+
+// Signature The name and signature of the job.
+func (r *TestJobWithContext) Signature() string {
+	return "test_job_with_context"
+}
+
+// Handle Execute the job.
+func (r *TestJobWithContext) Handle(args ...any) error {
+	return nil
+}
+
+// HandleWithContext Execute the job with the context.
+func (r *TestJobWithContext) HandleWithContext(ctx context.Context, args ...any) error {
+	testJobWithContext = tenancy.FromContext(ctx)
+
+	return nil
+}
+
 type TestJobErr struct {
 }
 
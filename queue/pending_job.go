@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,6 +10,7 @@ import (
 	contractslog "github.com/rusmanplatd/goravelframework/contracts/log"
 	contractsqueue "github.com/rusmanplatd/goravelframework/contracts/queue"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type PendingJob struct {
@@ -130,6 +132,21 @@ func (r *PendingJob) OnQueue(queue string) contractsqueue.PendingJob {
 	return r
 }
 
+// OnTenant sets the tenant of the task
+func (r *PendingJob) OnTenant(tenant string) contractsqueue.PendingJob {
+	r.task.Tenant = tenant
+	return r
+}
+
+// WithContext sets the tenant of the task to the tenant of the dispatch context
+func (r *PendingJob) WithContext(ctx context.Context) contractsqueue.PendingJob {
+	if tenant := tenancy.FromContext(ctx); tenant != "" {
+		r.task.Tenant = tenant
+	}
+
+	return r
+}
+
 func (r *PendingJob) recalculateDelay() {
 	if !r.delay.IsZero() {
 		if !r.task.Delay.IsZero() {
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	contractsqueue "github.com/rusmanplatd/goravelframework/contracts/queue"
 	mocksqueue "github.com/rusmanplatd/goravelframework/mocks/queue"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type PendingJobTestSuite struct {
@@ -185,3 +187,21 @@ func (s *PendingJobTestSuite) TestOnQueue() {
 	s.Equal("high", s.pendingJob.queue)
 	s.Equal(s.pendingJob, pendingJobWithNewQueue)
 }
+
+func (s *PendingJobTestSuite) TestOnTenant() {
+	pendingJobWithTenant := s.pendingJob.OnTenant("acme")
+
+	s.Equal("acme", s.pendingJob.task.Tenant)
+	s.Equal(s.pendingJob, pendingJobWithTenant)
+}
+
+func (s *PendingJobTestSuite) TestWithContext() {
+	pendingJobWithContext := s.pendingJob.WithContext(tenancy.WithTenant(context.Background(), "acme"))
+
+	s.Equal("acme", s.pendingJob.task.Tenant)
+	s.Equal(s.pendingJob, pendingJobWithContext)
+
+	s.pendingJob.WithContext(context.Background())
+
+	s.Equal("acme", s.pendingJob.task.Tenant)
+}
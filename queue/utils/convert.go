@@ -12,8 +12,9 @@ import (
 
 type Task struct {
 	Job
-	UUID  string `json:"uuid"`
-	Chain []Job  `json:"chain"`
+	UUID   string `json:"uuid"`
+	Tenant string `json:"tenant,omitempty"`
+	Chain  []Job  `json:"chain"`
 }
 
 type Job struct {
@@ -62,9 +63,10 @@ func TaskToJson(task contractsqueue.Task, json foundation.Json) (string, error)
 	}
 
 	t := Task{
-		UUID:  task.UUID,
-		Job:   job,
-		Chain: chain,
+		UUID:   task.UUID,
+		Tenant: task.Tenant,
+		Job:    job,
+		Chain:  chain,
 	}
 
 	payload, err := json.MarshalString(t)
@@ -116,6 +118,7 @@ func JsonToTask(payload string, jobStorer contractsqueue.JobStorer, json foundat
 
 	return contractsqueue.Task{
 		UUID:     task.UUID,
+		Tenant:   task.Tenant,
 		ChainJob: jobs,
 		Chain:    chain,
 	}, nil
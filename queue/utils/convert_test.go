@@ -50,6 +50,28 @@ func TestTaskToJson(t *testing.T) {
 			expectedJson:  "{\"test\":true}",
 			expectedError: nil,
 		},
+		{
+			name: "successful conversion with tenant",
+			task: contractsqueue.Task{
+				UUID:   "test-uuid",
+				Tenant: "acme",
+				ChainJob: contractsqueue.ChainJob{
+					Job: &TestJobOne{},
+				},
+			},
+			setup: func() {
+				expectedTask := Task{
+					UUID:   "test-uuid",
+					Tenant: "acme",
+					Job: Job{
+						Signature: "test_job_one",
+					},
+				}
+				mockJson.EXPECT().MarshalString(expectedTask).Return("{\"test\":true}", nil).Once()
+			},
+			expectedJson:  "{\"test\":true}",
+			expectedError: nil,
+		},
 		{
 			name: "successful conversion with task chain",
 			task: contractsqueue.Task{
@@ -173,6 +195,28 @@ func TestJsonToTask(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:    "happy path - conversion with tenant",
+			payload: "{\"uuid\":\"test-uuid\",\"tenant\":\"acme\",\"signature\":\"test_job_one\",\"args\":[]}",
+			setup: func() {
+				var task Task
+				mockJson.EXPECT().UnmarshalString("{\"uuid\":\"test-uuid\",\"tenant\":\"acme\",\"signature\":\"test_job_one\",\"args\":[]}", &task).
+					Run(func(_ string, taskPtr any) {
+						taskPtr.(*Task).UUID = "test-uuid"
+						taskPtr.(*Task).Tenant = "acme"
+						taskPtr.(*Task).Job.Signature = "test_job_one"
+					}).Return(nil).Once()
+				mockJobStorer.EXPECT().Get("test_job_one").Return(&TestJobOne{}, nil).Once()
+			},
+			expectedTask: contractsqueue.Task{
+				UUID:   "test-uuid",
+				Tenant: "acme",
+				ChainJob: contractsqueue.ChainJob{
+					Job: &TestJobOne{},
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			name:    "happy path - conversion with task chain",
 			payload: "{\"uuid\":\"test-uuid\",\"signature\":\"test_job_one\",\"args\":[],\"chain\":[{\"signature\":\"test_job_two\",\"args\":[{\"type\":\"int\",\"value\":42}]}]}",
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 	"github.com/rusmanplatd/goravelframework/support/color"
 	"github.com/rusmanplatd/goravelframework/support/console"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type Worker struct {
@@ -103,7 +105,12 @@ func (r *Worker) call(task queue.Task) error {
 		}
 
 		now := carbon.Now()
-		err := r.job.Call(task.Job.Signature(), utils.ConvertArgs(task.Args))
+		var err error
+		if jobWithContext, ok := task.Job.(queue.JobWithContext); ok {
+			err = jobWithContext.HandleWithContext(tenancy.WithTenant(context.Background(), task.Tenant), utils.ConvertArgs(task.Args)...)
+		} else {
+			err = r.job.Call(task.Job.Signature(), utils.ConvertArgs(task.Args))
+		}
 		duration := now.DiffAbsInDuration().String()
 
 		if err == nil {
@@ -255,6 +262,7 @@ func (r *Worker) run() error {
 						chainTask := queue.Task{
 							ChainJob: chain,
 							UUID:     task.UUID,
+							Tenant:   task.Tenant,
 							Chain:    task.Chain[i+1:],
 						}
 
@@ -2,6 +2,7 @@ package schedule
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/rusmanplatd/goravelframework/contracts/log"
 	"github.com/rusmanplatd/goravelframework/contracts/schedule"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type Application struct {
@@ -108,8 +110,28 @@ func (app *Application) getJob(event schedule.Event) cron.Job {
 
 func (app *Application) runJob(event schedule.Event) {
 	if event.GetCommand() != "" {
-		if err := app.artisan.Call(event.GetCommand()); err != nil {
+		if !event.IsOnTenants() {
+			if err := app.artisan.Call(event.GetCommand()); err != nil {
+				app.log.Errorf("run %s command error: %v", event.GetCommand(), err)
+			}
+
+			return
+		}
+
+		tenants, err := event.GetTenants()
+		if err != nil {
 			app.log.Errorf("run %s command error: %v", event.GetCommand(), err)
+
+			return
+		}
+
+		for _, tenant := range tenants {
+			// The option is placed before the arguments of the command, eg: send:emails --tenant=acme 1.
+			name, args, _ := strings.Cut(event.GetCommand(), " ")
+			command := strings.TrimSpace(fmt.Sprintf("%s --%s=%s %s", name, tenancy.OptionName, tenant, args))
+			if err := app.artisan.Call(command); err != nil {
+				app.log.Errorf("run %s command error: %v", command, err)
+			}
 		}
 	} else {
 		event.GetCallback()()
@@ -10,10 +10,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/rusmanplatd/goravelframework/contracts/schedule"
+	"github.com/rusmanplatd/goravelframework/errors"
 	mockscache "github.com/rusmanplatd/goravelframework/mocks/cache"
 	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
 	mockslog "github.com/rusmanplatd/goravelframework/mocks/log"
 	"github.com/rusmanplatd/goravelframework/support/env"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type ApplicationTestSuite struct {
@@ -28,6 +30,36 @@ func TestApplicationTestSuite(t *testing.T) {
 func (s *ApplicationTestSuite) SetupTest() {
 }
 
+func (s *ApplicationTestSuite) TestRunJobOnTenants() {
+	mockArtisan := mocksconsole.NewArtisan(s.T())
+	mockArtisan.EXPECT().Call("test --tenant=acme argument0").Return(nil).Once()
+	mockArtisan.EXPECT().Call("test --tenant=globex argument0").Return(nil).Once()
+
+	app := NewApplication(mockArtisan, nil, nil, false)
+	app.runJob(NewCommandEvent("test argument0").OnTenants("acme", "globex"))
+}
+
+func (s *ApplicationTestSuite) TestRunJobOnAllTenants() {
+	s.T().Cleanup(func() {
+		tenancy.SetTenantsResolver(nil)
+	})
+
+	mockArtisan := mocksconsole.NewArtisan(s.T())
+	mockLog := mockslog.NewLog(s.T())
+	mockLog.EXPECT().Errorf("run %s command error: %v", "test argument0", errors.TenancyTenantsResolverNotSet).Once()
+
+	app := NewApplication(mockArtisan, nil, mockLog, false)
+	app.runJob(NewCommandEvent("test argument0").OnTenants())
+
+	tenancy.SetTenantsResolver(func() ([]string, error) {
+		return []string{"acme", "globex"}, nil
+	})
+	mockArtisan.EXPECT().Call("test --tenant=acme argument0").Return(nil).Once()
+	mockArtisan.EXPECT().Call("test --tenant=globex argument0").Return(nil).Once()
+
+	app.runJob(NewCommandEvent("test argument0").OnTenants())
+}
+
 func (s *ApplicationTestSuite) TestCallAndCommand() {
 	mockArtisan := mocksconsole.NewArtisan(s.T())
 	mockArtisan.EXPECT().Call("test --name Goravel argument0 argument1").Return(nil).Times(2)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rusmanplatd/goravelframework/contracts/schedule"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 )
 
 type Event struct {
@@ -16,7 +17,9 @@ type Event struct {
 	name                string
 	delayIfStillRunning bool
 	onOneServer         bool
+	onTenants           bool
 	skipIfStillRunning  bool
+	tenants             []string
 }
 
 func NewCallbackEvent(callback func()) *Event {
@@ -201,6 +204,17 @@ func (r *Event) GetName() string {
 	return r.name
 }
 
+func (r *Event) GetTenants() ([]string, error) {
+	if !r.onTenants {
+		return nil, nil
+	}
+	if len(r.tenants) == 0 {
+		return tenancy.All()
+	}
+
+	return r.tenants, nil
+}
+
 func (r *Event) GetSkipIfStillRunning() bool {
 	return r.skipIfStillRunning
 }
@@ -307,6 +321,10 @@ func (r *Event) IsOnOneServer() bool {
 	return r.onOneServer
 }
 
+func (r *Event) IsOnTenants() bool {
+	return r.onTenants
+}
+
 func (r *Event) Name(name string) schedule.Event {
 	r.name = name
 
@@ -319,6 +337,15 @@ func (r *Event) OnOneServer() schedule.Event {
 	return r
 }
 
+// OnTenants run the command for each tenant, the command gets the tenant by tenancy.FromCommand(ctx), the
+// tenants are resolved by tenancy.All when the command runs if no tenant is given.
+func (r *Event) OnTenants(tenants ...string) schedule.Event {
+	r.onTenants = true
+	r.tenants = tenants
+
+	return r
+}
+
 // SkipIfStillRunning do not allow the event to overlap each other.
 func (r *Event) SkipIfStillRunning() schedule.Event {
 	r.skipIfStillRunning = true
@@ -195,6 +195,19 @@ func (s *EventTestSuite) TestSkipIfStillRunning() {
 	s.True(s.event.GetSkipIfStillRunning())
 }
 
+func (s *EventTestSuite) TestOnTenants() {
+	s.False(s.event.IsOnTenants())
+	tenants, err := s.event.GetTenants()
+	s.NoError(err)
+	s.Nil(tenants)
+
+	s.event.OnTenants("acme", "globex")
+	s.True(s.event.IsOnTenants())
+	tenants, err = s.event.GetTenants()
+	s.NoError(err)
+	s.Equal([]string{"acme", "globex"}, tenants)
+}
+
 func (s *EventTestSuite) TestDelayIfStillRunning() {
 	s.event.DelayIfStillRunning()
 	s.True(s.event.GetDelayIfStillRunning())
@@ -0,0 +1,481 @@
+package database
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/str"
+)
+
+var (
+	cursorKey   []byte
+	cursorKeyMu sync.RWMutex
+)
+
+// SetCursorKey sets the key that signs the pagination cursors, the database service provider sets it to app.key.
+func SetCursorKey(key string) {
+	cursorKeyMu.Lock()
+	defer cursorKeyMu.Unlock()
+
+	cursorKey = []byte(key)
+}
+
+// Cursor is the position of a cursor paginated query: the order column values of the row on the edge of a page,
+// the orders are kept to refuse the cursor when the query is ordered differently.
+type Cursor struct {
+	Orders   []string `json:"o"`
+	Values   []any    `json:"v"`
+	Backward bool     `json:"b"`
+	// Types are the types of the values that JSON can't keep, eg: "time", the values are restored by them.
+	Types []string `json:"t,omitempty"`
+}
+
+// cursorTypeTime is the type of the time values, the carbon values are converted to time.Time by their Value method.
+const cursorTypeTime = "time"
+
+// CursorOrder is an order column of a cursor paginated query, the NULL values of a column that isn't the
+// primary key are sorted before the other values.
+type CursorOrder struct {
+	Column     string
+	Desc       bool
+	PrimaryKey bool
+}
+
+// String returns the "column direction" order.
+func (r CursorOrder) String() string {
+	if r.Desc {
+		return r.Column + " DESC"
+	}
+
+	return r.Column + " ASC"
+}
+
+// CursorPaginate paginates a query by the keyset of its orders, the primary key is appended to the orders as the
+// tiebreaker if the query isn't ordered by it. The fetch function should query at most limit rows into dest,
+// ordered by the given orders and filtered by the where condition when it isn't empty.
+func CursorPaginate(orders []string, primaryKey string, limit int, cursor string, dest any, fetch func(orders []string, where string, args []any, limit int) error) (next, prev string, err error) {
+	if limit <= 0 {
+		return "", "", errors.DatabaseCursorInvalidLimit.Args(limit)
+	}
+
+	cursorOrders, err := ParseCursorOrders(orders, primaryKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	var (
+		decoded *Cursor
+		where   string
+		args    []any
+	)
+	if cursor != "" {
+		if decoded, err = DecodeCursor(cursor); err != nil {
+			return "", "", err
+		}
+		if where, args, err = CursorWhere(cursorOrders, decoded); err != nil {
+			return "", "", err
+		}
+	}
+
+	backward := decoded != nil && decoded.Backward
+	fetchOrders := CompileCursorOrders(cursorOrders)
+	if backward {
+		fetchOrders = CompileCursorOrders(ReverseCursorOrders(cursorOrders))
+	}
+
+	// Fetch one more row to know whether there are more rows after this page.
+	if err := fetch(fetchOrders, where, args, limit+1); err != nil {
+		return "", "", err
+	}
+
+	rows := reflect.Indirect(reflect.ValueOf(dest))
+	if rows.Kind() != reflect.Slice {
+		return "", "", errors.DatabaseUnsupportedType.Args(rows.Type().String(), "pointer to slice")
+	}
+
+	hasMore := rows.Len() > limit
+	if hasMore {
+		rows.Set(rows.Slice(0, limit))
+	}
+	if backward {
+		ReverseSlice(dest)
+	}
+	if rows.Len() == 0 {
+		return "", "", nil
+	}
+
+	if hasMore || backward {
+		if next, err = NewCursor(rows.Index(rows.Len()-1), cursorOrders, false); err != nil {
+			return "", "", err
+		}
+	}
+	if (hasMore && backward) || (decoded != nil && !backward) {
+		if prev, err = NewCursor(rows.Index(0), cursorOrders, true); err != nil {
+			return "", "", err
+		}
+	}
+
+	return next, prev, nil
+}
+
+// ParseCursorOrders parses the "column direction" orders of a query, the primary key is appended as the tiebreaker
+// if the query isn't ordered by it, so the keyset of the orders is unique.
+func ParseCursorOrders(orders []string, primaryKey string) ([]CursorOrder, error) {
+	if primaryKey == "" {
+		primaryKey = "id"
+	}
+
+	cursorOrders := make([]CursorOrder, 0, len(orders)+1)
+	orderedByPrimaryKey := false
+	for _, order := range orders {
+		fields := strings.Fields(order)
+		if len(fields) == 0 || len(fields) > 2 || strings.ContainsAny(fields[0], "()") {
+			return nil, errors.DatabaseCursorInvalidOrder.Args(order)
+		}
+
+		cursorOrder := CursorOrder{Column: fields[0], PrimaryKey: cursorColumnName(fields[0]) == cursorColumnName(primaryKey)}
+		if len(fields) == 2 {
+			switch strings.ToUpper(fields[1]) {
+			case "ASC":
+			case "DESC":
+				cursorOrder.Desc = true
+			default:
+				return nil, errors.DatabaseCursorInvalidOrder.Args(order)
+			}
+		}
+
+		orderedByPrimaryKey = orderedByPrimaryKey || cursorOrder.PrimaryKey
+		cursorOrders = append(cursorOrders, cursorOrder)
+	}
+
+	if !orderedByPrimaryKey {
+		cursorOrders = append(cursorOrders, CursorOrder{Column: primaryKey, PrimaryKey: true})
+	}
+
+	return cursorOrders, nil
+}
+
+// CompileCursorOrders compiles the orders of the query, the NULL values of a column that isn't the primary key are
+// sorted before the other values explicitly, because the databases sort them differently.
+func CompileCursorOrders(orders []CursorOrder) []string {
+	compiled := make([]string, 0, len(orders))
+	for _, order := range orders {
+		if !order.PrimaryKey {
+			direction := "ASC"
+			if order.Desc {
+				direction = "DESC"
+			}
+			compiled = append(compiled, fmt.Sprintf("CASE WHEN %s IS NULL THEN 0 ELSE 1 END %s", order.Column, direction))
+		}
+
+		compiled = append(compiled, order.String())
+	}
+
+	return compiled
+}
+
+// ReverseCursorOrders reverses the directions of the orders, it's used to fetch the previous page.
+func ReverseCursorOrders(orders []CursorOrder) []CursorOrder {
+	reversed := make([]CursorOrder, 0, len(orders))
+	for _, order := range orders {
+		order.Desc = !order.Desc
+		reversed = append(reversed, order)
+	}
+
+	return reversed
+}
+
+// CursorWhere builds the keyset condition that selects the rows after the cursor, the NULL values are the smallest
+// values, eg:
+//
+//	((a > ?) OR (a = ? AND (b < ? OR b IS NULL)) OR (a = ? AND b = ? AND id > ?))
+func CursorWhere(orders []CursorOrder, cursor *Cursor) (string, []any, error) {
+	keys := make([]string, 0, len(orders))
+	for _, order := range orders {
+		keys = append(keys, order.String())
+	}
+	if !slices.Equal(cursor.Orders, keys) {
+		return "", nil, errors.DatabaseCursorOrderMismatch.Args(strings.Join(cursor.Orders, ", "), strings.Join(keys, ", "))
+	}
+	if len(cursor.Values) != len(orders) {
+		return "", nil, errors.DatabaseCursorInvalid
+	}
+
+	var (
+		clauses []string
+		args    []any
+	)
+	for i, order := range orders {
+		greater := order.Desc == cursor.Backward
+		// Nothing is less than NULL.
+		if cursor.Values[i] == nil && !greater {
+			continue
+		}
+
+		var conditions []string
+		for j := 0; j < i; j++ {
+			if cursor.Values[j] == nil {
+				conditions = append(conditions, orders[j].Column+" IS NULL")
+			} else {
+				conditions = append(conditions, orders[j].Column+" = ?")
+				args = append(args, cursor.Values[j])
+			}
+		}
+
+		switch {
+		case cursor.Values[i] == nil:
+			conditions = append(conditions, order.Column+" IS NOT NULL")
+		case greater:
+			conditions = append(conditions, order.Column+" > ?")
+			args = append(args, cursor.Values[i])
+		case order.PrimaryKey:
+			conditions = append(conditions, order.Column+" < ?")
+			args = append(args, cursor.Values[i])
+		default:
+			conditions = append(conditions, fmt.Sprintf("(%s < ? OR %s IS NULL)", order.Column, order.Column))
+			args = append(args, cursor.Values[i])
+		}
+
+		clauses = append(clauses, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	return "(" + strings.Join(clauses, " OR ") + ")", args, nil
+}
+
+// EncodeCursor encodes the cursor into an opaque string that is signed by the cursor key, the types of the time values
+// are stored, so they are bound as time.Time instead of strings when the cursor is decoded.
+func EncodeCursor(cursor Cursor) (string, error) {
+	cursor.Types = nil
+	values := make([]any, len(cursor.Values))
+	for i, value := range cursor.Values {
+		if pointer, ok := value.(*time.Time); ok && pointer != nil {
+			value = *pointer
+		}
+		if t, ok := value.(time.Time); ok {
+			if cursor.Types == nil {
+				cursor.Types = make([]string, len(cursor.Values))
+			}
+			cursor.Types[i] = cursorTypeTime
+			value = t.Format(time.RFC3339Nano)
+		}
+		values[i] = value
+	}
+	cursor.Values = values
+
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := signCursor(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// DecodeCursor decodes the cursor encoded by EncodeCursor, it fails if the cursor has been tampered with.
+func DecodeCursor(value string) (*Cursor, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil, errors.DatabaseCursorInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, errors.DatabaseCursorInvalid
+	}
+
+	expected, err := signCursor(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, expected) {
+		return nil, errors.DatabaseCursorInvalid
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var cursor Cursor
+	if err := decoder.Decode(&cursor); err != nil {
+		return nil, errors.DatabaseCursorInvalid
+	}
+
+	if len(cursor.Types) > 0 && len(cursor.Types) != len(cursor.Values) {
+		return nil, errors.DatabaseCursorInvalid
+	}
+
+	// Keep the integers as int64, float64 would lose the precision of big IDs.
+	for i, value := range cursor.Values {
+		if len(cursor.Types) > 0 && cursor.Types[i] == cursorTypeTime {
+			text, ok := value.(string)
+			if !ok {
+				return nil, errors.DatabaseCursorInvalid
+			}
+			t, err := time.Parse(time.RFC3339Nano, text)
+			if err != nil {
+				return nil, errors.DatabaseCursorInvalid
+			}
+			cursor.Values[i] = t
+			continue
+		}
+
+		if number, ok := value.(json.Number); ok {
+			if integer, err := number.Int64(); err == nil {
+				cursor.Values[i] = integer
+			} else if float, err := number.Float64(); err == nil {
+				cursor.Values[i] = float
+			}
+		}
+	}
+	cursor.Types = nil
+
+	return &cursor, nil
+}
+
+// NewCursor creates a cursor from the order column values of the row.
+func NewCursor(row reflect.Value, orders []CursorOrder, backward bool) (string, error) {
+	keys := make([]string, 0, len(orders))
+	values := make([]any, 0, len(orders))
+	for _, order := range orders {
+		value, err := GetColumnValue(row, order.Column)
+		if err != nil {
+			return "", err
+		}
+
+		keys = append(keys, order.String())
+		values = append(values, value)
+	}
+
+	return EncodeCursor(Cursor{Orders: keys, Values: values, Backward: backward})
+}
+
+// GetColumnValue gets the value of the column from a map or a struct row, the struct field is matched by
+// the db tag, the column of the gorm tag or the snake case name of the field.
+func GetColumnValue(row reflect.Value, column string) (any, error) {
+	column = cursorColumnName(column)
+
+	for row.Kind() == reflect.Pointer || row.Kind() == reflect.Interface {
+		if row.IsNil() {
+			return nil, errors.DatabaseCursorColumnNotFound.Args(column)
+		}
+		row = row.Elem()
+	}
+
+	switch row.Kind() {
+	case reflect.Map:
+		value := row.MapIndex(reflect.ValueOf(column))
+		if !value.IsValid() {
+			return nil, errors.DatabaseCursorColumnNotFound.Args(column)
+		}
+
+		return toCursorValue(value.Interface())
+	case reflect.Struct:
+		if value, ok := getStructColumnValue(row, column); ok {
+			return toCursorValue(value.Interface())
+		}
+	}
+
+	return nil, errors.DatabaseCursorColumnNotFound.Args(column)
+}
+
+// ReverseSlice reverses the rows of a pointer to slice, the rows of a previous page are fetched in the reversed order.
+func ReverseSlice(dest any) {
+	value := reflect.Indirect(reflect.ValueOf(dest))
+	if value.Kind() != reflect.Slice {
+		return
+	}
+
+	swap := reflect.Swapper(value.Interface())
+	for i, j := 0, value.Len()-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
+
+func getStructColumnValue(row reflect.Value, column string) (reflect.Value, bool) {
+	t := row.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if field.Anonymous && reflect.Indirect(row.Field(i)).Kind() == reflect.Struct {
+			if value, ok := getStructColumnValue(reflect.Indirect(row.Field(i)), column); ok {
+				return value, true
+			}
+			continue
+		}
+
+		if getFieldColumn(field) == column {
+			return row.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func getFieldColumn(field reflect.StructField) string {
+	if tag := strings.Split(field.Tag.Get("db"), ",")[0]; tag != "" {
+		return tag
+	}
+
+	for setting := range strings.SplitSeq(field.Tag.Get("gorm"), ";") {
+		if name, ok := strings.CutPrefix(strings.TrimSpace(setting), "column:"); ok {
+			return name
+		}
+	}
+
+	return str.Of(field.Name).Snake().String()
+}
+
+func toCursorValue(value any) (any, error) {
+	if valuer, ok := value.(driver.Valuer); ok {
+		if v := reflect.ValueOf(valuer); v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil, nil
+		}
+
+		return valuer.Value()
+	}
+
+	return value, nil
+}
+
+// cursorColumnName removes the table of the column, eg: users.id -> id.
+func cursorColumnName(column string) string {
+	if index := strings.LastIndex(column, "."); index >= 0 {
+		return column[index+1:]
+	}
+
+	return column
+}
+
+// signCursor signs the payload of the cursor, it fails if the key is empty, otherwise anyone could forge a cursor.
+func signCursor(payload []byte) ([]byte, error) {
+	cursorKeyMu.RLock()
+	defer cursorKeyMu.RUnlock()
+
+	if len(cursorKey) == 0 {
+		return nil, errors.DatabaseCursorKeyNotSet
+	}
+
+	mac := hmac.New(sha256.New, cursorKey)
+	mac.Write(payload)
+
+	return mac.Sum(nil), nil
+}
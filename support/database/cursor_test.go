@@ -0,0 +1,227 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/carbon"
+)
+
+type CursorUser struct {
+	ID        uint `gorm:"primaryKey"`
+	Name      string
+	Score     int              `db:"score"`
+	CreatedAt *carbon.DateTime `gorm:"column:created_at"`
+}
+
+type CursorModel struct {
+	ID uint `gorm:"primaryKey"`
+}
+
+type CursorPost struct {
+	CursorModel
+	Title string
+}
+
+func TestParseCursorOrders(t *testing.T) {
+	orders, err := ParseCursorOrders(nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorOrder{{Column: "id", PrimaryKey: true}}, orders)
+
+	orders, err = ParseCursorOrders([]string{"score desc", "users.id"}, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorOrder{{Column: "score", Desc: true}, {Column: "users.id", PrimaryKey: true}}, orders)
+
+	// The primary key is appended as the tiebreaker.
+	orders, err = ParseCursorOrders([]string{"score desc"}, "uuid")
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorOrder{{Column: "score", Desc: true}, {Column: "uuid", PrimaryKey: true}}, orders)
+
+	_, err = ParseCursorOrders([]string{"RANDOM()"}, "id")
+	assert.Equal(t, errors.DatabaseCursorInvalidOrder.Args("RANDOM()"), err)
+
+	_, err = ParseCursorOrders([]string{"id NULLS LAST DESC"}, "id")
+	assert.Equal(t, errors.DatabaseCursorInvalidOrder.Args("id NULLS LAST DESC"), err)
+}
+
+func TestCompileCursorOrders(t *testing.T) {
+	orders := []CursorOrder{{Column: "score", Desc: true}, {Column: "id", PrimaryKey: true}}
+
+	assert.Equal(t, []string{"CASE WHEN score IS NULL THEN 0 ELSE 1 END DESC", "score DESC", "id ASC"}, CompileCursorOrders(orders))
+	assert.Equal(t, []string{"CASE WHEN score IS NULL THEN 0 ELSE 1 END ASC", "score ASC", "id DESC"}, CompileCursorOrders(ReverseCursorOrders(orders)))
+}
+
+func TestCursorWhere(t *testing.T) {
+	orders := []CursorOrder{{Column: "score", Desc: true}, {Column: "id", PrimaryKey: true}}
+	keys := []string{"score DESC", "id ASC"}
+
+	where, args, err := CursorWhere(orders, &Cursor{Orders: keys, Values: []any{10, 5}})
+	assert.NoError(t, err)
+	assert.Equal(t, "(((score < ? OR score IS NULL)) OR (score = ? AND id > ?))", where)
+	assert.Equal(t, []any{10, 10, 5}, args)
+
+	where, args, err = CursorWhere(orders, &Cursor{Orders: keys, Values: []any{10, 5}, Backward: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "((score > ?) OR (score = ? AND id < ?))", where)
+	assert.Equal(t, []any{10, 10, 5}, args)
+
+	// NULL is the smallest value.
+	where, args, err = CursorWhere(orders, &Cursor{Orders: keys, Values: []any{nil, 5}})
+	assert.NoError(t, err)
+	assert.Equal(t, "((score IS NULL AND id > ?))", where)
+	assert.Equal(t, []any{5}, args)
+
+	where, args, err = CursorWhere(orders, &Cursor{Orders: keys, Values: []any{nil, 5}, Backward: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "((score IS NOT NULL) OR (score IS NULL AND id < ?))", where)
+	assert.Equal(t, []any{5}, args)
+
+	_, _, err = CursorWhere(orders, &Cursor{Orders: keys, Values: []any{10}})
+	assert.Equal(t, errors.DatabaseCursorInvalid, err)
+
+	// The cursor is bound to the orders it's created for.
+	_, _, err = CursorWhere(orders, &Cursor{Orders: []string{"id ASC"}, Values: []any{5}})
+	assert.Equal(t, errors.DatabaseCursorOrderMismatch.Args("id ASC", "score DESC, id ASC"), err)
+}
+
+func TestEncodeAndDecodeCursor(t *testing.T) {
+	_, err := EncodeCursor(Cursor{Values: []any{1}})
+	assert.Equal(t, errors.DatabaseCursorKeyNotSet, err)
+
+	SetCursorKey("key")
+	defer SetCursorKey("")
+
+	encoded, err := EncodeCursor(Cursor{Orders: []string{"id ASC"}, Values: []any{uint64(9007199254740993), 1.5, "name", nil}, Backward: true})
+	assert.NoError(t, err)
+
+	cursor, err := DecodeCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, &Cursor{Orders: []string{"id ASC"}, Values: []any{int64(9007199254740993), 1.5, "name", nil}, Backward: true}, cursor)
+
+	// The time values are restored as time.Time, they would be compared as strings otherwise.
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 6000, time.FixedZone("UTC+7", 7*3600))
+	encoded, err = EncodeCursor(Cursor{Orders: []string{"created_at ASC", "id ASC"}, Values: []any{createdAt, 1}})
+	assert.NoError(t, err)
+
+	cursor, err = DecodeCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"created_at ASC", "id ASC"}, cursor.Orders)
+	assert.True(t, createdAt.Equal(cursor.Values[0].(time.Time)))
+	assert.Equal(t, int64(1), cursor.Values[1])
+
+	encoded, err = EncodeCursor(Cursor{Orders: []string{"created_at ASC"}, Values: []any{&createdAt}})
+	assert.NoError(t, err)
+
+	cursor, err = DecodeCursor(encoded)
+	assert.NoError(t, err)
+	assert.True(t, createdAt.Equal(cursor.Values[0].(time.Time)))
+
+	// The cursor is signed, so it can't be modified.
+	tampered, err := EncodeCursor(Cursor{Values: []any{1}})
+	assert.NoError(t, err)
+	SetCursorKey("another")
+	_, err = DecodeCursor(tampered)
+	assert.Equal(t, errors.DatabaseCursorInvalid, err)
+
+	_, err = DecodeCursor("invalid")
+	assert.Equal(t, errors.DatabaseCursorInvalid, err)
+
+	SetCursorKey("")
+	_, err = DecodeCursor(encoded)
+	assert.Equal(t, errors.DatabaseCursorKeyNotSet, err)
+}
+
+func TestGetColumnValue(t *testing.T) {
+	now := carbon.Now()
+	user := &CursorUser{ID: 1, Name: "goravel", Score: 10, CreatedAt: carbon.NewDateTime(now)}
+
+	value, err := GetColumnValue(reflect.ValueOf(user), "users.id")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), value)
+
+	value, err = GetColumnValue(reflect.ValueOf(user), "score")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, value)
+
+	value, err = GetColumnValue(reflect.ValueOf(user), "created_at")
+	assert.NoError(t, err)
+	assert.Equal(t, now.StdTime(), value)
+
+	value, err = GetColumnValue(reflect.ValueOf(CursorPost{CursorModel: CursorModel{ID: 2}}), "id")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), value)
+
+	value, err = GetColumnValue(reflect.ValueOf(map[string]any{"name": "goravel"}), "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "goravel", value)
+
+	_, err = GetColumnValue(reflect.ValueOf(user), "email")
+	assert.Equal(t, errors.DatabaseCursorColumnNotFound.Args("email"), err)
+}
+
+func TestCursorPaginate(t *testing.T) {
+	SetCursorKey("key")
+	defer SetCursorKey("")
+
+	rows := []CursorUser{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}}
+	fetch := func(dest *[]CursorUser) func(orders []string, where string, args []any, limit int) error {
+		return func(orders []string, where string, args []any, limit int) error {
+			var result []CursorUser
+			if orders[0] == "id DESC" {
+				for i := len(rows) - 1; i >= 0; i-- {
+					if where == "" || int64(rows[i].ID) < args[0].(int64) {
+						result = append(result, rows[i])
+					}
+				}
+			} else {
+				for _, row := range rows {
+					if where == "" || int64(row.ID) > args[0].(int64) {
+						result = append(result, row)
+					}
+				}
+			}
+			if len(result) > limit {
+				result = result[:limit]
+			}
+			*dest = result
+
+			return nil
+		}
+	}
+
+	var users []CursorUser
+	next, prev, err := CursorPaginate(nil, "id", 2, "", &users, fetch(&users))
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorUser{{ID: 1}, {ID: 2}}, users)
+	assert.NotEmpty(t, next)
+	assert.Empty(t, prev)
+
+	next, prev, err = CursorPaginate(nil, "id", 2, next, &users, fetch(&users))
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorUser{{ID: 3}, {ID: 4}}, users)
+	assert.NotEmpty(t, next)
+	assert.NotEmpty(t, prev)
+
+	last, _, err := CursorPaginate(nil, "id", 2, next, &users, fetch(&users))
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorUser{{ID: 5}}, users)
+	assert.Empty(t, last)
+
+	next, prev, err = CursorPaginate(nil, "id", 2, prev, &users, fetch(&users))
+	assert.NoError(t, err)
+	assert.Equal(t, []CursorUser{{ID: 1}, {ID: 2}}, users)
+	assert.NotEmpty(t, next)
+	assert.Empty(t, prev)
+
+	for _, limit := range []int{0, -1} {
+		_, _, err = CursorPaginate(nil, "id", limit, "", &users, func(orders []string, where string, args []any, limit int) error {
+			t.Fatal("the rows shouldn't be fetched")
+			return nil
+		})
+		assert.Equal(t, errors.DatabaseCursorInvalidLimit.Args(limit), err)
+	}
+}
@@ -21,6 +21,9 @@ func GetIDByReflect(t reflect.Type, v reflect.Value) any {
 		t = t.Elem()
 		v = v.Elem()
 	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
 
 	for i := 0; i < t.NumField(); i++ {
 		if !t.Field(i).IsExported() {
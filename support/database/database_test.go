@@ -101,6 +101,15 @@ func TestGetID(t *testing.T) {
 				assert.Nil(t, GetID(nil), description)
 			},
 		},
+		{
+			description: "return nil when model is a slice",
+			setup: func(description string) {
+				type User struct {
+					ID uint `gorm:"primaryKey"`
+				}
+				assert.Nil(t, GetID(&[]User{{ID: 1}}), description)
+			},
+		},
 	}
 	for _, test := range tests {
 		test.setup(test.description)
@@ -4,6 +4,7 @@ import "path/filepath"
 
 type Paths struct {
 	Bootstrap  string
+	Cast       string
 	Command    string
 	Controller string
 	Event      string
@@ -20,6 +21,7 @@ type Paths struct {
 	Policy     string
 	Provider   string
 	Request    string
+	Resource   string
 	Rule       string
 	Seeder     string
 	Test       string
@@ -45,6 +47,7 @@ var (
 	Config = Configuration{
 		Paths: Paths{
 			Bootstrap:  "bootstrap",
+			Cast:       filepath.Join("app", "casts"),
 			Command:    filepath.Join("app", "console", "commands"),
 			Controller: filepath.Join("app", "http", "controllers"),
 			Event:      filepath.Join("app", "events"),
@@ -61,6 +64,7 @@ var (
 			Policy:     filepath.Join("app", "policies"),
 			Provider:   filepath.Join("app", "providers"),
 			Request:    filepath.Join("app", "http", "requests"),
+			Resource:   filepath.Join("app", "http", "resources"),
 			Rule:       filepath.Join("app", "rules"),
 			Seeder:     filepath.Join("database", "seeders"),
 			Test:       "tests",
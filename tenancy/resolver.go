@@ -0,0 +1,55 @@
+package tenancy
+
+import (
+	"strings"
+
+	contractshttp "github.com/rusmanplatd/goravelframework/contracts/http"
+	contractstenancy "github.com/rusmanplatd/goravelframework/contracts/tenancy"
+)
+
+// ResolverFunc adapts a function to the tenancy.Resolver interface.
+type ResolverFunc func(ctx contractshttp.Context) string
+
+func (r ResolverFunc) Resolve(ctx contractshttp.Context) string {
+	return r(ctx)
+}
+
+// HeaderResolver resolves the tenant from the request header, eg: X-Tenant: acme.
+func HeaderResolver(header string) contractstenancy.Resolver {
+	return ResolverFunc(func(ctx contractshttp.Context) string {
+		return strings.TrimSpace(ctx.Request().Header(header))
+	})
+}
+
+// PathResolver resolves the tenant from the segment of the request path, the index starts from 0,
+// eg: the tenant of /acme/users is acme if the index is 0.
+func PathResolver(index int) contractstenancy.Resolver {
+	return ResolverFunc(func(ctx contractshttp.Context) string {
+		segments := strings.Split(strings.Trim(ctx.Request().Path(), "/"), "/")
+		if index < 0 || index >= len(segments) {
+			return ""
+		}
+
+		return segments[index]
+	})
+}
+
+// SubdomainResolver resolves the tenant from the subdomain of the domain, eg: the tenant of acme.example.com is
+// acme if the domain is example.com.
+func SubdomainResolver(domain string) contractstenancy.Resolver {
+	suffix := "." + strings.TrimPrefix(strings.ToLower(domain), ".")
+
+	return ResolverFunc(func(ctx contractshttp.Context) string {
+		host := strings.ToLower(ctx.Request().Host())
+		if index := strings.LastIndex(host, ":"); index != -1 && !strings.Contains(host[index:], "]") {
+			host = host[:index]
+		}
+
+		subdomain, found := strings.CutSuffix(host, suffix)
+		if !found || subdomain == "" || strings.Contains(subdomain, ".") {
+			return ""
+		}
+
+		return subdomain
+	})
+}
@@ -0,0 +1,68 @@
+package tenancy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mockshttp "github.com/rusmanplatd/goravelframework/mocks/http"
+)
+
+func TestHeaderResolver(t *testing.T) {
+	mockContext := mockshttp.NewContext(t)
+	mockRequest := mockshttp.NewContextRequest(t)
+	mockContext.EXPECT().Request().Return(mockRequest).Once()
+	mockRequest.EXPECT().Header("X-Tenant").Return(" acme ").Once()
+
+	assert.Equal(t, "acme", HeaderResolver("X-Tenant").Resolve(mockContext))
+}
+
+func TestPathResolver(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		index  int
+		expect string
+	}{
+		{name: "first segment", path: "/acme/users", index: 0, expect: "acme"},
+		{name: "second segment", path: "/tenants/acme/users", index: 1, expect: "acme"},
+		{name: "out of range", path: "/acme", index: 1, expect: ""},
+		{name: "negative index", path: "/acme", index: -1, expect: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockContext := mockshttp.NewContext(t)
+			mockRequest := mockshttp.NewContextRequest(t)
+			mockContext.EXPECT().Request().Return(mockRequest).Once()
+			mockRequest.EXPECT().Path().Return(test.path).Once()
+
+			assert.Equal(t, test.expect, PathResolver(test.index).Resolve(mockContext))
+		})
+	}
+}
+
+func TestSubdomainResolver(t *testing.T) {
+	tests := []struct {
+		name   string
+		host   string
+		expect string
+	}{
+		{name: "subdomain", host: "acme.example.com", expect: "acme"},
+		{name: "subdomain with port", host: "Acme.example.com:8080", expect: "acme"},
+		{name: "root domain", host: "example.com", expect: ""},
+		{name: "nested subdomain", host: "api.acme.example.com", expect: ""},
+		{name: "other domain", host: "acme.example.org", expect: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockContext := mockshttp.NewContext(t)
+			mockRequest := mockshttp.NewContextRequest(t)
+			mockContext.EXPECT().Request().Return(mockRequest).Once()
+			mockRequest.EXPECT().Host().Return(test.host).Once()
+
+			assert.Equal(t, test.expect, SubdomainResolver("example.com").Resolve(mockContext))
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package tenancy
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rusmanplatd/goravelframework/contracts/console"
+	"github.com/rusmanplatd/goravelframework/contracts/console/command"
+	"github.com/rusmanplatd/goravelframework/errors"
+)
+
+// OptionName is the option of the commands that are scheduled for the tenants, see schedule.Event.OnTenants.
+const OptionName = "tenant"
+
+type (
+	contextKey       struct{}
+	withoutTenantKey struct{}
+)
+
+var (
+	connectionResolver    func(tenant string) string
+	connectionResolverMux sync.RWMutex
+	tenantsResolver       func() ([]string, error)
+	tenantsResolverMux    sync.RWMutex
+)
+
+// WithTenant returns a copy of the context that carries the tenant.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, contextKey{}, tenant)
+}
+
+// FromContext gets the tenant from the context, an empty string is returned if the context doesn't carry a tenant.
+// The http.Context can be passed directly after the tenant is resolved by the middleware.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	tenant, _ := ctx.Value(contextKey{}).(string)
+
+	return tenant
+}
+
+// WithoutTenant returns a copy of the context that queries the models of all the tenants, the queries of the
+// orm.ModelWithTenant models fail without a tenant in the context, to avoid leaking the data across the tenants.
+func WithoutTenant(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, withoutTenantKey{}, true)
+}
+
+// IsWithoutTenant determines if the context queries the models of all the tenants, see WithoutTenant.
+func IsWithoutTenant(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	without, _ := ctx.Value(withoutTenantKey{}).(bool)
+
+	return without
+}
+
+// FromCommand returns a context that carries the tenant of the "tenant" option of the command, the command should
+// add the Flag to its flags.
+func FromCommand(ctx console.Context) context.Context {
+	return WithTenant(context.Background(), ctx.Option(OptionName))
+}
+
+// Flag gets the "tenant" option of the commands that are scheduled for the tenants.
+func Flag() command.Flag {
+	return &command.StringFlag{
+		Name:  OptionName,
+		Usage: "The tenant that the command runs for",
+	}
+}
+
+// SetConnectionResolver sets the resolver of the per-tenant database connections, Orm.WithContext switches to the
+// connection returned by the resolver when the context carries a tenant. The connection isn't switched if the
+// resolver returns an empty string.
+func SetConnectionResolver(resolver func(tenant string) string) {
+	connectionResolverMux.Lock()
+	defer connectionResolverMux.Unlock()
+
+	connectionResolver = resolver
+}
+
+// Connection gets the database connection of the tenant, an empty string is returned if the connection resolver
+// isn't set.
+func Connection(tenant string) string {
+	connectionResolverMux.RLock()
+	resolver := connectionResolver
+	connectionResolverMux.RUnlock()
+
+	if resolver == nil || tenant == "" {
+		return ""
+	}
+
+	return resolver(tenant)
+}
+
+// SetTenantsResolver sets the resolver of all the tenants, it's used by the scheduled commands that run for all the
+// tenants, see schedule.Event.OnTenants.
+func SetTenantsResolver(resolver func() ([]string, error)) {
+	tenantsResolverMux.Lock()
+	defer tenantsResolverMux.Unlock()
+
+	tenantsResolver = resolver
+}
+
+// All gets all the tenants by the resolver set by SetTenantsResolver.
+func All() ([]string, error) {
+	tenantsResolverMux.RLock()
+	resolver := tenantsResolver
+	tenantsResolverMux.RUnlock()
+
+	if resolver == nil {
+		return nil, errors.TenancyTenantsResolverNotSet
+	}
+
+	return resolver()
+}
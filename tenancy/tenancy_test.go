@@ -0,0 +1,65 @@
+package tenancy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+)
+
+func TestWithTenant(t *testing.T) {
+	assert.Equal(t, "", FromContext(nil))
+	assert.Equal(t, "", FromContext(context.Background()))
+	assert.Equal(t, "acme", FromContext(WithTenant(context.Background(), "acme")))
+	assert.Equal(t, "acme", FromContext(WithTenant(nil, "acme")))
+}
+
+func TestFromCommand(t *testing.T) {
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option(OptionName).Return("acme").Once()
+
+	assert.Equal(t, "acme", FromContext(FromCommand(mockContext)))
+}
+
+func TestConnection(t *testing.T) {
+	t.Cleanup(func() {
+		SetConnectionResolver(nil)
+	})
+
+	assert.Equal(t, "", Connection("acme"))
+
+	SetConnectionResolver(func(tenant string) string {
+		return "tenant_" + tenant
+	})
+
+	assert.Equal(t, "tenant_acme", Connection("acme"))
+	assert.Equal(t, "", Connection(""))
+}
+
+func TestWithoutTenant(t *testing.T) {
+	assert.False(t, IsWithoutTenant(nil))
+	assert.False(t, IsWithoutTenant(context.Background()))
+	assert.True(t, IsWithoutTenant(WithoutTenant(context.Background())))
+	assert.True(t, IsWithoutTenant(WithoutTenant(nil)))
+}
+
+func TestAll(t *testing.T) {
+	t.Cleanup(func() {
+		SetTenantsResolver(nil)
+	})
+
+	tenants, err := All()
+	assert.ErrorIs(t, err, errors.TenancyTenantsResolverNotSet)
+	assert.Nil(t, tenants)
+
+	SetTenantsResolver(func() ([]string, error) {
+		return []string{"acme", "globex"}, nil
+	})
+
+	tenants, err = All()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"acme", "globex"}, tenants)
+}
@@ -0,0 +1,322 @@
+package testing
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/assert"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/support/database"
+)
+
+const (
+	// closestRowsLimit is the max number of the closest rows printed when an assertion fails.
+	closestRowsLimit = 3
+	// scannedRowsLimit is the max number of rows scanned to find the closest rows.
+	scannedRowsLimit = 100
+	deletedAtColumn  = "deleted_at"
+)
+
+// AssertDatabaseHas asserts that a row of the table matches the data, the default connection is used if no connection
+// is given. The closest rows are printed if the assertion fails.
+func (r *TestCase) AssertDatabaseHas(t *testing.T, table string, data map[string]any, connection ...string) bool {
+	t.Helper()
+
+	return r.assertDatabaseHas(t, table, data, func(query contractsdb.Query) contractsdb.Query {
+		return query
+	}, fmt.Sprintf("The table [%s] doesn't have a row matching the attributes %s.", table, formatRow(data)), connection...)
+}
+
+// AssertDatabaseMissing asserts that no row of the table matches the data, the default connection is used if no
+// connection is given. The matching rows are printed if the assertion fails.
+func (r *TestCase) AssertDatabaseMissing(t *testing.T, table string, data map[string]any, connection ...string) bool {
+	t.Helper()
+
+	query := r.db(connection...).Table(table).Where(data)
+	count, err := query.Count()
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+	if count == 0 {
+		return true
+	}
+
+	rows, err := scanRows(query.Limit(closestRowsLimit))
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+
+	message := fmt.Sprintf("The table [%s] has %d unexpected rows matching the attributes %s.", table, count, formatRow(data))
+	message += "\nFound rows:"
+	for i, row := range rows {
+		message += fmt.Sprintf("\n  %d) %s", i+1, formatRow(row))
+	}
+
+	return assert.Fail(t, message)
+}
+
+// AssertDatabaseCount asserts that the table has the given number of rows, the default connection is used if no
+// connection is given.
+func (r *TestCase) AssertDatabaseCount(t *testing.T, table string, count int64, connection ...string) bool {
+	t.Helper()
+
+	actual, err := r.db(connection...).Table(table).Count()
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+
+	return assert.Equal(t, count, actual, fmt.Sprintf("The table [%s] has %d rows instead of %d rows.", table, actual, count))
+}
+
+// AssertDatabaseEmpty asserts that the table has no rows, the default connection is used if no connection is given.
+func (r *TestCase) AssertDatabaseEmpty(t *testing.T, table string, connection ...string) bool {
+	t.Helper()
+
+	actual, err := r.db(connection...).Table(table).Count()
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+
+	return assert.Zero(t, actual, fmt.Sprintf("The table [%s] is not empty, it has %d rows.", table, actual))
+}
+
+// AssertSoftDeleted asserts that a row of the table matches the data and is soft deleted, the default connection is
+// used if no connection is given. The closest rows are printed if the assertion fails.
+func (r *TestCase) AssertSoftDeleted(t *testing.T, table string, data map[string]any, connection ...string) bool {
+	t.Helper()
+
+	return r.assertDatabaseHas(t, table, data, func(query contractsdb.Query) contractsdb.Query {
+		return query.WhereNotNull(deletedAtColumn)
+	}, fmt.Sprintf("The table [%s] doesn't have a soft deleted row matching the attributes %s.", table, formatRow(data)), connection...)
+}
+
+// AssertNotSoftDeleted asserts that a row of the table matches the data and is not soft deleted, the default
+// connection is used if no connection is given. The closest rows are printed if the assertion fails.
+func (r *TestCase) AssertNotSoftDeleted(t *testing.T, table string, data map[string]any, connection ...string) bool {
+	t.Helper()
+
+	return r.assertDatabaseHas(t, table, data, func(query contractsdb.Query) contractsdb.Query {
+		return query.WhereNull(deletedAtColumn)
+	}, fmt.Sprintf("The table [%s] doesn't have a row that matches the attributes %s and is not soft deleted.", table, formatRow(data)), connection...)
+}
+
+// AssertModelExists asserts that the model exists in the database by its primary key, the soft deleted model is
+// regarded as existing.
+func (r *TestCase) AssertModelExists(t *testing.T, model any) bool {
+	t.Helper()
+
+	exists, err := r.modelExists(model)
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+
+	return assert.True(t, exists, fmt.Sprintf("The model %T with the primary key [%v] doesn't exist in the database.", model, database.GetID(model)))
+}
+
+// AssertModelMissing asserts that the model doesn't exist in the database by its primary key, the soft deleted model
+// is regarded as existing.
+func (r *TestCase) AssertModelMissing(t *testing.T, model any) bool {
+	t.Helper()
+
+	exists, err := r.modelExists(model)
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+
+	return assert.False(t, exists, fmt.Sprintf("The model %T with the primary key [%v] exists in the database unexpectedly.", model, database.GetID(model)))
+}
+
+func (r *TestCase) assertDatabaseHas(t *testing.T, table string, data map[string]any, scope func(query contractsdb.Query) contractsdb.Query, message string, connection ...string) bool {
+	t.Helper()
+
+	db := r.db(connection...)
+	count, err := scope(db.Table(table).Where(data)).Count()
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+	if count > 0 {
+		return true
+	}
+
+	rows, err := scanRows(db.Table(table).Limit(scannedRowsLimit))
+	if err != nil {
+		return assert.Fail(t, err.Error())
+	}
+
+	return assert.Fail(t, message+closestRows(rows, data))
+}
+
+func (r *TestCase) db(connection ...string) contractsdb.DB {
+	if app == nil {
+		panic(errors.ApplicationNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	db := app.MakeDB()
+	if db == nil {
+		panic(errors.DBFacadeNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	if len(connection) > 0 && connection[0] != "" {
+		return db.Connection(connection[0])
+	}
+
+	return db
+}
+
+func (r *TestCase) modelExists(model any) (bool, error) {
+	if app == nil {
+		panic(errors.ApplicationNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	orm := app.MakeOrm()
+	if orm == nil {
+		panic(errors.OrmFacadeNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	if database.GetID(model) == nil {
+		return false, errors.TestingModelPrimaryKeyIsEmpty.Args(model)
+	}
+
+	// Query a copy of the model to keep the model unchanged, the primary key of the copy is used as the condition.
+	value := reflect.Indirect(reflect.ValueOf(model))
+	dest := reflect.New(value.Type())
+	dest.Elem().Set(value)
+
+	err := orm.Query().WithoutEvents().WithTrashed().FirstOrFail(dest.Interface())
+	if errors.Is(err, errors.OrmRecordNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// closestRows formats the rows that match the most attributes of the data, only the different attributes are shown.
+func closestRows(rows []map[string]any, data map[string]any) string {
+	if len(rows) == 0 {
+		return "\nThe table is empty."
+	}
+
+	type scoredRow struct {
+		row   map[string]any
+		score int
+	}
+
+	scoredRows := make([]scoredRow, 0, len(rows))
+	for _, row := range rows {
+		score := 0
+		for column, value := range data {
+			if actual, ok := row[column]; ok && equalValue(actual, value) {
+				score++
+			}
+		}
+		scoredRows = append(scoredRows, scoredRow{row: row, score: score})
+	}
+
+	slices.SortStableFunc(scoredRows, func(a, b scoredRow) int {
+		return b.score - a.score
+	})
+
+	columns := sortedColumns(data)
+	message := "\nThe closest rows:"
+	for i, scored := range scoredRows[:min(closestRowsLimit, len(scoredRows))] {
+		message += fmt.Sprintf("\n  %d) %s", i+1, formatRow(scored.row))
+		for _, column := range columns {
+			actual, ok := scored.row[column]
+			if !ok {
+				message += fmt.Sprintf("\n     - %s: %s\n     + %s: <column not found>", column, formatValue(data[column]), column)
+				continue
+			}
+			if !equalValue(actual, data[column]) {
+				message += fmt.Sprintf("\n     - %s: %s\n     + %s: %s", column, formatValue(data[column]), column, formatValue(actual))
+			}
+		}
+	}
+
+	return message
+}
+
+func equalValue(actual, expected any) bool {
+	return normalizeValue(actual) == normalizeValue(expected)
+}
+
+func formatRow(row map[string]any) string {
+	var pairs []string
+	for _, column := range sortedColumns(row) {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", column, formatValue(row[column])))
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func formatValue(value any) string {
+	switch value.(type) {
+	case nil:
+		return "NULL"
+	case []byte, string, time.Time, fmt.Stringer:
+		return fmt.Sprintf("%q", normalizeValue(value))
+	default:
+		return normalizeValue(value)
+	}
+}
+
+// normalizeValue converts the value of a column to a string, so the values of different types can be compared, eg:
+// MySQL returns bytes for the string columns.
+func normalizeValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return string(value)
+	case time.Time:
+		return value.Format(time.DateTime)
+	case fmt.Stringer:
+		return value.String()
+	}
+
+	if str, err := cast.ToStringE(value); err == nil {
+		return str
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func scanRows(query contractsdb.Query) ([]map[string]any, error) {
+	var (
+		rows []map[string]any
+		err  error
+	)
+
+	// The cursor should be drained to release the connection even if an error occurs.
+	for row := range query.Cursor() {
+		values := make(map[string]any)
+		if scanErr := row.Scan(&values); scanErr != nil {
+			err = scanErr
+			continue
+		}
+
+		rows = append(rows, values)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func sortedColumns(row map[string]any) []string {
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	slices.Sort(columns)
+
+	return columns
+}
@@ -0,0 +1,228 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
+	databasedb "github.com/rusmanplatd/goravelframework/database/db"
+	"github.com/rusmanplatd/goravelframework/database/orm"
+	"github.com/rusmanplatd/goravelframework/errors"
+	mocksdb "github.com/rusmanplatd/goravelframework/mocks/database/db"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+	mocksfoundation "github.com/rusmanplatd/goravelframework/mocks/foundation"
+)
+
+type DatabaseSuite struct {
+	suite.Suite
+	mockApp   *mocksfoundation.Application
+	mockDB    *mocksdb.DB
+	mockQuery *mocksdb.Query
+	testCase  *TestCase
+}
+
+func TestDatabaseSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseSuite))
+}
+
+func (s *DatabaseSuite) SetupTest() {
+	s.mockApp = mocksfoundation.NewApplication(s.T())
+	s.mockDB = mocksdb.NewDB(s.T())
+	s.mockQuery = mocksdb.NewQuery(s.T())
+	s.testCase = &TestCase{}
+	app = s.mockApp
+}
+
+func (s *DatabaseSuite) TearDownTest() {
+	app = nil
+}
+
+func (s *DatabaseSuite) TestAssertDatabaseHas() {
+	data := map[string]any{"name": "goravel", "age": 1}
+
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(1), nil).Once()
+
+	s.True(s.testCase.AssertDatabaseHas(s.T(), "users", data))
+
+	// The given connection is used
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Connection("postgres").Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(1), nil).Once()
+
+	s.True(s.testCase.AssertDatabaseHas(s.T(), "users", data, "postgres"))
+
+	// The closest rows are printed
+	mockT := &testing.T{}
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(0), nil).Once()
+	s.mockQuery.EXPECT().Limit(uint64(scannedRowsLimit)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Cursor().Return(rows(map[string]any{"name": []byte("goravel"), "age": int64(2)})).Once()
+
+	s.False(s.testCase.AssertDatabaseHas(mockT, "users", data))
+	s.True(mockT.Failed())
+}
+
+func (s *DatabaseSuite) TestAssertDatabaseMissing() {
+	data := map[string]any{"name": "goravel"}
+
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(0), nil).Once()
+
+	s.True(s.testCase.AssertDatabaseMissing(s.T(), "users", data))
+
+	mockT := &testing.T{}
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(1), nil).Once()
+	s.mockQuery.EXPECT().Limit(uint64(closestRowsLimit)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Cursor().Return(rows(map[string]any{"name": "goravel"})).Once()
+
+	s.False(s.testCase.AssertDatabaseMissing(mockT, "users", data))
+	s.True(mockT.Failed())
+}
+
+func (s *DatabaseSuite) TestAssertDatabaseCount() {
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Twice()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Count().Return(int64(2), nil).Twice()
+
+	s.True(s.testCase.AssertDatabaseCount(s.T(), "users", 2))
+
+	mockT := &testing.T{}
+	s.False(s.testCase.AssertDatabaseCount(mockT, "users", 1))
+	s.True(mockT.Failed())
+}
+
+func (s *DatabaseSuite) TestAssertDatabaseEmpty() {
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Twice()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Count().Return(int64(0), nil).Once()
+
+	s.True(s.testCase.AssertDatabaseEmpty(s.T(), "users"))
+
+	mockT := &testing.T{}
+	s.mockQuery.EXPECT().Count().Return(int64(0), assert.AnError).Once()
+
+	s.False(s.testCase.AssertDatabaseEmpty(mockT, "users"))
+	s.True(mockT.Failed())
+}
+
+func (s *DatabaseSuite) TestAssertSoftDeleted() {
+	data := map[string]any{"name": "goravel"}
+
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().WhereNotNull("deleted_at").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(1), nil).Once()
+
+	s.True(s.testCase.AssertSoftDeleted(s.T(), "users", data))
+}
+
+func (s *DatabaseSuite) TestAssertNotSoftDeleted() {
+	data := map[string]any{"name": "goravel"}
+
+	mockT := &testing.T{}
+	s.mockApp.EXPECT().MakeDB().Return(s.mockDB).Once()
+	s.mockDB.EXPECT().Table("users").Return(s.mockQuery).Twice()
+	s.mockQuery.EXPECT().Where(data).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().WhereNull("deleted_at").Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Count().Return(int64(0), nil).Once()
+	s.mockQuery.EXPECT().Limit(uint64(scannedRowsLimit)).Return(s.mockQuery).Once()
+	s.mockQuery.EXPECT().Cursor().Return(rows()).Once()
+
+	s.False(s.testCase.AssertNotSoftDeleted(mockT, "users", data))
+	s.True(mockT.Failed())
+}
+
+func (s *DatabaseSuite) TestAssertModelExistsAndMissing() {
+	mockOrm := mocksorm.NewOrm(s.T())
+	mockOrmQuery := mocksorm.NewQuery(s.T())
+	user := &DatabaseUser{Model: orm.Model{ID: 1}, Name: "goravel"}
+
+	s.mockApp.EXPECT().MakeOrm().Return(mockOrm).Times(3)
+	mockOrm.EXPECT().Query().Return(mockOrmQuery).Times(3)
+	mockOrmQuery.EXPECT().WithoutEvents().Return(mockOrmQuery).Times(3)
+	mockOrmQuery.EXPECT().WithTrashed().Return(mockOrmQuery).Times(3)
+
+	mockOrmQuery.EXPECT().FirstOrFail(&DatabaseUser{Model: orm.Model{ID: 1}, Name: "goravel"}).Return(nil).Once()
+	s.True(s.testCase.AssertModelExists(s.T(), user))
+
+	mockOrmQuery.EXPECT().FirstOrFail(&DatabaseUser{Model: orm.Model{ID: 1}, Name: "goravel"}).Return(errors.OrmRecordNotFound).Once()
+	s.True(s.testCase.AssertModelMissing(s.T(), user))
+
+	mockT := &testing.T{}
+	mockOrmQuery.EXPECT().FirstOrFail(&DatabaseUser{Model: orm.Model{ID: 1}, Name: "goravel"}).Return(assert.AnError).Once()
+	s.False(s.testCase.AssertModelExists(mockT, user))
+	s.True(mockT.Failed())
+
+	// The primary key is empty
+	mockT = &testing.T{}
+	s.mockApp.EXPECT().MakeOrm().Return(mockOrm).Once()
+	s.False(s.testCase.AssertModelMissing(mockT, &DatabaseUser{}))
+	s.True(mockT.Failed())
+}
+
+func TestClosestRows(t *testing.T) {
+	data := map[string]any{"name": "goravel", "age": 1}
+
+	assert.Equal(t, "\nThe table is empty.", closestRows(nil, data))
+	assert.Equal(t, `
+The closest rows:
+  1) {age: 2, name: "goravel"}
+     - age: 1
+     + age: 2
+  2) {age: 3, name: "framework"}
+     - age: 1
+     + age: 3
+     - name: "goravel"
+     + name: "framework"`, closestRows([]map[string]any{
+		{"name": []byte("framework"), "age": int64(3)},
+		{"name": []byte("goravel"), "age": int64(2)},
+	}, data))
+	assert.Equal(t, `
+The closest rows:
+  1) {id: 1}
+     - age: 1
+     + age: <column not found>
+     - name: "goravel"
+     + name: <column not found>`, closestRows([]map[string]any{{"id": 1}}, data))
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "NULL", formatValue(nil))
+	assert.Equal(t, `"goravel"`, formatValue([]byte("goravel")))
+	assert.Equal(t, `"goravel"`, formatValue("goravel"))
+	assert.Equal(t, `"2025-01-02 03:04:05"`, formatValue(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)))
+	assert.Equal(t, "1", formatValue(int64(1)))
+	assert.Equal(t, "true", formatValue(true))
+	assert.True(t, equalValue([]byte("1"), 1))
+}
+
+type DatabaseUser struct {
+	orm.Model
+	Name string
+}
+
+func rows(values ...map[string]any) chan contractsdb.Row {
+	ch := make(chan contractsdb.Row, len(values))
+	for _, value := range values {
+		ch <- databasedb.NewRow(value, nil)
+	}
+	close(ch)
+
+	return ch
+}
@@ -12,6 +12,7 @@ import (
 
 var (
 	json          foundation.Json
+	app           foundation.Application
 	artisanFacade contractsconsole.Artisan
 	routeFacade   contractsroute.Route
 	sessionFacade contractsession.Manager
@@ -36,7 +37,9 @@ func (r *ServiceProvider) Register(app foundation.Application) {
 	})
 }
 
-func (r *ServiceProvider) Boot(app foundation.Application) {
+func (r *ServiceProvider) Boot(application foundation.Application) {
+	app = application
+
 	artisanFacade = app.MakeArtisan()
 	if artisanFacade == nil {
 		color.Errorln(errors.ConsoleFacadeNotSet.SetModule(errors.ModuleTesting))
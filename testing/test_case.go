@@ -2,14 +2,26 @@ package testing
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"sync"
 	"testing"
 
+	"github.com/rusmanplatd/goravelframework/contracts/binding"
 	contractsseeder "github.com/rusmanplatd/goravelframework/contracts/database/seeder"
+	contractsevent "github.com/rusmanplatd/goravelframework/contracts/event"
 	contractshttp "github.com/rusmanplatd/goravelframework/contracts/testing/http"
+	databasedriver "github.com/rusmanplatd/goravelframework/database/driver"
 	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/event"
 	"github.com/rusmanplatd/goravelframework/testing/http"
 )
 
+var (
+	lazilyRefreshDatabase     bool
+	lazilyRefreshDatabaseLock sync.Mutex
+)
+
 type TestCase struct {
 }
 
@@ -37,6 +49,118 @@ func (r *TestCase) RefreshDatabase(seeders ...contractsseeder.Seeder) {
 	}
 }
 
+// LazilyRefreshDatabase refreshes the database only once per test process, the following calls are ignored. It can be
+// used with DatabaseTransactions to isolate the tests without migrating the database for each test.
+func (r *TestCase) LazilyRefreshDatabase(seeders ...contractsseeder.Seeder) {
+	lazilyRefreshDatabaseLock.Lock()
+	defer lazilyRefreshDatabaseLock.Unlock()
+
+	if lazilyRefreshDatabase {
+		return
+	}
+
+	r.RefreshDatabase(seeders...)
+	lazilyRefreshDatabase = true
+}
+
+// DatabaseTransactions runs the test in a transaction of each connection, all the configured connections are used if
+// no connection is given. The ORM and DB queries of the connections are run in the transactions transparently, the
+// transactions begun in the test are run in savepoints, and the transactions are rolled back when the test finishes.
+// The tests using it can't be run in parallel.
+func (r *TestCase) DatabaseTransactions(t *testing.T, connections ...string) {
+	if app == nil {
+		panic(errors.ApplicationNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	config := app.MakeConfig()
+	if config == nil {
+		panic(errors.ConfigFacadeNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	orm := app.MakeOrm()
+	if orm == nil {
+		panic(errors.OrmFacadeNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	if len(connections) == 0 {
+		if configuredConnections, ok := config.Get("database.connections").(map[string]any); ok {
+			connections = slices.Sorted(maps.Keys(configuredConnections))
+		}
+	}
+
+	for _, connection := range connections {
+		// Initialize the connection, the ORM and DB facades share the same connection pool.
+		orm.Connection(connection)
+
+		rollback, err := databasedriver.BeginTransaction(connection)
+		if err != nil {
+			panic(err)
+		}
+
+		t.Cleanup(func() {
+			if err := rollback(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// FakeEvent replaces the event facade with a fake that records dispatched events instead of calling their listeners,
+// only the given events will be faked if any, the real event facade will be restored when the test finishes. The
+// services depending on the event facade, eg: outbox, are resolved again, so they dispatch the events to the fake too,
+// but the instances got before faking keep the real event facade. The model events of the ORM are passed to the
+// observers directly, they are not faked.
+func (r *TestCase) FakeEvent(t *testing.T, events ...any) contractsevent.Fake {
+	if app == nil {
+		panic(errors.ApplicationNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	dispatcher := app.MakeEvent()
+	if dispatcher == nil {
+		panic(errors.EventFacadeNotSet.SetModule(errors.ModuleTesting))
+	}
+
+	fake := event.NewFake(t, dispatcher, events...)
+	app.Instance(binding.Event, fake)
+	app.Fresh(eventDependents()...)
+
+	t.Cleanup(func() {
+		app.Instance(binding.Event, dispatcher)
+		app.Fresh(eventDependents()...)
+	})
+
+	return fake
+}
+
+// eventDependents gets the event binding and the bindings depending on it directly or indirectly, their resolved
+// instances hold the event facade.
+func eventDependents() []any {
+	dependents := map[string]bool{binding.Event: true}
+	for changed := true; changed; {
+		changed = false
+		for name, info := range binding.Bindings {
+			if dependents[name] {
+				continue
+			}
+
+			for _, dependency := range info.Dependencies {
+				if dependents[dependency] {
+					dependents[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	var result []any
+	for _, name := range slices.Sorted(maps.Keys(dependents)) {
+		result = append(result, name)
+	}
+
+	return result
+}
+
 func getCommandOptionOfSeeders(seeders []contractsseeder.Seeder) string {
 	if len(seeders) == 0 {
 		return ""
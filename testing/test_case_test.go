@@ -4,9 +4,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/rusmanplatd/goravelframework/contracts/binding"
+	"github.com/rusmanplatd/goravelframework/errors"
+	"github.com/rusmanplatd/goravelframework/event"
+	mocksconfig "github.com/rusmanplatd/goravelframework/mocks/config"
 	mocksconsole "github.com/rusmanplatd/goravelframework/mocks/console"
+	mocksorm "github.com/rusmanplatd/goravelframework/mocks/database/orm"
+	mocksevent "github.com/rusmanplatd/goravelframework/mocks/event"
+	mocksfoundation "github.com/rusmanplatd/goravelframework/mocks/foundation"
 )
 
 type TestCaseSuite struct {
@@ -49,6 +57,71 @@ func (s *TestCaseSuite) TestRefreshDatabase() {
 	})
 }
 
+func (s *TestCaseSuite) TestLazilyRefreshDatabase() {
+	defer func() {
+		lazilyRefreshDatabase = false
+	}()
+
+	s.mockArtisan.EXPECT().Call("--no-ansi migrate:refresh --seeder mock").Return(nil).Once()
+	s.testCase.LazilyRefreshDatabase(&MockSeeder{})
+	s.testCase.LazilyRefreshDatabase(&MockSeeder{})
+}
+
+func (s *TestCaseSuite) TestDatabaseTransactions() {
+	s.Panics(func() {
+		s.testCase.DatabaseTransactions(s.T())
+	})
+
+	mockApp := mocksfoundation.NewApplication(s.T())
+	mockConfig := mocksconfig.NewConfig(s.T())
+	mockOrm := mocksorm.NewOrm(s.T())
+	app = mockApp
+	defer func() {
+		app = nil
+	}()
+
+	mockApp.EXPECT().MakeConfig().Return(mockConfig).Once()
+	mockApp.EXPECT().MakeOrm().Return(mockOrm).Once()
+	mockConfig.EXPECT().Get("database.connections").Return(map[string]any{
+		"mysql":    map[string]any{},
+		"postgres": map[string]any{},
+	}).Once()
+	mockOrm.EXPECT().Connection("mysql").Return(mockOrm).Once()
+
+	s.PanicsWithValue(errors.DatabaseConnectionNotInitialized.Args("mysql"), func() {
+		s.testCase.DatabaseTransactions(s.T())
+	})
+}
+
+func (s *TestCaseSuite) TestFakeEvent() {
+	mockApp := mocksfoundation.NewApplication(s.T())
+	mockEvent := mocksevent.NewInstance(s.T())
+	app = mockApp
+	defer func() {
+		app = nil
+	}()
+
+	mockApp.EXPECT().MakeEvent().Return(mockEvent).Once()
+	mockApp.EXPECT().Instance(binding.Event, mock.AnythingOfType("*event.Fake")).Once()
+	dependents := eventDependents()
+	s.Contains(dependents, binding.Event)
+	s.Contains(dependents, binding.Notification)
+	s.Contains(dependents, binding.Outbox)
+	s.NotContains(dependents, binding.Config)
+
+	mockApp.EXPECT().Fresh(dependents...).Twice()
+	mockApp.EXPECT().Instance(binding.Event, mockEvent).Once()
+
+	s.T().Run("fake", func(t *testing.T) {
+		fake := s.testCase.FakeEvent(t)
+		s.IsType(&event.Fake{}, fake)
+
+		_, err := fake.Dispatch("user.registered", "john")
+		s.NoError(err)
+		fake.AssertDispatched("user.registered")
+	})
+}
+
 type MockSeeder struct{}
 
 func (m *MockSeeder) Signature() string {
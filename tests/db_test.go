@@ -14,6 +14,7 @@ import (
 	"github.com/goravel/sqlserver"
 	"github.com/rusmanplatd/goravelframework/contracts/database/db"
 	databasedb "github.com/rusmanplatd/goravelframework/database/db"
+	databasedriver "github.com/rusmanplatd/goravelframework/database/driver"
 	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 	"github.com/rusmanplatd/goravelframework/support/convert"
@@ -597,6 +598,37 @@ func (s *DBTestSuite) TestInsertGetID() {
 	}
 }
 
+func (s *DBTestSuite) TestInsertOrIgnore() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			_, err := query.DB().Table("products").Insert(map[string]any{"name": "insert or ignore product"})
+			s.NoError(err)
+
+			var product Product
+			err = query.DB().Table("products").Where("name", "insert or ignore product").First(&product)
+			s.NoError(err)
+
+			data := []map[string]any{
+				{"id": product.ID, "name": "insert or ignore product1"},
+			}
+			if driver == sqlserver.Name {
+				result, err := query.DB().Table("products").InsertOrIgnore(data)
+				s.Equal(errors.DatabaseIgnoreUniqueByIsRequired.Args(sqlserver.Name), err)
+				s.Nil(result)
+			}
+
+			result, err := query.DB().Table("products").InsertOrIgnore(data, "id")
+			s.NoError(err)
+			s.Equal(int64(0), result.RowsAffected)
+
+			var product1 Product
+			err = query.DB().Table("products").Where("id", product.ID).First(&product1)
+			s.NoError(err)
+			s.Equal("insert or ignore product", product1.Name)
+		})
+	}
+}
+
 func (s *DBTestSuite) TestJoin() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -1067,6 +1099,46 @@ func (s *DBTestSuite) TestSharedLock() {
 	}
 }
 
+func (s *DBTestSuite) TestSubQuery() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			_, err := query.DB().Table("products").Insert([]map[string]any{
+				{"name": "sub_query_product1", "weight": 100, "height": 10},
+				{"name": "sub_query_product2", "weight": 200, "height": 20},
+				{"name": "sub_query_product3", "weight": 300, "height": 30},
+			})
+			s.NoError(err)
+
+			type Product struct {
+				Name   string
+				Weight int
+			}
+
+			var products []Product
+			heavy := query.DB().Table("products").Where("weight > ?", 150)
+			err = query.DB().Table("products").FromSub(heavy, "heavy").Where("name LIKE ?", "sub_query_%").OrderBy("name").Get(&products)
+			s.NoError(err)
+			s.Equal([]Product{{Name: "sub_query_product2", Weight: 200}, {Name: "sub_query_product3", Weight: 300}}, products)
+
+			var names []string
+			err = query.DB().Table("products").WhereIn("name", query.DB().Table("products").Select("name").Where("height", 10)).Pluck("name", &names)
+			s.NoError(err)
+			s.Equal([]string{"sub_query_product1"}, names)
+
+			count, err := query.DB().Table("products").Select("name").Where("weight", 100).
+				Union(query.DB().Table("products").Select("name").Where("weight", 300)).Count()
+			s.NoError(err)
+			s.Equal(int64(2), count)
+
+			var product Product
+			err = query.DB().Table("light").With("light", query.DB().Table("products").Where("weight < ?", 150)).
+				Where("name LIKE ?", "sub_query_%").First(&product)
+			s.NoError(err)
+			s.Equal(Product{Name: "sub_query_product1", Weight: 100}, product)
+		})
+	}
+}
+
 func (s *DBTestSuite) TestSum() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -1239,6 +1311,44 @@ func (s *DBTestSuite) TestTransaction() {
 	}
 }
 
+func (s *DBTestSuite) TestTestingTransaction() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			rollback, err := databasedriver.BeginTransaction(query.Driver().Pool().Writers[0].Connection)
+			s.NoError(err)
+
+			s.NoError(query.Query().Create(&Product{Name: "testing transaction orm product"}))
+			_, err = query.DB().Table("products").Insert(Product{Name: "testing transaction db product"})
+			s.NoError(err)
+
+			// The transactions begun in the testing transaction are run in savepoints.
+			s.NoError(query.DB().Transaction(func(tx db.Tx) error {
+				_, err := tx.Table("products").Insert(Product{Name: "testing transaction committed product"})
+
+				return err
+			}))
+			tx, err := query.Query().BeginTransaction()
+			s.NoError(err)
+			s.NoError(tx.Create(&Product{Name: "testing transaction rolled back product"}))
+			s.NoError(tx.Rollback())
+
+			var names []string
+			s.NoError(query.DB().Table("products").OrderBy("id").Pluck("name", &names))
+			s.Equal([]string{
+				"testing transaction orm product",
+				"testing transaction db product",
+				"testing transaction committed product",
+			}, names)
+
+			s.NoError(rollback())
+
+			count, err := query.DB().Table("products").Count()
+			s.NoError(err)
+			s.Equal(int64(0), count)
+		})
+	}
+}
+
 func (s *DBTestSuite) TestUpdate_Delete() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -1374,6 +1484,39 @@ func (s *DBTestSuite) TestUpdateOrInsert() {
 	}
 }
 
+func (s *DBTestSuite) TestUpsert() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			_, err := query.DB().Table("products").Insert([]map[string]any{
+				{"name": "upsert product1", "weight": 100},
+				{"name": "upsert product2", "weight": 100},
+			})
+			s.NoError(err)
+
+			var products []Product
+			err = query.DB().Table("products").Where("name LIKE ?", "upsert product%").OrderBy("id").Get(&products)
+			s.NoError(err)
+			s.Len(products, 2)
+
+			_, err = query.DB().Table("products").Upsert([]map[string]any{
+				{"id": products[0].ID, "name": "upsert product1", "weight": 200},
+				{"id": products[1].ID, "name": "upsert product2", "weight": 300},
+			}, []string{"id"}, []string{"weight"})
+			s.NoError(err)
+
+			var product1 Product
+			err = query.DB().Table("products").Where("id", products[0].ID).First(&product1)
+			s.NoError(err)
+			s.Equal(200, *product1.Weight)
+
+			var product2 Product
+			err = query.DB().Table("products").Where("id", products[1].ID).First(&product2)
+			s.NoError(err)
+			s.Equal(300, *product2.Weight)
+		})
+	}
+}
+
 func (s *DBTestSuite) TestValue() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
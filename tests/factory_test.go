@@ -21,7 +21,7 @@ func TestFactoryTestSuite(t *testing.T) {
 
 func (s *FactoryTestSuite) SetupSuite() {
 	postgresTestQuery := NewTestQueryBuilder().Postgres("", false)
-	postgresTestQuery.CreateTable(TestTableUsers, TestTableAuthors)
+	postgresTestQuery.CreateTable(TestTableUsers, TestTableAuthors, TestTableBooks)
 	s.query = postgresTestQuery.Query()
 }
 
@@ -147,3 +147,73 @@ func (s *FactoryTestSuite) TestMake() {
 	s.NotEmpty(author.CreatedAt.String())
 	s.NotEmpty(author.UpdatedAt.String())
 }
+
+func (s *FactoryTestSuite) TestStateAndSequence() {
+	var users []User
+	s.Nil(s.factory.Count(3).State(func(attributes map[string]any) map[string]any {
+		attributes["Avatar"] = "state_avatar"
+		return attributes
+	}).Sequence(
+		map[string]any{"Name": "sequence_user1"},
+		map[string]any{"Name": "sequence_user2"},
+	).Create(&users))
+	s.Len(users, 3)
+	s.True(users[0].ID > 0)
+	s.Equal("sequence_user1", users[0].Name)
+	s.Equal("sequence_user2", users[1].Name)
+	s.Equal("sequence_user1", users[2].Name)
+	s.Equal("state_avatar", users[2].Avatar)
+}
+
+func (s *FactoryTestSuite) TestHasAndFor() {
+	var created []uint
+	var user User
+	s.Nil(s.factory.Has(s.factory.Count(2), "Books").AfterCreating(func(model any) error {
+		created = append(created, model.(*User).ID)
+		return nil
+	}).Create(&user))
+	s.True(user.ID > 0)
+	s.Equal([]uint{user.ID}, created)
+	s.Len(user.Books, 2)
+	s.True(user.Books[0].ID > 0)
+	s.Equal(user.ID, user.Books[0].UserID)
+
+	count, err := s.query.Model(&Book{}).Where("user_id", user.ID).Count()
+	s.Nil(err)
+	s.Equal(int64(2), count)
+
+	var book Book
+	s.Nil(s.factory.For(&user, "User").Create(&book))
+	s.True(book.ID > 0)
+	s.Equal(user.ID, book.UserID)
+
+	var book1 Book
+	s.Nil(s.factory.For(s.factory, "User").Create(&book1))
+	s.True(book1.User.ID > 0)
+	s.Equal(book1.User.ID, book1.UserID)
+
+	// The parent built by the factory is shared by the models.
+	users, err := s.query.Model(&User{}).Count()
+	s.Nil(err)
+
+	var books []Book
+	s.Nil(s.factory.Count(3).For(s.factory, "User").Create(&books))
+	s.Len(books, 3)
+	s.True(books[0].UserID > 0)
+	for _, book := range books {
+		s.True(book.ID > 0)
+		s.Equal(books[0].UserID, book.UserID)
+	}
+
+	count, err = s.query.Model(&User{}).Count()
+	s.Nil(err)
+	s.Equal(users+1, count)
+}
+
+func (s *FactoryTestSuite) TestRaw() {
+	raws, err := s.factory.Count(2).Raw(&User{}, map[string]any{"Avatar": "raw_avatar"})
+	s.Nil(err)
+	s.Len(raws, 2)
+	s.Equal("raw_avatar", raws[0]["Avatar"])
+	s.True(len(raws[1]["Name"].(string)) > 0)
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -10,6 +11,7 @@ import (
 	contractsmigration "github.com/rusmanplatd/goravelframework/contracts/database/migration"
 	contractsschema "github.com/rusmanplatd/goravelframework/contracts/database/schema"
 	"github.com/rusmanplatd/goravelframework/database/migration"
+	"github.com/rusmanplatd/goravelframework/errors"
 )
 
 type DefaultMigratorWithDBSuite struct {
@@ -43,7 +45,7 @@ func (s *DefaultMigratorWithDBSuite) TestRun() {
 				testMigration,
 			})
 
-			migrator := migration.NewMigrator(nil, schema, "migrations")
+			migrator := migration.NewMigrator(nil, schema, "migrations", time.Minute)
 
 			s.NoError(migrator.Run())
 			s.True(schema.HasTable("users"))
@@ -63,7 +65,7 @@ func (s *DefaultMigratorWithDBSuite) TestReset() {
 				testMigration,
 			})
 
-			migrator := migration.NewMigrator(nil, schema, "migrations")
+			migrator := migration.NewMigrator(nil, schema, "migrations", time.Minute)
 
 			s.NoError(migrator.Reset())
 			s.NoError(migrator.Run())
@@ -83,7 +85,7 @@ func (s *DefaultMigratorWithDBSuite) TestRollback() {
 				testMigration,
 			})
 
-			migrator := migration.NewMigrator(nil, schema, "migrations")
+			migrator := migration.NewMigrator(nil, schema, "migrations", time.Minute)
 
 			s.NoError(migrator.Rollback(1, 0))
 			s.NoError(migrator.Run())
@@ -94,12 +96,36 @@ func (s *DefaultMigratorWithDBSuite) TestRollback() {
 	}
 }
 
+func (s *DefaultMigratorWithDBSuite) TestRunIsolated() {
+	for driver, testQuery := range s.driverToTestQuery {
+		s.Run(driver, func() {
+			schema := newSchema(testQuery, s.driverToTestQuery)
+			testMigration := NewTestMigration(schema)
+			schema.Register([]contractsschema.Migration{
+				testMigration,
+			})
+
+			migrator := migration.NewMigrator(nil, schema, "migrations", time.Second)
+
+			release, err := migration.NewLock(schema, "migrations", time.Second).Acquire(false)
+			s.NoError(err)
+			s.Equal(errors.MigrationLocked, migrator.RunIsolated())
+			s.ErrorIs(migrator.Run(), errors.MigrationLockTimeout)
+			s.False(schema.HasTable("users"))
+			s.NoError(release())
+
+			s.NoError(migrator.RunIsolated())
+			s.True(schema.HasTable("users"))
+		})
+	}
+}
+
 func (s *DefaultMigratorWithDBSuite) TestStatus() {
 	for driver, testQuery := range s.driverToTestQuery {
 		s.Run(driver, func() {
 			schema := newSchema(testQuery, s.driverToTestQuery)
 			testMigration := NewTestMigration(schema)
-			migrator := migration.NewMigrator(nil, schema, "migrations")
+			migrator := migration.NewMigrator(nil, schema, "migrations", time.Minute)
 			status, err := migrator.Status()
 			s.NoError(err)
 			s.Len(status, 0)
@@ -135,7 +161,7 @@ func TestDefaultMigratorWithPostgresSchema(t *testing.T) {
 	schema.Register([]contractsschema.Migration{
 		testMigration,
 	})
-	migrator := migration.NewMigrator(nil, schema, "migrations")
+	migrator := migration.NewMigrator(nil, schema, "migrations", time.Minute)
 
 	assert.NoError(t, migrator.Run())
 	assert.True(t, schema.HasTable("users"))
@@ -155,7 +181,7 @@ func TestDefaultMigratorWithSqlserverSchema(t *testing.T) {
 	schema.Register([]contractsschema.Migration{
 		testMigration,
 	})
-	migrator := migration.NewMigrator(nil, schema, "migrations")
+	migrator := migration.NewMigrator(nil, schema, "migrations", time.Minute)
 
 	assert.NoError(t, migrator.Run())
 	assert.True(t, schema.HasTable("goravel.users"))
@@ -26,6 +26,7 @@ func mockDatabaseConfigWithoutWriteAndRead(mockConfig *mocksconfig.Config, confi
 
 	mockConfig.EXPECT().GetBool("app.debug").Return(true)
 	mockConfig.EXPECT().GetInt("database.slow_threshold", 200).Return(200)
+	mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.slow_threshold", connection), 200).Return(200)
 	mockConfig.EXPECT().GetInt("database.pool.max_idle_conns", 10).Return(10)
 	mockConfig.EXPECT().GetInt("database.pool.max_open_conns", 100).Return(100)
 	mockConfig.EXPECT().GetDuration("database.pool.conn_max_idletime", time.Duration(3600)).Return(time.Duration(3600))
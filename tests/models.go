@@ -7,6 +7,7 @@ import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/rusmanplatd/goravelframework/contracts/database/factory"
 	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
+	"github.com/rusmanplatd/goravelframework/database/orm/casts"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 	"gorm.io/gorm"
 )
@@ -437,6 +438,22 @@ type Book struct {
 	Author *Author
 }
 
+func (r *Book) Factory() factory.Factory {
+	return &BookFactory{}
+}
+
+type BookFactory struct {
+}
+
+func (r *BookFactory) Definition() map[string]any {
+	faker := gofakeit.New(0)
+	return map[string]any{
+		"Name":      faker.BookTitle(),
+		"CreatedAt": carbon.NewDateTime(carbon.Now()),
+		"UpdatedAt": carbon.NewDateTime(carbon.Now()),
+	}
+}
+
 type Author struct {
 	Model
 	BookID uint   `db:"book_id"`
@@ -596,3 +613,62 @@ type UlidMorphableEntity struct {
 	MorphableID   string `gorm:"type:char(26)" json:"morphable_id"`
 	MorphableType string `json:"morphable_type"`
 }
+
+type CastUser struct {
+	Model
+	Name   string
+	Avatar []string `gorm:"type:varchar(255)"`
+}
+
+func (r *CastUser) TableName() string {
+	return "users"
+}
+
+func (r *CastUser) Casts() map[string]contractsorm.Cast {
+	return map[string]contractsorm.Cast{
+		"avatar": casts.CommaSeparated{},
+	}
+}
+
+type Order struct {
+	Model
+	Status  string
+	Version uint
+}
+
+func (r *Order) VersionColumn() string {
+	return "version"
+}
+
+type TenantPost struct {
+	Model
+	Tenant string
+	Title  string
+}
+
+func (r *TenantPost) TenantColumn() string {
+	return "tenant"
+}
+
+type FullTextPost struct {
+	Title string
+	Body  string
+}
+
+type Article struct {
+	Model
+	SoftDeletes
+	Title    string
+	Comments []*Comment
+}
+
+func (r *Article) CascadeDeletes() []string {
+	return []string{"Comments"}
+}
+
+type Comment struct {
+	Model
+	SoftDeletes
+	ArticleID uint
+	Body      string
+}
@@ -36,7 +36,7 @@ type TestQuery struct {
 
 func NewTestQuery(ctx context.Context, driver contractsdriver.Driver, config config.Config, connection string) (*TestQuery, error) {
 	pool := driver.Pool()
-	logger := databasedb.NewLogger(config, utils.NewTestLog())
+	logger := databasedb.NewLogger(config, utils.NewTestLog(), connection)
 	gorm, err := databasedriver.BuildGorm(config, logger.ToGorm(), pool, connection)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -12,16 +13,23 @@ import (
 	"github.com/goravel/mysql"
 	"github.com/goravel/sqlite"
 	"github.com/goravel/sqlserver"
+	"github.com/rusmanplatd/goravelframework/cache"
+	contractscache "github.com/rusmanplatd/goravelframework/contracts/cache"
+	contractsdb "github.com/rusmanplatd/goravelframework/contracts/database/db"
 	"github.com/rusmanplatd/goravelframework/contracts/database/orm"
 	contractsorm "github.com/rusmanplatd/goravelframework/contracts/database/orm"
 	databasedb "github.com/rusmanplatd/goravelframework/database/db"
 	"github.com/rusmanplatd/goravelframework/database/gorm"
+	databaseorm "github.com/rusmanplatd/goravelframework/database/orm"
 	"github.com/rusmanplatd/goravelframework/errors"
 	"github.com/rusmanplatd/goravelframework/support/carbon"
 	"github.com/rusmanplatd/goravelframework/support/convert"
+	supportdatabase "github.com/rusmanplatd/goravelframework/support/database"
+	"github.com/rusmanplatd/goravelframework/tenancy"
 	"github.com/rusmanplatd/goravelpostgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	gormio "gorm.io/gorm"
 )
 
 type QueryTestSuite struct {
@@ -302,6 +310,104 @@ func (s *QueryTestSuite) TestBelongsTo() {
 	}
 }
 
+func (s *QueryTestSuite) TestCascadeDeletes() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			article := Article{Title: "cascade_article", Comments: []*Comment{
+				{Body: "cascade_comment1"},
+				{Body: "cascade_comment2"},
+			}}
+			s.Nil(query.Query().Select(gorm.Associations).Create(&article))
+			s.True(article.ID > 0)
+
+			// The comment deleted before the article is kept deleted when the article is restored
+			comment := Comment{ArticleID: article.ID, Body: "cascade_comment3", SoftDeletes: SoftDeletes{
+				DeletedAt: gormio.DeletedAt{Time: time.Now().Add(-time.Hour), Valid: true},
+			}}
+			s.Nil(query.Query().Create(&comment))
+
+			res, err := query.Query().Delete(&article)
+			s.Nil(err)
+			s.Equal(int64(1), res.RowsAffected)
+
+			count, err := query.Query().Model(&Comment{}).Where("article_id", article.ID).Count()
+			s.Nil(err)
+			s.Equal(int64(0), count)
+
+			count, err = query.Query().Model(&Comment{}).Where("article_id", article.ID).OnlyTrashed().Count()
+			s.Nil(err)
+			s.Equal(int64(3), count)
+
+			// The relationship is limited to the soft deleted models
+			var trashedArticle Article
+			s.Nil(query.Query().WithTrashed().With("Comments", func(query contractsorm.Query) contractsorm.Query {
+				return query.OnlyTrashed()
+			}).Where("id", article.ID).First(&trashedArticle))
+			s.Len(trashedArticle.Comments, 3)
+
+			res, err = query.Query().WithTrashed().Restore(&Article{Model: Model{ID: article.ID}})
+			s.Nil(err)
+			s.Equal(int64(1), res.RowsAffected)
+
+			var comments []Comment
+			s.Nil(query.Query().Where("article_id", article.ID).Order("id").Find(&comments))
+			s.Len(comments, 2)
+			s.Equal("cascade_comment1", comments[0].Body)
+			s.Equal("cascade_comment2", comments[1].Body)
+
+			count, err = query.Query().Model(&Comment{}).Where("article_id", article.ID).OnlyTrashed().Count()
+			s.Nil(err)
+			s.Equal(int64(1), count)
+
+			// The comments are not cascaded when the article is force deleted
+			res, err = query.Query().ForceDelete(&article)
+			s.Nil(err)
+			s.Equal(int64(1), res.RowsAffected)
+
+			count, err = query.Query().Model(&Comment{}).Where("article_id", article.ID).Count()
+			s.Nil(err)
+			s.Equal(int64(2), count)
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestCascadeDeletes_Batch() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			articles := []Article{
+				{Title: "cascade_batch_article", Comments: []*Comment{{Body: "cascade_batch_comment1"}}},
+				{Title: "cascade_batch_article", Comments: []*Comment{{Body: "cascade_batch_comment2"}}},
+			}
+			s.Nil(query.Query().Select(gorm.Associations).Create(&articles))
+			articleIDs := []uint{articles[0].ID, articles[1].ID}
+
+			res, err := query.Query().Delete(&articles)
+			s.Nil(err)
+			s.Equal(int64(2), res.RowsAffected)
+
+			count, err := query.Query().Model(&Comment{}).Where("article_id", articleIDs).Count()
+			s.Nil(err)
+			s.Equal(int64(0), count)
+
+			res, err = query.Query().WithTrashed().Model(&Article{}).Where("title", "cascade_batch_article").Restore()
+			s.Nil(err)
+			s.Equal(int64(2), res.RowsAffected)
+
+			count, err = query.Query().Model(&Comment{}).Where("article_id", articleIDs).Count()
+			s.Nil(err)
+			s.Equal(int64(2), count)
+
+			res, err = query.Query().Model(&Article{}).Where("title", "cascade_batch_article").Delete()
+			s.Nil(err)
+			s.Equal(int64(2), res.RowsAffected)
+
+			count, err = query.Query().Model(&Comment{}).Where("article_id", articleIDs).OnlyTrashed().Count()
+			s.Nil(err)
+			s.Equal(int64(2), count)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestCount() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -564,6 +670,85 @@ func (s *QueryTestSuite) TestCreate() {
 	}
 }
 
+func (s *QueryTestSuite) TestChunk() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			for i := 0; i < 5; i++ {
+				user := User{Name: "chunk_user", Avatar: fmt.Sprintf("chunk_avatar%d", i), Address: &Address{Name: "chunk_address"}}
+				s.Nil(query.Query().Select(gorm.Associations).Create(&user))
+				s.True(user.ID > 0)
+			}
+
+			var (
+				chunks    []int
+				addresses int
+				ids       []uint
+			)
+			// The chunks are ordered by the primary key if the query isn't ordered.
+			s.Nil(query.Query().Model(&User{}).Where("name = ?", "chunk_user").With("Address").Chunk(2, func(models any) error {
+				users := *models.(*[]User)
+				chunks = append(chunks, len(users))
+				for _, user := range users {
+					ids = append(ids, user.ID)
+					if user.Address != nil {
+						addresses++
+					}
+				}
+
+				return nil
+			}))
+			s.Equal([]int{2, 2, 1}, chunks)
+			s.Equal(5, addresses)
+			s.True(slices.IsSorted(ids))
+
+			// The rows can be updated while chunking by id.
+			chunks = nil
+			s.Nil(query.Query().Model(&User{}).Where("name = ?", "chunk_user").ChunkByID(2, "", func(models any) error {
+				users := *models.(*[]User)
+				chunks = append(chunks, len(users))
+				for _, user := range users {
+					if _, err := query.Query().Model(&User{}).Where("id", user.ID).Update("name", "chunk_updated_user"); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}))
+			s.Equal([]int{2, 2, 1}, chunks)
+
+			var count int
+			s.Nil(query.Query().Model(&User{}).Where("name = ?", "chunk_updated_user").Each(func(model any) error {
+				s.Equal("chunk_updated_user", model.(*User).Name)
+				count++
+
+				return nil
+			}))
+			s.Equal(5, count)
+
+			count = 0
+			for user, err := range databaseorm.Lazy[User](query.Query().Where("name = ?", "chunk_updated_user"), 2) {
+				s.Nil(err)
+				s.True(user.ID > 0)
+				count++
+			}
+			s.Equal(5, count)
+
+			s.Equal(errors.OrmQueryModelNotSet, query.Query().Chunk(2, func(models any) error {
+				return nil
+			}))
+			s.ErrorIs(query.Query().Model(&User{}).Chunk(0, func(models any) error {
+				return nil
+			}), errors.OrmQueryInvalidChunkSize)
+			s.ErrorIs(query.Query().Model(&User{}).ChunkByID(-1, "", func(models any) error {
+				return nil
+			}), errors.OrmQueryInvalidChunkSize)
+			for _, err := range databaseorm.Lazy[User](query.Query(), 0) {
+				s.ErrorIs(err, errors.OrmQueryInvalidChunkSize)
+			}
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestCursor() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -2656,6 +2841,49 @@ func (s *QueryTestSuite) TestOffset() {
 	}
 }
 
+func (s *QueryTestSuite) TestOnlyTrashed() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			users := []User{
+				{Name: "only_trashed_user1", Avatar: "only_trashed_avatar", Books: []*Book{{Name: "only_trashed_book"}}},
+				{Name: "only_trashed_user2", Avatar: "only_trashed_avatar"},
+			}
+			s.Nil(query.Query().Select(gorm.Associations).Create(&users))
+
+			res, err := query.Query().Delete(&users[0])
+			s.Nil(err)
+			s.Equal(int64(1), res.RowsAffected)
+
+			var trashedUsers []User
+			s.Nil(query.Query().Where("avatar", "only_trashed_avatar").OnlyTrashed().Find(&trashedUsers))
+			s.Len(trashedUsers, 1)
+			s.Equal("only_trashed_user1", trashedUsers[0].Name)
+
+			// The preloaded relationships are not limited to the soft deleted models
+			var trashedUser User
+			s.Nil(query.Query().With("Books").Where("avatar", "only_trashed_avatar").OnlyTrashed().First(&trashedUser))
+			s.Equal("only_trashed_user1", trashedUser.Name)
+			s.Len(trashedUser.Books, 1)
+
+			count, err := query.Query().Model(&User{}).Where("avatar", "only_trashed_avatar").OnlyTrashed().Count()
+			s.Nil(err)
+			s.Equal(int64(1), count)
+
+			res, err = query.Query().Model(&User{}).Where("avatar", "only_trashed_avatar").OnlyTrashed().Restore()
+			s.Nil(err)
+			s.Equal(int64(1), res.RowsAffected)
+
+			count, err = query.Query().Model(&User{}).Where("avatar", "only_trashed_avatar").OnlyTrashed().Count()
+			s.Nil(err)
+			s.Equal(int64(0), count)
+
+			// The model can't be soft deleted
+			var books []Book
+			s.ErrorIs(query.Query().OnlyTrashed().Find(&books), errors.OrmDeletedAtColumnNotFound)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestOrder() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -2765,6 +2993,24 @@ func (s *QueryTestSuite) TestInTransaction() {
 	}
 }
 
+func (s *QueryTestSuite) TestInsertOrIgnore() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			user := User{Name: "insert_or_ignore_user", Avatar: "insert_or_ignore_avatar"}
+			s.Nil(query.Query().Create(&user))
+			s.True(user.ID > 0)
+
+			result, err := query.Query().InsertOrIgnore(&User{Model: Model{ID: user.ID}, Name: "insert_or_ignore_user1"})
+			s.Nil(err)
+			s.Equal(int64(0), result.RowsAffected)
+
+			var user1 User
+			s.Nil(query.Query().Find(&user1, user.ID))
+			s.Equal("insert_or_ignore_user", user1.Name)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestPaginate() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -2809,6 +3055,108 @@ func (s *QueryTestSuite) TestPaginate() {
 	}
 }
 
+func (s *QueryTestSuite) TestCursorPaginate() {
+	supportdatabase.SetCursorKey("cursor_paginate_key")
+	defer supportdatabase.SetCursorKey("")
+
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			var created []User
+			for i := 0; i < 5; i++ {
+				user := User{Name: "cursor_paginate_user", Avatar: fmt.Sprintf("cursor_paginate_avatar%d", i)}
+				s.Nil(query.Query().Create(&user))
+				s.True(user.ID > 0)
+				created = append(created, user)
+			}
+
+			var users []User
+			next, prev, err := query.Query().Where("name = ?", "cursor_paginate_user").OrderByDesc("id").CursorPaginate(2, "", &users)
+			s.Nil(err)
+			s.Equal([]uint{created[4].ID, created[3].ID}, []uint{users[0].ID, users[1].ID})
+			s.NotEmpty(next)
+			s.Empty(prev)
+
+			var users1 []User
+			next, prev, err = query.Query().Where("name = ?", "cursor_paginate_user").OrderByDesc("id").CursorPaginate(2, next, &users1)
+			s.Nil(err)
+			s.Equal([]uint{created[2].ID, created[1].ID}, []uint{users1[0].ID, users1[1].ID})
+			s.NotEmpty(next)
+			s.NotEmpty(prev)
+
+			var users2 []User
+			last, _, err := query.Query().Where("name = ?", "cursor_paginate_user").OrderByDesc("id").CursorPaginate(2, next, &users2)
+			s.Nil(err)
+			s.Equal(1, len(users2))
+			s.Equal(created[0].ID, users2[0].ID)
+			s.Empty(last)
+
+			var users3 []User
+			_, prev, err = query.Query().Where("name = ?", "cursor_paginate_user").OrderByDesc("id").CursorPaginate(2, prev, &users3)
+			s.Nil(err)
+			s.Equal([]uint{created[4].ID, created[3].ID}, []uint{users3[0].ID, users3[1].ID})
+			s.Empty(prev)
+
+			// The cursor is bound to the orders it's created for.
+			_, _, err = query.Query().Where("name = ?", "cursor_paginate_user").OrderBy("id").CursorPaginate(2, next, &users3)
+			s.ErrorIs(err, errors.DatabaseCursorOrderMismatch)
+
+			// The NULL values are sorted first, the rows that have the same value are ordered by the primary key.
+			var ids []uint
+			for i, bio := range []*string{nil, convert.Pointer("a"), nil, convert.Pointer("a"), convert.Pointer("b")} {
+				user := User{Name: "cursor_paginate_bio_user", Avatar: fmt.Sprintf("cursor_paginate_bio_avatar%d", i), Bio: bio}
+				s.Nil(query.Query().Create(&user))
+				ids = append(ids, user.ID)
+			}
+
+			var (
+				paginated []uint
+				cursor    string
+			)
+			for {
+				var users []User
+				next, _, err := query.Query().Where("name = ?", "cursor_paginate_bio_user").OrderBy("bio").CursorPaginate(2, cursor, &users)
+				s.Nil(err)
+				for _, user := range users {
+					paginated = append(paginated, user.ID)
+				}
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+			s.Equal([]uint{ids[0], ids[2], ids[1], ids[3], ids[4]}, paginated)
+
+			// The time values of the cursor are bound as time, they would be compared as strings otherwise.
+			ids = nil
+			for i, day := range []int{3, 1, 2, 1, 5} {
+				user := User{Name: "cursor_paginate_created_at_user", Avatar: fmt.Sprintf("cursor_paginate_created_at_avatar%d", i)}
+				user.CreatedAt = carbon.NewDateTime(carbon.FromDateTime(2025, 1, day, 10, 0, 0))
+				s.Nil(query.Query().Create(&user))
+				ids = append(ids, user.ID)
+			}
+
+			paginated, cursor = nil, ""
+			for {
+				var users []User
+				next, _, err := query.Query().Where("name = ?", "cursor_paginate_created_at_user").OrderByDesc("created_at").CursorPaginate(2, cursor, &users)
+				s.Nil(err)
+				for _, user := range users {
+					paginated = append(paginated, user.ID)
+				}
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+			s.Equal([]uint{ids[4], ids[0], ids[2], ids[1], ids[3]}, paginated)
+
+			var users4 []User
+			_, _, err = query.Query().Where("name = ?", "cursor_paginate_created_at_user").CursorPaginate(-1, "", &users4)
+			s.ErrorIs(err, errors.DatabaseCursorInvalidLimit)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestPluck() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -3495,6 +3843,48 @@ func (s *QueryTestSuite) TestSoftDelete() {
 	}
 }
 
+func (s *QueryTestSuite) TestRemember() {
+	databasedb.SetQueryCache(func() contractscache.Driver {
+		return &cache.Memory{}
+	}, true)
+	defer databasedb.SetQueryCache(nil, false)
+
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			user := User{Name: "remember_user", Avatar: "remember_avatar"}
+			s.Nil(query.Query().Create(&user))
+			s.True(user.ID > 0)
+
+			var user1 User
+			s.Nil(query.Query().Remember(time.Minute).Where("name", "remember_user").First(&user1))
+			s.Equal(user.ID, user1.ID)
+
+			// The cached result is returned even if the table is changed by a raw statement.
+			_, err := query.Query().Exec("UPDATE users SET avatar = ? WHERE id = ?", "remember_avatar1", user.ID)
+			s.Nil(err)
+
+			var user2 User
+			s.Nil(query.Query().Remember(time.Minute).Where("name", "remember_user").First(&user2))
+			s.Equal("remember_avatar", user2.Avatar)
+
+			count, err := query.Query().Model(&User{}).Remember(time.Minute).Where("name", "remember_user").Count()
+			s.Nil(err)
+			s.Equal(int64(1), count)
+
+			// The cached results are flushed after the table is written by the Orm.
+			s.Nil(query.Query().Create(&User{Name: "remember_user"}))
+
+			var user3 User
+			s.Nil(query.Query().Remember(time.Minute).Where("name", "remember_user").First(&user3))
+			s.Equal("remember_avatar1", user3.Avatar)
+
+			count, err = query.Query().Model(&User{}).Remember(time.Minute).Where("name", "remember_user").Count()
+			s.Nil(err)
+			s.Equal(int64(2), count)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestRestore() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -3811,6 +4201,128 @@ func (s *QueryTestSuite) TestUpdateOrCreate() {
 	}
 }
 
+func (s *QueryTestSuite) TestCasts() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			user := CastUser{Name: "cast_user", Avatar: []string{"avatar1", "avatar2"}}
+			s.Nil(query.Query().Create(&user))
+			s.True(user.ID > 0)
+
+			var originalUser User
+			s.Nil(query.Query().Find(&originalUser, user.ID))
+			s.Equal("avatar1,avatar2", originalUser.Avatar)
+
+			var castUser CastUser
+			s.Nil(query.Query().Find(&castUser, user.ID))
+			s.Equal([]string{"avatar1", "avatar2"}, castUser.Avatar)
+
+			_, err := query.Query().Model(&CastUser{}).Where("id", user.ID).Update("avatar", []string{"avatar3"})
+			s.Nil(err)
+			s.Nil(query.Query().Find(&castUser, user.ID))
+			s.Equal([]string{"avatar3"}, castUser.Avatar)
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestOptimisticLocking() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			order := Order{Status: "pending"}
+			s.Nil(query.Query().Create(&order))
+			s.True(order.ID > 0)
+			s.Equal(uint(1), order.Version)
+
+			var order1, order2 Order
+			s.Nil(query.Query().Find(&order1, order.ID))
+			s.Nil(query.Query().Find(&order2, order.ID))
+
+			order1.Status = "paid"
+			s.Nil(query.Query().Save(&order1))
+			s.Equal(uint(2), order1.Version)
+
+			order2.Status = "cancelled"
+			s.ErrorIs(query.Query().Save(&order2), errors.OrmStaleModel)
+			s.Equal(uint(1), order2.Version)
+
+			_, err := query.Query().Model(&order2).Update("status", "cancelled")
+			s.ErrorIs(err, errors.OrmStaleModel)
+
+			_, err = query.Query().Model(&order1).Update("status", "shipped")
+			s.Nil(err)
+			s.Equal(uint(3), order1.Version)
+
+			var order3 Order
+			s.Nil(query.Query().Find(&order3, order.ID))
+			s.Equal("shipped", order3.Status)
+			s.Equal(uint(3), order3.Version)
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestTenancy() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			acme := query.Query().(contractsorm.QueryWithContext).WithContext(tenancy.WithTenant(context.Background(), "acme"))
+			globex := query.Query().(contractsorm.QueryWithContext).WithContext(tenancy.WithTenant(context.Background(), "globex"))
+
+			post := TenantPost{Title: "acme post"}
+			s.Nil(acme.Create(&post))
+			s.Equal("acme", post.Tenant)
+			s.Nil(globex.Create(&TenantPost{Title: "globex post"}))
+
+			var posts []TenantPost
+			s.Nil(acme.Find(&posts))
+			s.Len(posts, 1)
+			s.Equal("acme post", posts[0].Title)
+
+			var found TenantPost
+			s.ErrorIs(globex.FindOrFail(&found, post.ID), errors.OrmRecordNotFound)
+
+			// The queries without a tenant fail
+			_, err := query.Query().Model(&TenantPost{}).Count()
+			s.ErrorIs(err, errors.OrmTenantNotFound)
+			s.ErrorIs(query.Query().Create(&TenantPost{Title: "no tenant post"}), errors.OrmTenantNotFound)
+
+			count, err := query.Query().(contractsorm.QueryWithContext).WithContext(tenancy.WithoutTenant(context.Background())).Model(&TenantPost{}).Count()
+			s.Nil(err)
+			s.Equal(int64(2), count)
+
+			res, err := globex.Where("id", post.ID).Delete(&TenantPost{})
+			s.Nil(err)
+			s.Equal(int64(0), res.RowsAffected)
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestUpsert() {
+	for driver, query := range s.queries {
+		s.Run(driver, func() {
+			users := []User{{Name: "upsert_user", Avatar: "upsert_avatar"}, {Name: "upsert_user1", Avatar: "upsert_avatar1"}}
+			s.Nil(query.Query().Create(&users))
+			s.True(users[0].ID > 0)
+			s.True(users[1].ID > 0)
+
+			users[0].Avatar = "upsert_avatar2"
+			users[1].Avatar = "upsert_avatar3"
+			users[1].Name = "upsert_user2"
+			_, err := query.Query().Upsert(&users, []string{"id"}, []string{"avatar"})
+			s.Nil(err)
+
+			var user User
+			s.Nil(query.Query().Find(&user, users[0].ID))
+			s.Equal("upsert_avatar2", user.Avatar)
+
+			var user1 User
+			s.Nil(query.Query().Find(&user1, users[1].ID))
+			s.Equal("upsert_user1", user1.Name)
+			s.Equal("upsert_avatar3", user1.Avatar)
+
+			_, err = query.Query().Upsert(&users, nil, nil)
+			s.Equal(errors.DatabaseUpsertUniqueByIsRequired, err)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestWhere() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
@@ -3843,6 +4355,81 @@ func (s *QueryTestSuite) TestWhere() {
 	}
 }
 
+func (s *QueryTestSuite) TestWhereFullText() {
+	for driver, query := range s.queries {
+		// SQLite requires a FTS5 virtual table.
+		if driver == sqlite.Name {
+			s.Run(driver, func() {
+				_, err := query.Query().Exec("DROP TABLE IF EXISTS full_text_posts")
+				s.Nil(err)
+				_, err = query.Query().Exec("CREATE VIRTUAL TABLE full_text_posts USING fts5(title, body)")
+				s.Nil(err)
+				s.Nil(query.Query().Table("full_text_posts").Create(&[]FullTextPost{
+					{Title: "full text search engine", Body: "goravel"},
+					{Title: "goravel", Body: "searching the full text"},
+					{Title: "nothing related", Body: "goravel"},
+				}))
+
+				var posts []FullTextPost
+				s.Nil(query.Query().Table("full_text_posts").WhereFullText([]string{"title"}, "search*").Find(&posts))
+				s.Len(posts, 1)
+				s.Equal("full text search engine", posts[0].Title)
+
+				posts = nil
+				s.Nil(query.Query().Table("full_text_posts").
+					WhereFullText([]string{"title", "body"}, "search*").
+					OrderByFullText([]string{"title", "body"}, "search*").
+					Find(&posts))
+				s.Len(posts, 2)
+
+				posts = nil
+				s.Nil(query.Query().Table("full_text_posts").WhereFullText([]string{"full_text_posts.title", "full_text_posts.body"}, "nothing OR engine").Find(&posts))
+				s.Len(posts, 2)
+
+				_, err = query.Query().Exec("DROP TABLE full_text_posts")
+				s.Nil(err)
+			})
+
+			continue
+		}
+
+		// MySQL and SQL Server require a full-text index.
+		if driver != goravelpostgres.Name {
+			continue
+		}
+
+		s.Run(driver, func() {
+			s.Nil(query.Query().Create(&[]User{
+				{Name: "full text search engine", Avatar: "where_full_text_avatar"},
+				{Name: "searching the full text", Avatar: "where_full_text_avatar"},
+				{Name: "nothing related", Avatar: "where_full_text_avatar"},
+			}))
+
+			var users []User
+			s.Nil(query.Query().Where("avatar", "where_full_text_avatar").WhereFullText([]string{"name"}, "search").Find(&users))
+			s.Len(users, 2)
+
+			users = nil
+			s.Nil(query.Query().Where("avatar", "where_full_text_avatar").
+				WhereFullText([]string{"name"}, "\"full text search\"", contractsdb.FullTextOptions{Mode: "websearch"}).
+				OrderByFullText([]string{"name"}, "search").
+				Find(&users))
+			s.Len(users, 1)
+			s.Equal("full text search engine", users[0].Name)
+
+			users = nil
+			s.Nil(query.Query().Where("avatar", "where_full_text_avatar").
+				WhereFullText([]string{"name"}, "nothing").
+				OrWhereFullText([]string{"name"}, "engine").
+				OrderBy("id").
+				Find(&users))
+			s.Len(users, 2)
+			s.Equal("full text search engine", users[0].Name)
+			s.Equal("nothing related", users[1].Name)
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestWhereIn() {
 	for driver, query := range s.queries {
 		s.Run(driver, func() {
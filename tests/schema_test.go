@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -1560,6 +1561,62 @@ func (s *SchemaSuite) TestColumnTypes_Sqlserver() {
 	}
 }
 
+func (s *SchemaSuite) TestDumpAndLoad() {
+	for driver, testQuery := range s.driverToTestQuery {
+		if driver == sqlserver.Name {
+			continue
+		}
+
+		s.Run(driver, func() {
+			schema := newSchema(testQuery, s.driverToTestQuery)
+			s.NoError(schema.Create("migrations", func(table contractsschema.Blueprint) {
+				table.ID()
+				table.String("migration")
+				table.Integer("batch")
+			}))
+			s.NoError(schema.Create("dump_users", func(table contractsschema.Blueprint) {
+				table.ID()
+				table.String("name").Unique()
+				table.String("avatar").Nullable()
+			}))
+			s.NoError(schema.Create("dump_posts", func(table contractsschema.Blueprint) {
+				table.ID()
+				table.UnsignedBigInteger("dump_user_id")
+				table.Foreign("dump_user_id").References("id").On("dump_users")
+			}))
+			s.NoError(testQuery.Query().Table("migrations").Create(map[string]any{
+				"migration": "20240817214501_create_dump_users_table",
+				"batch":     1,
+			}))
+
+			path := filepath.Join(s.T().TempDir(), driver+"-schema.sql")
+			s.NoError(schema.Dump(path, "migrations"))
+
+			s.NoError(schema.DropIfExists("dump_posts"))
+			s.NoError(schema.DropIfExists("dump_users"))
+			s.NoError(schema.DropIfExists("migrations"))
+			s.False(schema.HasTable("dump_users"))
+
+			s.NoError(schema.Load(path))
+			s.True(schema.HasTable("dump_users"))
+			s.True(schema.HasTable("dump_posts"))
+			s.True(schema.HasColumns("dump_users", []string{"id", "name", "avatar"}))
+			s.True(schema.HasIndex("dump_users", s.prefix+"dump_users_name_unique"))
+
+			foreignKeys, err := schema.GetForeignKeys("dump_posts")
+			s.NoError(err)
+			s.Len(foreignKeys, 1)
+
+			var migrations []string
+			s.NoError(testQuery.Query().Table("migrations").Pluck("migration", &migrations))
+			s.Equal([]string{"20240817214501_create_dump_users_table"}, migrations)
+
+			// The id is still increased after loading the dump.
+			s.NoError(testQuery.Query().Table("dump_users").Create(map[string]any{"name": "goravel"}))
+		})
+	}
+}
+
 func (s *SchemaSuite) TestEnum_Postgres() {
 	if s.driverToTestQuery[goravelpostgres.Name] == nil {
 		s.T().Skip("Skip test")
@@ -2564,6 +2621,39 @@ func (s *SchemaSuite) TestUnique() {
 	}
 }
 
+func (s *SchemaSuite) TestCreateView() {
+	for driver, testQuery := range s.driverToTestQuery {
+		s.Run(driver, func() {
+			schema := newSchema(testQuery, s.driverToTestQuery)
+			table := "create_views"
+
+			s.NoError(schema.Create(table, func(table contractsschema.Blueprint) {
+				table.String("name")
+				table.Integer("age")
+			}))
+			s.NoError(schema.CreateView("goravel_adults", "select * from goravel_create_views where age >= 18"))
+			s.True(schema.HasView("goravel_adults"))
+
+			s.NoError(schema.CreateView("goravel_adults", testQuery.DB().Table("create_views").Select("name").Where("age >= ?", 21), true))
+			views, err := schema.GetViews()
+			s.NoError(err)
+			s.Len(views, 1)
+			s.Contains(views[0].Definition, "21")
+
+			s.NoError(schema.DropView("goravel_adults"))
+			s.False(schema.HasView("goravel_adults"))
+
+			if driver == goravelpostgres.Name {
+				s.NoError(schema.CreateMaterializedView("goravel_adults_summary", "select count(*) as total from goravel_create_views"))
+				s.NoError(schema.RefreshMaterializedView("goravel_adults_summary"))
+				s.NoError(schema.DropMaterializedView("goravel_adults_summary"))
+			} else {
+				s.Error(schema.CreateMaterializedView("goravel_adults_summary", "select 1"))
+			}
+		})
+	}
+}
+
 func (s *SchemaSuite) TestViewMethods() {
 	for driver, testQuery := range s.driverToTestQuery {
 		s.Run(driver, func() {
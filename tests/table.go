@@ -28,6 +28,10 @@ const (
 	TestTableMorphableEntities
 	TestTableUuidMorphableEntities
 	TestTableUlidMorphableEntities
+	TestTableOrders
+	TestTableTenantPosts
+	TestTableArticles
+	TestTableComments
 )
 
 type testTables struct {
@@ -61,6 +65,10 @@ func (r *testTables) All() map[TestTable]func() ([]string, error) {
 		TestTableMorphableEntities:     r.morphableEntities,
 		TestTableUuidMorphableEntities: r.uuidMorphableEntities,
 		TestTableUlidMorphableEntities: r.ulidMorphableEntities,
+		TestTableOrders:                r.orders,
+		TestTableTenantPosts:           r.tenantPosts,
+		TestTableArticles:              r.articles,
+		TestTableComments:              r.comments,
 	}
 }
 
@@ -394,6 +402,91 @@ func (r *testTables) globalScopes() ([]string, error) {
 	return append(dropSql, createSql...), nil
 }
 
+func (r *testTables) orders() ([]string, error) {
+	dropSql, err := r.dropSql("orders")
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint := schema.NewBlueprint(nil, "", "orders")
+	blueprint.Create()
+	blueprint.BigIncrements("id")
+	blueprint.String("status")
+	blueprint.UnsignedInteger("version")
+	blueprint.Timestamps()
+
+	createSql, err := blueprint.ToSql(r.grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(dropSql, createSql...), nil
+}
+
+func (r *testTables) tenantPosts() ([]string, error) {
+	dropSql, err := r.dropSql("tenant_posts")
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint := schema.NewBlueprint(nil, "", "tenant_posts")
+	blueprint.Create()
+	blueprint.BigIncrements("id")
+	blueprint.String("tenant")
+	blueprint.String("title")
+	blueprint.Timestamps()
+
+	createSql, err := blueprint.ToSql(r.grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(dropSql, createSql...), nil
+}
+
+func (r *testTables) articles() ([]string, error) {
+	dropSql, err := r.dropSql("articles")
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint := schema.NewBlueprint(nil, "", "articles")
+	blueprint.Create()
+	blueprint.BigIncrements("id")
+	blueprint.String("title")
+	blueprint.Timestamps()
+	blueprint.SoftDeletes()
+
+	createSql, err := blueprint.ToSql(r.grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(dropSql, createSql...), nil
+}
+
+func (r *testTables) comments() ([]string, error) {
+	dropSql, err := r.dropSql("comments")
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint := schema.NewBlueprint(nil, "", "comments")
+	blueprint.Create()
+	blueprint.BigIncrements("id")
+	blueprint.UnsignedBigInteger("article_id")
+	blueprint.String("body")
+	blueprint.Timestamps()
+	blueprint.SoftDeletes()
+
+	createSql, err := blueprint.ToSql(r.grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(dropSql, createSql...), nil
+}
+
 func (r *testTables) uuidEntities() ([]string, error) {
 	dropSql, err := r.dropSql("uuid_entities")
 	if err != nil {